@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
@@ -35,11 +36,12 @@ var (
 	addrHTTPS                     = flag.String("addr-https", "127.0.0.1:443", "Addr: https request entrypoint")
 	defaultTLSCert                = flag.String("default-tls-cert", "", "Default TLS cert file path")
 	defaultTLSKey                 = flag.String("default-tls-key", "", "Default TLS key file path")
-	addrRPC                       = flag.String("addr-rpc", "127.0.0.1:9091", "Addr: manager request entrypoint")
+	addrRPC                       = flag.String("addr-rpc", "127.0.0.1:9091", "Addr: manager request entrypoint, it has no authentication and must be only accessible by the api server")
 	addrStore                     = flag.String("addr-store", "etcd://127.0.0.1:2379", "Addr: store of meta data, support etcd")
 	addrStoreUser                 = flag.String("addr-store-user", "", "addr Store UserName")
 	addrStorePwd                  = flag.String("addr-store-pwd", "", "addr Store Password")
 	addrPPROF                     = flag.String("addr-pprof", "", "Addr: pprof addr")
+	addrCache                     = flag.String("addr-cache", "", "Addr: storage of the cached responses shared by the proxies, support redis, e.g. redis://127.0.0.1:6379/0, the memory is used if empty")
 	namespace                     = flag.String("namespace", "dev", "The namespace to isolation the environment.")
 	limitCpus                     = flag.Int("limit-cpus", 0, "Limit: schedule threads count")
 	limitCountDispatchWorker      = flag.Int("limit-dispatch", 64, "Limit: Count of dispatch worker")
@@ -51,10 +53,17 @@ var (
 	limitDurationConnIdleSec      = flag.Int("limit-conn-idle", 30, "Limit(sec): Idle for backend server connections")
 	limitTimeoutWriteSec          = flag.Int("limit-timeout-write", 30, "Limit(sec): Timeout for write to backend servers")
 	limitTimeoutReadSec           = flag.Int("limit-timeout-read", 30, "Limit(sec): Timeout for read from backend servers")
+	limitTimeoutSSEIdleSec        = flag.Int("limit-timeout-sse-idle", 60, "Limit(sec): Idle timeout for server-sent events streams from backend servers")
+	limitTimeoutGracefulStopSec   = flag.Int("limit-timeout-graceful-stop", 30, "Limit(sec): Timeout for waiting in-flight requests when stopping by SIGTERM")
+	limitTimeoutUpgradeSec        = flag.Int("limit-timeout-upgrade", 60, "Limit(sec): Timeout for waiting the new process ready when upgrading by SIGUSR2")
+	limitTimeoutProxyProtocolSec  = flag.Int("limit-timeout-proxy-protocol", 5, "Limit(sec): Timeout for reading the PROXY protocol header of the connections")
 	limitBufferRead               = flag.Int("limit-buf-read", 2048, "Limit(bytes): Bytes for read buffer size")
 	limitBufferWrite              = flag.Int("limit-buf-write", 1024, "Limit(bytes): Bytes for write buffer size")
 	limitBytesBodyMB              = flag.Int("limit-body", 10, "Limit(MB): MB for body size")
 	limitBytesCachingMB           = flag.Uint64("limit-caching", 64, "Limit(MB): MB for caching size")
+	limitGraphQLDepth             = flag.Int("limit-graphql-depth", 10, "Limit(count): Max depth of the graphql query")
+	limitGraphQLComplexity        = flag.Int("limit-graphql-complexity", 100, "Limit(count): Max count of the fields of the graphql query")
+	limitBytesCompressMin         = flag.Int("limit-compress-min", 1024, "Limit(bytes): Min bytes of the response body to compress")
 	ttlProxy                      = flag.Int64("ttl-proxy", 10, "TTL(secs): proxy")
 	version                       = flag.Bool("version", false, "Show version info")
 
@@ -71,8 +80,16 @@ var (
 
 	// enable features
 	enableWebSocket              = flag.Bool("websocket", false, "enable websocket")
+	enableGRPC                   = flag.Bool("grpc", false, "enable grpc and grpc-web")
 	enableJSPlugin               = flag.Bool("js", false, "enable js plugin")
+	graphQLPath                  = flag.String("graphql", "", "enable graphql endpoint at the path, e.g. /graphql")
+	enableCompression            = flag.Bool("compress", false, "enable compressing responses with brotli or gzip")
+	compressTypes                = flag.String("compress-types", "text/,application/json,application/javascript,application/xml", "content type prefixes of the compressed responses, divided by ,")
+	enableRequestStreaming       = flag.Bool("stream-request", false, "enable streaming the request bodies of the streaming apis on the http entrypoint, the bodies are not limited by limit-body")
+	enableRequestDecompression   = flag.Bool("decompress-request", false, "enable decompressing request bodies with Content-Encoding")
 	disableHeaderNameNormalizing = flag.Bool("disable-header-normalizing", false, "disable normalizing header name")
+	enableProxyProtocol          = flag.Bool("proxy-protocol", false, "enable PROXY protocol v1 and v2 on the http and https entrypoints, the header is only accepted from the trusted-proxies, which are required")
+	trustedProxies               = flag.String("trusted-proxies", "", "CIDRs or ips of the trusted proxies, divided by ,, the real client ip is taken from the Forwarded or X-Forwarded-For header of the trusted proxies")
 )
 
 func init() {
@@ -87,6 +104,7 @@ func init() {
 	defaultFilters.Set(proxy.FilterHeader)
 	defaultFilters.Set(proxy.FilterXForward)
 	defaultFilters.Set(proxy.FilterValidation)
+	defaultFilters.Set(proxy.FilterTransform)
 	defaultFilters.Set(proxy.FilterJSPlugin)
 }
 
@@ -107,14 +125,24 @@ func main() {
 		runtime.GOMAXPROCS(*limitCpus)
 	}
 
+	p := proxy.NewProxy(getCfg())
+
 	if *addrPPROF != "" {
+		http.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+			if !p.IsReady() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		})
+
 		go func() {
-			log.Errorf("start pprof failed, errors:\n%+v",
-				http.ListenAndServe(*addrPPROF, nil))
+			l, err := p.Listen(*addrPPROF)
+			if err == nil {
+				err = http.Serve(l, nil)
+			}
+			log.Errorf("start pprof failed, errors:\n%+v", err)
 		}()
 	}
 
-	p := proxy.NewProxy(getCfg())
 	go p.Start()
 
 	waitStop(p)
@@ -126,10 +154,26 @@ func waitStop(p *proxy.Proxy) {
 		syscall.SIGHUP,
 		syscall.SIGINT,
 		syscall.SIGTERM,
-		syscall.SIGQUIT)
+		syscall.SIGQUIT,
+		syscall.SIGUSR2)
 
 	sig := <-sc
-	p.Stop()
+	for sig == syscall.SIGUSR2 {
+		err := p.Upgrade()
+		if err == nil {
+			log.Infof("exit: upgraded, bye :-).")
+			os.Exit(0)
+		}
+
+		log.Errorf("upgrade failed, errors:\n%+v", err)
+		sig = <-sc
+	}
+
+	if sig == syscall.SIGTERM {
+		p.GracefulStop()
+	} else {
+		p.Stop()
+	}
 	log.Infof("exit: signal=<%d>.", sig)
 	switch sig {
 	case syscall.SIGTERM:
@@ -153,6 +197,7 @@ func getCfg() *proxy.Cfg {
 	cfg.DefaultTLSKey = *defaultTLSKey
 	cfg.AddrRPC = *addrRPC
 	cfg.AddrPPROF = *addrPPROF
+	cfg.AddrCache = *addrCache
 	cfg.AddrStore = *addrStore
 	cfg.AddrStoreUserName = *addrStoreUser
 	cfg.AddrStorePwd = *addrStorePwd
@@ -160,6 +205,9 @@ func getCfg() *proxy.Cfg {
 	cfg.Namespace = fmt.Sprintf("/%s", *namespace)
 	cfg.Option.LimitBytesBody = *limitBytesBodyMB * 1024 * 1024
 	cfg.Option.LimitBytesCaching = *limitBytesCachingMB * 1024 * 1024
+	cfg.Option.LimitGraphQLDepth = *limitGraphQLDepth
+	cfg.Option.LimitGraphQLComplexity = *limitGraphQLComplexity
+	cfg.Option.LimitBytesCompressMin = *limitBytesCompressMin
 	cfg.Option.LimitBufferRead = *limitBufferRead
 	cfg.Option.LimitBufferWrite = *limitBufferWrite
 	cfg.Option.LimitCountConn = *limitCountConn
@@ -170,12 +218,26 @@ func getCfg() *proxy.Cfg {
 	cfg.Option.LimitDurationConnKeepalive = time.Second * time.Duration(*limitDurationConnKeepaliveSec)
 	cfg.Option.LimitTimeoutRead = time.Second * time.Duration(*limitTimeoutReadSec)
 	cfg.Option.LimitTimeoutWrite = time.Second * time.Duration(*limitTimeoutWriteSec)
+	cfg.Option.LimitTimeoutSSEIdle = time.Second * time.Duration(*limitTimeoutSSEIdleSec)
+	cfg.Option.LimitTimeoutGracefulStop = time.Second * time.Duration(*limitTimeoutGracefulStopSec)
+	cfg.Option.LimitTimeoutUpgrade = time.Second * time.Duration(*limitTimeoutUpgradeSec)
+	cfg.Option.LimitTimeoutProxyProtocol = time.Second * time.Duration(*limitTimeoutProxyProtocolSec)
 	cfg.Option.LimitIntervalHeathCheck = time.Second * time.Duration(*limitIntervalHeathCheckSec)
 	cfg.Option.JWTCfgFile = *jwtCfg
 	cfg.Option.CrossCfgFile = *crossCfg
 	cfg.Option.EnableWebSocket = *enableWebSocket
+	cfg.Option.EnableGRPC = *enableGRPC
 	cfg.Option.EnableJSPlugin = *enableJSPlugin
+	cfg.Option.GraphQLPath = *graphQLPath
+	cfg.Option.EnableCompression = *enableCompression
+	cfg.Option.EnableRequestDecompression = *enableRequestDecompression
+	cfg.Option.EnableRequestStreaming = *enableRequestStreaming
+	cfg.Option.CompressContentTypes = strings.Split(*compressTypes, ",")
 	cfg.Option.DisableHeaderNameNormalizing = *disableHeaderNameNormalizing
+	cfg.Option.EnableProxyProtocol = *enableProxyProtocol
+	if *trustedProxies != "" {
+		cfg.Option.TrustedProxies = strings.Split(*trustedProxies, ",")
+	}
 
 	specs := defaultFilters
 	if len(*filters) > 0 {
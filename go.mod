@@ -1,6 +1,7 @@
 module github.com/fagongzi/gateway
 
 require (
+	github.com/andybalholm/brotli v1.0.4
 	github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973 // indirect
 	github.com/boltdb/bolt v1.3.1 // indirect
 	github.com/buger/jsonparser v0.0.0-20180318095312-2cac668e8456
@@ -27,7 +28,6 @@ require (
 	github.com/grpc-ecosystem/grpc-gateway v1.6.2 // indirect
 	github.com/jonboulle/clockwork v0.1.0 // indirect
 	github.com/juju/ratelimit v1.0.1
-	github.com/koding/websocketproxy v0.0.0-20180716164433-0fa3f994f6e7
 	github.com/labstack/echo v0.0.0-20180412143600-6d227dfea4d2
 	github.com/labstack/gommon v0.0.0-20180613044413-d6898124de91 // indirect
 	github.com/mattn/go-colorable v0.0.0-20170801030607-167de6bfdfba // indirect
@@ -69,6 +69,36 @@ func (ab *APIBuilder) WebSocketOptions(options *metapb.WebSocketOptions) *APIBui
 	return ab
 }
 
+// Streaming set streaming mode, the request and response bodies are piped between client and backend
+func (ab *APIBuilder) Streaming(value bool) *APIBuilder {
+	ab.value.Streaming = value
+	return ab
+}
+
+// PartialErrors set whether return the aggregated response with the failed nodes in _errors
+func (ab *APIBuilder) PartialErrors(value bool) *APIBuilder {
+	ab.value.PartialErrors = value
+	return ab
+}
+
+// GraphQLField set the field name of the graphql query type resolved by the api
+func (ab *APIBuilder) GraphQLField(name string) *APIBuilder {
+	ab.value.GraphQLField = name
+	return ab
+}
+
+// SSEOptions set server-sent events options
+func (ab *APIBuilder) SSEOptions(options *metapb.SSEOptions) *APIBuilder {
+	ab.value.SSEOptions = options
+	return ab
+}
+
+// CORS set the cross-origin resource sharing policy
+func (ab *APIBuilder) CORS(policy *metapb.CORSPolicy) *APIBuilder {
+	ab.value.CORS = policy
+	return ab
+}
+
 // MatchURLPattern set a match path
 func (ab *APIBuilder) MatchURLPattern(urlPattern string) *APIBuilder {
 	ab.value.URLPattern = urlPattern
@@ -495,6 +525,57 @@ func (ab *APIBuilder) AddDispatchNodeCachingKey(cluster uint64, keys ...metapb.P
 	return ab.AddDispatchNodeCachingKeyWithIndex(cluster, 0, keys...)
 }
 
+// DispatchNodeCachingHTTPSemanticsWithIndex set dispatch node caching follows the http semantics of RFC 7234,
+// the stale durations are used if the response has no stale-while-revalidate or stale-if-error
+func (ab *APIBuilder) DispatchNodeCachingHTTPSemanticsWithIndex(cluster uint64, index int, staleWhileRevalidate, staleIfError time.Duration) *APIBuilder {
+	node := ab.getNode(cluster, index)
+	if node != nil {
+		node.Cache.HTTPSemantics = true
+		node.Cache.StaleWhileRevalidate = uint64(staleWhileRevalidate.Seconds())
+		node.Cache.StaleIfError = uint64(staleIfError.Seconds())
+	}
+
+	return ab
+}
+
+// DispatchNodeCachingHTTPSemantics set dispatch node caching follows the http semantics of RFC 7234
+func (ab *APIBuilder) DispatchNodeCachingHTTPSemantics(cluster uint64, staleWhileRevalidate, staleIfError time.Duration) *APIBuilder {
+	return ab.DispatchNodeCachingHTTPSemanticsWithIndex(cluster, 0, staleWhileRevalidate, staleIfError)
+}
+
+// DispatchNodeCachingCoalesceWithIndex set dispatch node caching coalesce the concurrent requests missed the cache,
+// the requests wait for the first request up to the timeout, the failure of the first request is shared within the error ttl
+func (ab *APIBuilder) DispatchNodeCachingCoalesceWithIndex(cluster uint64, index int, timeout, errorTTL time.Duration) *APIBuilder {
+	node := ab.getNode(cluster, index)
+	if node != nil {
+		node.Cache.Coalesce = true
+		node.Cache.CoalesceTimeout = uint64(timeout.Seconds())
+		node.Cache.ErrorTTL = uint64(errorTTL.Seconds())
+	}
+
+	return ab
+}
+
+// DispatchNodeCachingCoalesce set dispatch node caching coalesce the concurrent requests missed the cache
+func (ab *APIBuilder) DispatchNodeCachingCoalesce(cluster uint64, timeout, errorTTL time.Duration) *APIBuilder {
+	return ab.DispatchNodeCachingCoalesceWithIndex(cluster, 0, timeout, errorTTL)
+}
+
+// AddDispatchNodeCachingStatusCodeWithIndex add the status codes of the cached responses
+func (ab *APIBuilder) AddDispatchNodeCachingStatusCodeWithIndex(cluster uint64, index int, codes ...int32) *APIBuilder {
+	node := ab.getNode(cluster, index)
+	if node != nil {
+		node.Cache.StatusCodes = append(node.Cache.StatusCodes, codes...)
+	}
+
+	return ab
+}
+
+// AddDispatchNodeCachingStatusCode add the status codes of the cached responses
+func (ab *APIBuilder) AddDispatchNodeCachingStatusCode(cluster uint64, codes ...int32) *APIBuilder {
+	return ab.AddDispatchNodeCachingStatusCodeWithIndex(cluster, 0, codes...)
+}
+
 // AddDispatchNodeCachingConditionWithIndex add condition for caching
 func (ab *APIBuilder) AddDispatchNodeCachingConditionWithIndex(cluster uint64, index int, param metapb.Parameter, op metapb.CMP, expect string) *APIBuilder {
 	node := ab.getNode(cluster, index)
@@ -514,6 +595,55 @@ func (ab *APIBuilder) AddDispatchNodeCachingCondition(cluster uint64, param meta
 	return ab.AddDispatchNodeCachingConditionWithIndex(cluster, 0, param, op, expect)
 }
 
+// AddDispatchNodeCachingConditionGroupWithIndex add condition group for caching
+func (ab *APIBuilder) AddDispatchNodeCachingConditionGroupWithIndex(cluster uint64, index int, logic metapb.Logic, children ...metapb.Condition) *APIBuilder {
+	node := ab.getNode(cluster, index)
+	if node != nil {
+		node.Cache.Conditions = append(node.Cache.Conditions, metapb.Condition{
+			Logic:    logic,
+			Children: children,
+		})
+	}
+
+	return ab
+}
+
+// AddDispatchNodeCachingConditionGroup add condition group for caching
+func (ab *APIBuilder) AddDispatchNodeCachingConditionGroup(cluster uint64, logic metapb.Logic, children ...metapb.Condition) *APIBuilder {
+	return ab.AddDispatchNodeCachingConditionGroupWithIndex(cluster, 0, logic, children...)
+}
+
+// AddTransformation add transformation for the request or the response
+func (ab *APIBuilder) AddTransformation(target metapb.TransformTarget, action metapb.TransformAction, name, value string) *APIBuilder {
+	ab.value.Transformations = append(ab.value.Transformations, &metapb.Transformation{
+		Target: target,
+		Action: action,
+		Name:   name,
+		Value:  value,
+	})
+	return ab
+}
+
+// AddDispatchNodeTransformationWithIndex add dispatch node transformation for the request or the response
+func (ab *APIBuilder) AddDispatchNodeTransformationWithIndex(cluster uint64, index int, target metapb.TransformTarget, action metapb.TransformAction, name, value string) *APIBuilder {
+	node := ab.getNode(cluster, index)
+	if node != nil {
+		node.Transformations = append(node.Transformations, &metapb.Transformation{
+			Target: target,
+			Action: action,
+			Name:   name,
+			Value:  value,
+		})
+	}
+
+	return ab
+}
+
+// AddDispatchNodeTransformation add dispatch node transformation for the request or the response
+func (ab *APIBuilder) AddDispatchNodeTransformation(cluster uint64, target metapb.TransformTarget, action metapb.TransformAction, name, value string) *APIBuilder {
+	return ab.AddDispatchNodeTransformationWithIndex(cluster, 0, target, action, name, value)
+}
+
 // DispatchNodeURLRewriteWithIndex set dispatch node url rewrite
 func (ab *APIBuilder) DispatchNodeURLRewriteWithIndex(cluster uint64, index int, urlRewrite string) *APIBuilder {
 	node := ab.getNode(cluster, index)
@@ -535,6 +665,93 @@ func (ab *APIBuilder) DispatchNodeURLRewrite(cluster uint64, urlRewrite string)
 	return ab.DispatchNodeURLRewriteWithIndex(cluster, 0, urlRewrite)
 }
 
+// DispatchNodeBodyTemplateWithIndex set dispatch node method, content type and body template
+func (ab *APIBuilder) DispatchNodeBodyTemplateWithIndex(cluster uint64, index int, method, contentType, bodyTemplate string) *APIBuilder {
+	node := ab.getNode(cluster, index)
+
+	if node == nil {
+		node = &metapb.DispatchNode{
+			ClusterID: cluster,
+		}
+		ab.value.Nodes = append(ab.value.Nodes, node)
+	}
+
+	node.Method = method
+	node.ContentType = contentType
+	node.BodyTemplate = bodyTemplate
+	return ab
+}
+
+// DispatchNodeBodyTemplate set dispatch node method, content type and body template
+func (ab *APIBuilder) DispatchNodeBodyTemplate(cluster uint64, method, contentType, bodyTemplate string) *APIBuilder {
+	return ab.DispatchNodeBodyTemplateWithIndex(cluster, 0, method, contentType, bodyTemplate)
+}
+
+// DispatchNodeFailurePolicyWithIndex set dispatch node failure policy
+func (ab *APIBuilder) DispatchNodeFailurePolicyWithIndex(cluster uint64, index int, policy metapb.FailurePolicy) *APIBuilder {
+	node := ab.getNode(cluster, index)
+
+	if node == nil {
+		node = &metapb.DispatchNode{
+			ClusterID: cluster,
+		}
+		ab.value.Nodes = append(ab.value.Nodes, node)
+	}
+
+	node.FailurePolicy = policy
+	return ab
+}
+
+// DispatchNodeFailurePolicy set dispatch node failure policy
+func (ab *APIBuilder) DispatchNodeFailurePolicy(cluster uint64, policy metapb.FailurePolicy) *APIBuilder {
+	return ab.DispatchNodeFailurePolicyWithIndex(cluster, 0, policy)
+}
+
+// DispatchNodeForEachWithIndex set dispatch node dispatch one request per element of the array
+func (ab *APIBuilder) DispatchNodeForEachWithIndex(cluster uint64, index int, path string, concurrency, maxElements int32) *APIBuilder {
+	node := ab.getNode(cluster, index)
+
+	if node == nil {
+		node = &metapb.DispatchNode{
+			ClusterID: cluster,
+		}
+		ab.value.Nodes = append(ab.value.Nodes, node)
+	}
+
+	node.ForEach = &metapb.ForEach{
+		Path:        path,
+		Concurrency: concurrency,
+		MaxElements: maxElements,
+	}
+	return ab
+}
+
+// DispatchNodeForEach set dispatch node dispatch one request per element of the array
+func (ab *APIBuilder) DispatchNodeForEach(cluster uint64, path string, concurrency, maxElements int32) *APIBuilder {
+	return ab.DispatchNodeForEachWithIndex(cluster, 0, path, concurrency, maxElements)
+}
+
+// DispatchNodeDecoderWithIndex set dispatch node response decoder, the protobuf descriptor is used by the protobuf decoder
+func (ab *APIBuilder) DispatchNodeDecoderWithIndex(cluster uint64, index int, decoder metapb.ResponseDecoder, protobuf *metapb.ProtobufDescriptor) *APIBuilder {
+	node := ab.getNode(cluster, index)
+
+	if node == nil {
+		node = &metapb.DispatchNode{
+			ClusterID: cluster,
+		}
+		ab.value.Nodes = append(ab.value.Nodes, node)
+	}
+
+	node.Decoder = decoder
+	node.Protobuf = protobuf
+	return ab
+}
+
+// DispatchNodeDecoder set dispatch node response decoder, the protobuf descriptor is used by the protobuf decoder
+func (ab *APIBuilder) DispatchNodeDecoder(cluster uint64, decoder metapb.ResponseDecoder, protobuf *metapb.ProtobufDescriptor) *APIBuilder {
+	return ab.DispatchNodeDecoderWithIndex(cluster, 0, decoder, protobuf)
+}
+
 // DispatchNodeValueAttrNameWithIndex set dispatch node attr name of value
 func (ab *APIBuilder) DispatchNodeValueAttrNameWithIndex(cluster uint64, index int, attrName string) *APIBuilder {
 	node := ab.getNode(cluster, index)
@@ -604,15 +821,25 @@ func (ab *APIBuilder) NoRenderTemplate() *APIBuilder {
 
 // AddFlatRenderObject add the render object to the top level object
 func (ab *APIBuilder) AddFlatRenderObject(namesAndExtractExps ...string) *APIBuilder {
-	return ab.addRenderObject("", true, namesAndExtractExps...)
+	return ab.addRenderObject("", true, false, namesAndExtractExps...)
 }
 
 // AddRenderObject add the render object to the top level object
 func (ab *APIBuilder) AddRenderObject(nameInTemplate string, namesAndExtractExps ...string) *APIBuilder {
-	return ab.addRenderObject(nameInTemplate, false, namesAndExtractExps...)
+	return ab.addRenderObject(nameInTemplate, false, false, namesAndExtractExps...)
 }
 
-func (ab *APIBuilder) addRenderObject(nameInTemplate string, flatAttrs bool, namesAndExtractExps ...string) *APIBuilder {
+// AddFlatRenderExpressions add the render object with the template expressions to the top level object
+func (ab *APIBuilder) AddFlatRenderExpressions(namesAndExpressions ...string) *APIBuilder {
+	return ab.addRenderObject("", true, true, namesAndExpressions...)
+}
+
+// AddRenderExpressions add the render object with the template expressions to the top level object
+func (ab *APIBuilder) AddRenderExpressions(nameInTemplate string, namesAndExpressions ...string) *APIBuilder {
+	return ab.addRenderObject(nameInTemplate, false, true, namesAndExpressions...)
+}
+
+func (ab *APIBuilder) addRenderObject(nameInTemplate string, flatAttrs bool, expression bool, namesAndExtractExps ...string) *APIBuilder {
 	if len(namesAndExtractExps) == 0 || len(namesAndExtractExps)%2 != 0 {
 		return ab
 	}
@@ -638,10 +865,15 @@ func (ab *APIBuilder) addRenderObject(nameInTemplate string, flatAttrs bool, nam
 
 	l := len(namesAndExtractExps) / 2
 	for i := 0; i < l; i++ {
-		obj.Attrs = append(obj.Attrs, &metapb.RenderAttr{
-			Name:       namesAndExtractExps[2*i],
-			ExtractExp: namesAndExtractExps[2*i+1],
-		})
+		attr := &metapb.RenderAttr{
+			Name: namesAndExtractExps[2*i],
+		}
+		if expression {
+			attr.Expression = namesAndExtractExps[2*i+1]
+		} else {
+			attr.ExtractExp = namesAndExtractExps[2*i+1]
+		}
+		obj.Attrs = append(obj.Attrs, attr)
 	}
 
 	return ab
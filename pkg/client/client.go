@@ -46,10 +46,15 @@ type Client interface {
 	ApplyPlugins(ids ...uint64) error
 	GetAppliedPlugins() ([]uint64, error)
 
+	PutIPAccessControl(whitelist, blacklist []string) error
+	GetIPAccessControl() (*metapb.IPAccessControl, error)
+
 	Clean() error
 	SetID(id uint64) error
 	Batch(batch *rpcpb.BatchReq) (*rpcpb.BatchRsp, error)
 
+	PurgeCache(req *rpcpb.PurgeCacheReq) (*rpcpb.PurgeCacheRsp, error)
+
 	Close() error
 }
 
@@ -580,6 +585,39 @@ func (c *client) GetAppliedPlugins() ([]uint64, error) {
 	return rsp.Applied.AppliedIDs, nil
 }
 
+func (c *client) PutIPAccessControl(whitelist, blacklist []string) error {
+	meta, err := c.getMetaClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = meta.PutIPAccessControl(context.Background(), &rpcpb.PutIPAccessControlReq{
+		Value: metapb.IPAccessControl{
+			Whitelist: whitelist,
+			Blacklist: blacklist,
+		},
+	}, grpc.FailFast(true))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) GetIPAccessControl() (*metapb.IPAccessControl, error) {
+	meta, err := c.getMetaClient()
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := meta.GetIPAccessControl(context.Background(), &rpcpb.GetIPAccessControlReq{}, grpc.FailFast(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.Value, nil
+}
+
 func (c *client) Clean() error {
 	meta, err := c.getMetaClient()
 	if err != nil {
@@ -611,6 +649,15 @@ func (c *client) Batch(batch *rpcpb.BatchReq) (*rpcpb.BatchRsp, error) {
 	return meta.Batch(context.Background(), batch, grpc.FailFast(true))
 }
 
+func (c *client) PurgeCache(req *rpcpb.PurgeCacheReq) (*rpcpb.PurgeCacheRsp, error) {
+	meta, err := c.getMetaClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return meta.PurgeCache(context.Background(), req, grpc.FailFast(true))
+}
+
 func (c *client) Close() error {
 	return c.clients.Close()
 }
@@ -42,6 +42,15 @@ func (rb *RoutingBuilder) AddCondition(param metapb.Parameter, op metapb.CMP, ex
 	return rb
 }
 
+// AddConditionGroup add a condition group of the children
+func (rb *RoutingBuilder) AddConditionGroup(logic metapb.Logic, children ...metapb.Condition) *RoutingBuilder {
+	rb.value.Conditions = append(rb.value.Conditions, metapb.Condition{
+		Logic:    logic,
+		Children: children,
+	})
+	return rb
+}
+
 // TrafficRate set traffic rate for this routing
 func (rb *RoutingBuilder) TrafficRate(rate int) *RoutingBuilder {
 	rb.value.TrafficRate = int32(rate)
@@ -78,6 +87,24 @@ func (rb *RoutingBuilder) API(api uint64) *RoutingBuilder {
 	return rb
 }
 
+// StickyKey set the parameter to select the same requests by traffic rate
+func (rb *RoutingBuilder) StickyKey(param metapb.Parameter) *RoutingBuilder {
+	rb.value.StickyKey = &param
+	return rb
+}
+
+// Compare compare the response of the copy routing with the primary response
+func (rb *RoutingBuilder) Compare(value *metapb.ShadowCompare) *RoutingBuilder {
+	rb.value.Compare = value
+	return rb
+}
+
+// Rollout set progressive rollout for this routing
+func (rb *RoutingBuilder) Rollout(value *metapb.Rollout) *RoutingBuilder {
+	rb.value.Rollout = value
+	return rb
+}
+
 // Commit commit
 func (rb *RoutingBuilder) Commit() (uint64, error) {
 	err := pb.ValidateRouting(&rb.value)
@@ -34,6 +34,7 @@ var (
 	body   = []byte("body")
 	depend = []byte("depend")
 	param  = []byte("param")
+	item   = []byte("item")
 
 	dot = []byte{'.'}
 )
@@ -43,12 +44,14 @@ type Ctx struct {
 	Origin *fasthttp.Request
 	Depend []byte
 	Params map[string][]byte
+	Item   []byte
 }
 
 // Reset reset ctx
 func (c *Ctx) Reset() {
 	c.Origin = nil
 	c.Depend = nil
+	c.Item = nil
 	if c.Params != nil {
 		for key := range c.Params {
 			delete(c.Params, key)
@@ -89,12 +92,39 @@ func Exec(ctx *Ctx, exprs ...Expr) []byte {
 	return buf.Bytes()
 }
 
+// Depends returns the attrs referenced by the depend exprs, $(depend.xxx.yyy)
+// references the attr xxx
+func Depends(exprs ...Expr) []string {
+	var attrs []string
+	for _, expr := range exprs {
+		if e, ok := expr.(*dependParamExpr); ok {
+			if !containsString(attrs, e.param[0]) {
+				attrs = append(attrs, e.param[0])
+			}
+		}
+	}
+
+	return attrs
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Parse parse expr
 func Parse(value []byte) ([]Expr, error) {
 	// symbol table
 	// $(origin.path)/$(origin.query)/$(origin.query.xxx)/$(origin.cookie.xxx)/$(origin.header.xxx)/$(origin.body.xxx)
 	// $(depend.xxx)
 	// $(param.xxx)
+	// $(item)/$(item.xxx)
+	// \$, \( and \) are the literal characters
 
 	var exprs []Expr
 	lexer := newScanner(value)
@@ -117,7 +147,7 @@ func Parse(value []byte) ([]Expr, error) {
 
 			value := lexer.ScanString()
 			if len(value) > 0 {
-				exprs = append(exprs, &constExpr{value: value})
+				exprs = append(exprs, &constExpr{value: unescape(value)})
 			}
 			break
 		case tokenLParen:
@@ -150,7 +180,7 @@ func Parse(value []byte) ([]Expr, error) {
 
 			value := lexer.ScanString()
 			if len(value) > 0 {
-				exprs = append(exprs, &constExpr{value: value})
+				exprs = append(exprs, &constExpr{value: unescape(value)})
 			}
 			return exprs, nil
 		}
@@ -224,6 +254,13 @@ func (scan *scanner) NextToken() {
 			scan.token = tokenEOF
 			scan.Next()
 			return
+		case '\\':
+			// skip the escaped character
+			switch scan.Next() {
+			case '$', '(', ')', '\\':
+				scan.Next()
+			}
+			continue
 		}
 
 		scan.Next()
@@ -251,6 +288,7 @@ func (scan *scanner) ScanString() []byte {
 // $(origin.path)/$(origin.query)/$(origin.query.xxx)/$(origin.cookie.xxx)/$(origin.header.xxx)/$(origin.body.xxx)
 // $(depend.xxx.xxx)
 // $(param.xxx)
+// $(item)/$(item.xxx.xxx)
 func newExpr(value []byte) (Expr, error) {
 	values := bytes.Split(value, dot)
 	if bytes.Equal(values[0], origin) {
@@ -267,6 +305,8 @@ func newExpr(value []byte) (Expr, error) {
 		}
 
 		return &pathParamExpr{param: hack.SliceToString(values[1])}, nil
+	} else if bytes.Equal(values[0], item) {
+		return &itemParamExpr{param: toStringSlice(values[1:])}, nil
 	}
 
 	return nil, fmt.Errorf("syntax error: not support source %s", values[0])
@@ -317,6 +357,26 @@ func newOriginExpr(values [][]byte) (Expr, error) {
 	return nil, fmt.Errorf("syntax error: not support origin %s", values[1])
 }
 
+// unescape returns the value with the escaped \$, \(, \) and \\ replaced by the characters
+func unescape(value []byte) []byte {
+	if bytes.IndexByte(value, '\\') < 0 {
+		return value
+	}
+
+	dst := make([]byte, 0, len(value))
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			switch value[i+1] {
+			case '$', '(', ')', '\\':
+				i++
+			}
+		}
+		dst = append(dst, value[i])
+	}
+
+	return dst
+}
+
 type constExpr struct {
 	value []byte
 }
@@ -396,13 +456,14 @@ type originBodyParamExpr struct {
 }
 
 func (e *originBodyParamExpr) Exec(buf *bytes.Buffer, ctx *Ctx) {
-	value, _, _, err := jsonparser.Get(ctx.Origin.Body(), e.param...)
-	if err != nil {
-		return
-	}
+	value, _ := e.jsonValue(ctx)
 	buf.Write(value)
 }
 
+func (e *originBodyParamExpr) jsonValue(ctx *Ctx) ([]byte, jsonparser.ValueType) {
+	return getJSONValue(ctx.Origin.Body(), e.param...)
+}
+
 func (e *originBodyParamExpr) Name() string {
 	return "origin-body-expr"
 }
@@ -412,19 +473,40 @@ type dependParamExpr struct {
 }
 
 func (e *dependParamExpr) Exec(buf *bytes.Buffer, ctx *Ctx) {
-	if ctx.Depend != nil {
-		value, _, _, err := jsonparser.Get(ctx.Depend, e.param...)
-		if err != nil {
-			return
-		}
-		buf.Write(value)
-	}
+	value, _ := e.jsonValue(ctx)
+	buf.Write(value)
+}
+
+func (e *dependParamExpr) jsonValue(ctx *Ctx) ([]byte, jsonparser.ValueType) {
+	return getJSONValue(ctx.Depend, e.param...)
 }
 
 func (e *dependParamExpr) Name() string {
 	return "depend-expr"
 }
 
+type itemParamExpr struct {
+	param []string
+}
+
+func (e *itemParamExpr) Exec(buf *bytes.Buffer, ctx *Ctx) {
+	if len(e.param) == 0 {
+		buf.Write(ctx.Item)
+		return
+	}
+
+	value, _ := e.jsonValue(ctx)
+	buf.Write(value)
+}
+
+func (e *itemParamExpr) jsonValue(ctx *Ctx) ([]byte, jsonparser.ValueType) {
+	return getJSONValue(ctx.Item, e.param...)
+}
+
+func (e *itemParamExpr) Name() string {
+	return "item-expr"
+}
+
 type pathParamExpr struct {
 	param string
 }
@@ -439,6 +521,21 @@ func (e *pathParamExpr) Name() string {
 	return "param-expr"
 }
 
+// getJSONValue returns the value of the path in the json document, the string
+// value is the escaped content without quotes
+func getJSONValue(data []byte, path ...string) ([]byte, jsonparser.ValueType) {
+	if data == nil {
+		return nil, jsonparser.NotExist
+	}
+
+	value, vt, _, err := jsonparser.Get(data, path...)
+	if err != nil {
+		return nil, jsonparser.NotExist
+	}
+
+	return value, vt
+}
+
 func toStringSlice(values [][]byte) []string {
 	paths := make([]string, 0, len(values))
 	for _, value := range values {
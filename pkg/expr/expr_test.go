@@ -144,3 +144,64 @@ func TestExec(t *testing.T) {
 		t.Errorf("expect but %s", value)
 	}
 }
+
+func TestDepends(t *testing.T) {
+	exprs, err := Parse([]byte("/users/$(depend.user.id)/orders/$(depend.order.id)?name=$(depend.user.name)&q=$(origin.query)"))
+	if err != nil {
+		t.Errorf("expect no syntax error: %+v", err)
+	}
+
+	attrs := Depends(exprs...)
+	if len(attrs) != 2 || attrs[0] != "user" || attrs[1] != "order" {
+		t.Errorf("expect [user order] but %+v", attrs)
+	}
+
+	exprs, err = Parse([]byte("/users/$(origin.path)"))
+	if err != nil {
+		t.Errorf("expect no syntax error: %+v", err)
+	}
+
+	if attrs := Depends(exprs...); len(attrs) != 0 {
+		t.Errorf("expect no depends but %+v", attrs)
+	}
+}
+
+func TestExecItem(t *testing.T) {
+	exprs, err := Parse([]byte("/orders/$(item)"))
+	if err != nil {
+		t.Errorf("expect no syntax error: %+v", err)
+	}
+
+	value := Exec(&Ctx{Item: []byte("10")}, exprs...)
+	if string(value) != "/orders/10" {
+		t.Errorf("expect /orders/10 but %s", value)
+	}
+
+	exprs, err = Parse([]byte("/orders/$(item.order.id)"))
+	if err != nil {
+		t.Errorf("expect no syntax error: %+v", err)
+	}
+
+	value = Exec(&Ctx{Item: []byte(`{"order":{"id":11}}`)}, exprs...)
+	if string(value) != "/orders/11" {
+		t.Errorf("expect /orders/11 but %s", value)
+	}
+}
+
+func TestParseEscape(t *testing.T) {
+	exprs, err := Parse([]byte(`\(\$\)\\$(origin.path)`))
+	if err != nil {
+		t.Errorf("expect no syntax error: %+v", err)
+	}
+
+	if len(exprs) != 2 || exprs[0].Name() != "const-expr" || exprs[1].Name() != "origin-path-expr" {
+		t.Errorf("parse escape error, %+v", exprs)
+	}
+
+	req := fasthttp.AcquireRequest()
+	req.SetRequestURI("http://127.0.0.1/path")
+	value := Exec(&Ctx{Origin: req}, exprs...)
+	if string(value) != `($)\/path` {
+		t.Errorf(`expect ($)\/path but %s`, value)
+	}
+}
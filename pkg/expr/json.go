@@ -0,0 +1,136 @@
+package expr
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/buger/jsonparser"
+)
+
+var (
+	null = []byte("null")
+)
+
+// jsonValueExpr is the expr whose value is taken from a json document
+type jsonValueExpr interface {
+	jsonValue(ctx *Ctx) ([]byte, jsonparser.ValueType)
+}
+
+// ExecJSON returns the result of the exprs of a json template, the values are
+// written by the json context of the template, so they can not change the
+// structure of the template. A value in a json string is escaped as the content
+// of the string. Otherwise a json value is written as is, a missing value is
+// null, and the other value is written as a json string.
+func ExecJSON(ctx *Ctx, exprs ...Expr) []byte {
+	buf := bytes.NewBuffer(nil)
+	var tmp bytes.Buffer
+	inString := false
+
+	for _, expr := range exprs {
+		switch e := expr.(type) {
+		case *constExpr:
+			buf.Write(e.value)
+			inString = jsonStringState(e.value, inString)
+		case jsonValueExpr:
+			value, vt := e.jsonValue(ctx)
+			writeJSONValue(buf, value, vt, inString)
+		default:
+			tmp.Reset()
+			expr.Exec(&tmp, ctx)
+			writeJSONText(buf, tmp.Bytes(), inString)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// jsonStringState returns true if the end of the value is in a json string
+func jsonStringState(value []byte, inString bool) bool {
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			if inString {
+				i++
+			}
+		case '"':
+			inString = !inString
+		}
+	}
+
+	return inString
+}
+
+func writeJSONValue(buf *bytes.Buffer, value []byte, vt jsonparser.ValueType, inString bool) {
+	switch {
+	case vt == jsonparser.NotExist:
+		if !inString {
+			buf.Write(null)
+		}
+	case vt == jsonparser.String:
+		// the string value is escaped already
+		if inString {
+			buf.Write(value)
+			return
+		}
+
+		buf.WriteByte('"')
+		buf.Write(value)
+		buf.WriteByte('"')
+	case inString:
+		writeJSONEscaped(buf, value)
+	default:
+		buf.Write(value)
+	}
+}
+
+func writeJSONText(buf *bytes.Buffer, value []byte, inString bool) {
+	switch {
+	case inString:
+		writeJSONEscaped(buf, value)
+	case len(value) == 0:
+		buf.Write(null)
+	case isJSONValue(value):
+		buf.Write(value)
+	default:
+		buf.WriteByte('"')
+		writeJSONEscaped(buf, value)
+		buf.WriteByte('"')
+	}
+}
+
+// isJSONValue returns true if the value is a single json value
+func isJSONValue(value []byte) bool {
+	data, vt, offset, err := jsonparser.Get(value)
+	if err != nil || len(bytes.TrimSpace(value[offset:])) > 0 {
+		return false
+	}
+
+	switch vt {
+	case jsonparser.Number:
+		_, err = jsonparser.ParseFloat(data)
+		return err == nil
+	case jsonparser.Boolean:
+		_, err = jsonparser.ParseBoolean(data)
+		return err == nil
+	case jsonparser.Null:
+		return bytes.Equal(data, null)
+	case jsonparser.String, jsonparser.Object, jsonparser.Array:
+		return true
+	}
+
+	return false
+}
+
+func writeJSONEscaped(buf *bytes.Buffer, value []byte) {
+	for _, c := range value {
+		switch {
+		case c == '"' || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c < 0x20:
+			fmt.Fprintf(buf, `\u%04x`, c)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+}
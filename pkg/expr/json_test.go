@@ -0,0 +1,57 @@
+package expr
+
+import (
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestExecJSON(t *testing.T) {
+	template := `{"user":$(depend.user),"name":"$(depend.user.name)","id":"$(origin.query.id)","v":$(origin.query.v),"m":$(depend.missing)}`
+	cases := []struct {
+		uri    string
+		depend string
+		expect string
+	}{
+		{"http://127.0.0.1/path?id=1&v=2", `{"user":{"name":"a\"b"}}`,
+			`{"user":{"name":"a\"b"},"name":"a\"b","id":"1","v":2,"m":null}`},
+		{`http://127.0.0.1/path?id=1%22,%22admin%22:true,%22x%22:%22&v=true`, `{"user":{"name":"a"}}`,
+			`{"user":{"name":"a"},"name":"a","id":"1\",\"admin\":true,\"x\":\"","v":true,"m":null}`},
+		{`http://127.0.0.1/path?v=1,%22admin%22:true`, `{"user":1}`,
+			`{"user":1,"name":"","id":"","v":"1,\"admin\":true","m":null}`},
+		{"http://127.0.0.1/path?v=abc", `{"user":"a\"b"}`,
+			`{"user":"a\"b","name":"","id":"","v":"abc","m":null}`},
+		{"http://127.0.0.1/path", `{"user":{"id":1}}`,
+			`{"user":{"id":1},"name":"","id":"","v":null,"m":null}`},
+	}
+
+	exprs, err := Parse([]byte(template))
+	if err != nil {
+		t.Fatalf("expect no syntax error: %+v", err)
+	}
+
+	for _, c := range cases {
+		req := fasthttp.AcquireRequest()
+		req.SetRequestURI(c.uri)
+		value := ExecJSON(&Ctx{Origin: req, Depend: []byte(c.depend)}, exprs...)
+		if string(value) != c.expect {
+			t.Errorf("%s expect %s but %s", c.uri, c.expect, value)
+		}
+		fasthttp.ReleaseRequest(req)
+	}
+}
+
+func TestExecJSONInString(t *testing.T) {
+	exprs, err := Parse([]byte(`{"user":"$(depend.user)","s":"a\"$(origin.query.s)"}`))
+	if err != nil {
+		t.Fatalf("expect no syntax error: %+v", err)
+	}
+
+	req := fasthttp.AcquireRequest()
+	req.SetRequestURI("http://127.0.0.1/path?s=%22%5C%0A")
+	value := ExecJSON(&Ctx{Origin: req, Depend: []byte(`{"user":{"name":"a"}}`)}, exprs...)
+	expect := `{"user":"{\"name\":\"a\"}","s":"a\"\"\\\u000a"}`
+	if string(value) != expect {
+		t.Errorf("expect %s but %s", expect, value)
+	}
+}
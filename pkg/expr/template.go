@@ -0,0 +1,691 @@
+package expr
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/buger/jsonparser"
+	"github.com/fagongzi/util/hack"
+)
+
+// Template is a compiled render template expression, the expression is one of:
+// path:     $.a.b, $.a[0].b, $.a[*].b, @ is the current element in map
+// literal:  "abc", 10, 1.5, true, false, null
+// object:   {"name": $.a.name, "id": $.a.id}
+// array:    [$.a, $.b]
+// function: default(a, b...), concat(a, b...), format("{}-{}", a, b), number(a),
+// string(a), map(array, expr)
+type Template struct {
+	root tplNode
+}
+
+// ParseTemplate parse the render template expression
+func ParseTemplate(value string) (*Template, error) {
+	p := &tplParser{value: value}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.value) {
+		return nil, p.errorf("unexpected %q", p.value[p.pos])
+	}
+
+	return &Template{root: root}, nil
+}
+
+// Exec returns the json value of the template on the src, returns nil if the
+// value is missing.
+func (t *Template) Exec(src []byte) []byte {
+	return t.AppendExec(nil, src)
+}
+
+// AppendExec appends the json value of the template on the src to the dst, and
+// returns the extended dst, the dst is returned unchanged if the value is missing.
+// All the values are appended into the dst without the intermediate buffers.
+func (t *Template) AppendExec(dst, src []byte) []byte {
+	dst, _ = t.root.appendTo(dst, src, src)
+	return dst
+}
+
+type tplValue struct {
+	// data is the raw json value, the string value is the escaped content without quotes
+	data []byte
+	vt   jsonparser.ValueType
+}
+
+var (
+	missingValue = tplValue{vt: jsonparser.NotExist}
+	nullValue    = tplValue{data: []byte("null"), vt: jsonparser.Null}
+)
+
+func (v tplValue) appendTo(dst []byte) []byte {
+	if v.vt == jsonparser.String {
+		dst = append(dst, '"')
+		dst = append(dst, v.data...)
+		return append(dst, '"')
+	}
+
+	return append(dst, v.data...)
+}
+
+// text returns the string form of the value, used by number
+func (v tplValue) text() string {
+	switch v.vt {
+	case jsonparser.NotExist, jsonparser.Null:
+		return ""
+	case jsonparser.String:
+		if bytes.IndexByte(v.data, '\\') < 0 {
+			return hack.SliceToString(v.data)
+		}
+
+		value, err := jsonparser.ParseString(v.data)
+		if err != nil {
+			return hack.SliceToString(v.data)
+		}
+		return value
+	}
+
+	return hack.SliceToString(v.data)
+}
+
+// valueOf returns the value of the json written in the dst
+func valueOf(data []byte) tplValue {
+	switch data[0] {
+	case '"':
+		return tplValue{data: data[1 : len(data)-1], vt: jsonparser.String}
+	case '{':
+		return tplValue{data: data, vt: jsonparser.Object}
+	case '[':
+		return tplValue{data: data, vt: jsonparser.Array}
+	case 'n':
+		return tplValue{data: data, vt: jsonparser.Null}
+	case 't', 'f':
+		return tplValue{data: data, vt: jsonparser.Boolean}
+	}
+
+	return tplValue{data: data, vt: jsonparser.Number}
+}
+
+// eval appends the json value of the node to the dst, and returns the value which
+// is a sub slice of the dst. The caller truncates the dst after the value is used.
+func eval(dst []byte, n tplNode, src, current []byte) ([]byte, tplValue) {
+	mark := len(dst)
+	dst, ok := n.appendTo(dst, src, current)
+	if !ok {
+		return dst, missingValue
+	}
+
+	return dst, valueOf(dst[mark:])
+}
+
+// moveTo moves the dst[from:] to the dst[to:], and returns the truncated dst
+func moveTo(dst []byte, to, from int) []byte {
+	n := copy(dst[to:], dst[from:])
+	return dst[:to+n]
+}
+
+// appendText converts the json value in the dst[start:] to the escaped string
+// content, used by concat and format
+func appendText(dst []byte, start int) []byte {
+	switch dst[start] {
+	case '"':
+		n := copy(dst[start:], dst[start+1:len(dst)-1])
+		return dst[:start+n]
+	case 'n':
+		return dst[:start]
+	case '{', '[':
+		end := len(dst)
+		dst = appendEscape(dst, dst[start:end])
+		return moveTo(dst, start, end)
+	}
+
+	return dst
+}
+
+const hexChars = "0123456789abcdef"
+
+func appendEscape(dst []byte, value []byte) []byte {
+	for _, c := range value {
+		switch {
+		case c == '"' || c == '\\':
+			dst = append(dst, '\\', c)
+		case c == '\n':
+			dst = append(dst, '\\', 'n')
+		case c == '\r':
+			dst = append(dst, '\\', 'r')
+		case c == '\t':
+			dst = append(dst, '\\', 't')
+		case c < 0x20:
+			dst = append(dst, '\\', 'u', '0', '0', hexChars[c>>4], hexChars[c&0xf])
+		default:
+			dst = append(dst, c)
+		}
+	}
+
+	return dst
+}
+
+type tplNode interface {
+	// appendTo appends the json value of the node to the dst, returns the dst
+	// unchanged and false if the value is missing
+	appendTo(dst, src, current []byte) ([]byte, bool)
+}
+
+type tplStep struct {
+	key      string
+	wildcard bool
+}
+
+type tplPath struct {
+	current bool
+	steps   []tplStep
+}
+
+func (n *tplPath) appendTo(dst, src, current []byte) ([]byte, bool) {
+	data := src
+	if n.current {
+		data = current
+	}
+
+	value, vt, _, err := jsonparser.Get(data)
+	if err != nil {
+		return dst, false
+	}
+
+	return appendSteps(dst, value, vt, n.steps)
+}
+
+func appendSteps(dst, data []byte, vt jsonparser.ValueType, steps []tplStep) ([]byte, bool) {
+	for idx, step := range steps {
+		if step.wildcard {
+			if vt != jsonparser.Array {
+				return dst, false
+			}
+
+			mark := len(dst)
+			dst = append(dst, '[')
+			jsonparser.ArrayEach(data, func(value []byte, vt jsonparser.ValueType, offset int, err error) {
+				start := len(dst)
+				if start > mark+1 {
+					dst = append(dst, ',')
+				}
+
+				var ok bool
+				if dst, ok = appendSteps(dst, value, vt, steps[idx+1:]); !ok {
+					dst = dst[:start]
+				}
+			})
+			return append(dst, ']'), true
+		}
+
+		if vt != jsonparser.Object && vt != jsonparser.Array {
+			return dst, false
+		}
+
+		var err error
+		data, vt, _, err = jsonparser.Get(data, step.key)
+		if err != nil {
+			return dst, false
+		}
+	}
+
+	return tplValue{data: data, vt: vt}.appendTo(dst), true
+}
+
+type tplLiteral struct {
+	value tplValue
+}
+
+func (n *tplLiteral) appendTo(dst, src, current []byte) ([]byte, bool) {
+	return n.value.appendTo(dst), true
+}
+
+type tplObject struct {
+	keys   [][]byte
+	values []tplNode
+}
+
+func (n *tplObject) appendTo(dst, src, current []byte) ([]byte, bool) {
+	mark := len(dst)
+	dst = append(dst, '{')
+	for idx, node := range n.values {
+		start := len(dst)
+		if start > mark+1 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = append(dst, n.keys[idx]...)
+		dst = append(dst, '"', ':')
+
+		var ok bool
+		if dst, ok = node.appendTo(dst, src, current); !ok {
+			dst = dst[:start]
+		}
+	}
+
+	return append(dst, '}'), true
+}
+
+type tplArray struct {
+	values []tplNode
+}
+
+func (n *tplArray) appendTo(dst, src, current []byte) ([]byte, bool) {
+	mark := len(dst)
+	dst = append(dst, '[')
+	for _, node := range n.values {
+		start := len(dst)
+		if start > mark+1 {
+			dst = append(dst, ',')
+		}
+
+		var ok bool
+		if dst, ok = node.appendTo(dst, src, current); !ok {
+			dst = dst[:start]
+		}
+	}
+
+	return append(dst, ']'), true
+}
+
+type tplFunc struct {
+	name string
+	args []tplNode
+}
+
+var tplFuncArgs = map[string][2]int{
+	// name: min args, max args, -1 is unlimited
+	"default": {1, -1},
+	"concat":  {1, -1},
+	"format":  {1, -1},
+	"number":  {1, 1},
+	"string":  {1, 1},
+	"map":     {2, 2},
+}
+
+func (n *tplFunc) appendTo(dst, src, current []byte) ([]byte, bool) {
+	mark := len(dst)
+	switch n.name {
+	case "default":
+		for _, arg := range n.args {
+			var v tplValue
+			if dst, v = eval(dst, arg, src, current); v.vt != jsonparser.NotExist && v.vt != jsonparser.Null {
+				return dst, true
+			}
+			dst = dst[:mark]
+		}
+		return nullValue.appendTo(dst), true
+	case "concat":
+		dst = append(dst, '"')
+		for _, arg := range n.args {
+			start := len(dst)
+			var ok bool
+			if dst, ok = arg.appendTo(dst, src, current); ok {
+				dst = appendText(dst, start)
+			}
+		}
+		return append(dst, '"'), true
+	case "format":
+		return n.format(dst, src, current), true
+	case "number":
+		var v tplValue
+		dst, v = eval(dst, n.args[0], src, current)
+		switch v.vt {
+		case jsonparser.Number:
+			return dst, true
+		case jsonparser.String:
+			if value, err := strconv.ParseFloat(v.text(), 64); err == nil {
+				return strconv.AppendFloat(dst[:mark], value, 'f', -1, 64), true
+			}
+		}
+		return nullValue.appendTo(dst[:mark]), true
+	case "string":
+		var v tplValue
+		dst, v = eval(dst, n.args[0], src, current)
+		switch v.vt {
+		case jsonparser.String:
+			return dst, true
+		case jsonparser.NotExist, jsonparser.Null:
+			return nullValue.appendTo(dst[:mark]), true
+		}
+
+		// quote the text of the value
+		dst = appendText(dst, mark)
+		dst = append(dst, 0)
+		copy(dst[mark+1:], dst[mark:len(dst)-1])
+		dst[mark] = '"'
+		return append(dst, '"'), true
+	case "map":
+		var v tplValue
+		dst, v = eval(dst, n.args[0], src, current)
+		if v.vt != jsonparser.Array {
+			return dst[:mark], false
+		}
+
+		// the mapped values are appended after the array, and moved to the mark at last
+		values := len(dst)
+		dst = append(dst, '[')
+		jsonparser.ArrayEach(v.data, func(value []byte, vt jsonparser.ValueType, offset int, err error) {
+			start := len(dst)
+			if start > values+1 {
+				dst = append(dst, ',')
+			}
+
+			elem := len(dst)
+			if vt == jsonparser.String {
+				// keep the quotes, so the element is a valid json value
+				dst = append(dst, '"')
+				dst = append(dst, value...)
+				dst = append(dst, '"')
+				value = dst[elem:]
+			}
+
+			result := len(dst)
+			var ok bool
+			if dst, ok = n.args[1].appendTo(dst, src, value); !ok {
+				dst = dst[:start]
+				return
+			}
+			dst = moveTo(dst, elem, result)
+		})
+		dst = append(dst, ']')
+		return moveTo(dst, mark, values), true
+	}
+
+	return dst, false
+}
+
+// format replace the {} in the first arg with the following args in order, the
+// layout is appended after the dst, and replaced by the output at last
+func (n *tplFunc) format(dst, src, current []byte) []byte {
+	mark := len(dst)
+	dst, ok := n.args[0].appendTo(dst, src, current)
+	if ok {
+		dst = appendText(dst, mark)
+	}
+	layout := dst[mark:]
+	args := n.args[1:]
+
+	output := len(dst)
+	dst = append(dst, '"')
+	for {
+		idx := bytes.Index(layout, []byte("{}"))
+		if idx < 0 || len(args) == 0 {
+			dst = append(dst, layout...)
+			break
+		}
+
+		dst = append(dst, layout[:idx]...)
+		start := len(dst)
+		if dst, ok = args[0].appendTo(dst, src, current); ok {
+			dst = appendText(dst, start)
+		}
+		layout = layout[idx+2:]
+		args = args[1:]
+	}
+	dst = append(dst, '"')
+
+	return moveTo(dst, mark, output)
+}
+
+type tplParser struct {
+	value string
+	pos   int
+}
+
+func (p *tplParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("syntax error: template at %d, %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *tplParser) skipSpace() {
+	for p.pos < len(p.value) {
+		switch p.value[p.pos] {
+		case ' ', '\t', '\r', '\n':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *tplParser) peek() byte {
+	p.skipSpace()
+	if p.pos < len(p.value) {
+		return p.value[p.pos]
+	}
+
+	return eoi
+}
+
+func (p *tplParser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf("expect %q", c)
+	}
+
+	p.pos++
+	return nil
+}
+
+func (p *tplParser) parseExpr() (tplNode, error) {
+	c := p.peek()
+	switch {
+	case c == '$' || c == '@':
+		return p.parsePath()
+	case c == '"':
+		value, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return &tplLiteral{value: tplValue{data: value, vt: jsonparser.String}}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c == '{':
+		return p.parseObject()
+	case c == '[':
+		return p.parseArray()
+	case isIdentChar(c):
+		return p.parseIdent()
+	}
+
+	return nil, p.errorf("unexpected %q", c)
+}
+
+func (p *tplParser) parsePath() (tplNode, error) {
+	n := &tplPath{current: p.value[p.pos] == '@'}
+	p.pos++
+
+	for p.pos < len(p.value) {
+		switch p.value[p.pos] {
+		case '.':
+			p.pos++
+			start := p.pos
+			for p.pos < len(p.value) && isIdentChar(p.value[p.pos]) {
+				p.pos++
+			}
+			if start == p.pos {
+				return nil, p.errorf("missing path key")
+			}
+			n.steps = append(n.steps, tplStep{key: p.value[start:p.pos]})
+		case '[':
+			end := p.pos + 1
+			for end < len(p.value) && p.value[end] != ']' {
+				end++
+			}
+			if end == len(p.value) {
+				return nil, p.errorf("missing ]")
+			}
+
+			index := p.value[p.pos+1 : end]
+			if index == "*" {
+				n.steps = append(n.steps, tplStep{wildcard: true})
+			} else if _, err := strconv.ParseUint(index, 10, 32); err == nil {
+				n.steps = append(n.steps, tplStep{key: p.value[p.pos : end+1]})
+			} else {
+				return nil, p.errorf("invalid index %s", index)
+			}
+			p.pos = end + 1
+		default:
+			return n, nil
+		}
+	}
+
+	return n, nil
+}
+
+// parseString returns the escaped content of the string literal
+func (p *tplParser) parseString() ([]byte, error) {
+	start := p.pos + 1
+	for end := start; end < len(p.value); end++ {
+		switch p.value[end] {
+		case '\\':
+			end++
+		case '"':
+			value := []byte(p.value[start:end])
+			if _, err := jsonparser.ParseString(value); err != nil {
+				return nil, p.errorf("invalid string %s", p.value[p.pos:end+1])
+			}
+			p.pos = end + 1
+			return value, nil
+		}
+	}
+
+	return nil, p.errorf("missing \"")
+}
+
+func (p *tplParser) parseNumber() (tplNode, error) {
+	start := p.pos
+	for p.pos < len(p.value) && bytes.IndexByte([]byte("+-.0123456789eE"), p.value[p.pos]) >= 0 {
+		p.pos++
+	}
+
+	value := p.value[start:p.pos]
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return nil, p.errorf("invalid number %s", value)
+	}
+
+	return &tplLiteral{value: tplValue{data: []byte(value), vt: jsonparser.Number}}, nil
+}
+
+func (p *tplParser) parseObject() (tplNode, error) {
+	n := &tplObject{}
+	p.pos++
+	if p.peek() == '}' {
+		p.pos++
+		return n, nil
+	}
+
+	for {
+		if p.peek() != '"' {
+			return nil, p.errorf("expect object key")
+		}
+
+		key, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+
+		n.keys = append(n.keys, key)
+		n.values = append(n.values, value)
+
+		if p.peek() == '}' {
+			p.pos++
+			return n, nil
+		}
+
+		if err := p.expect(','); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *tplParser) parseArray() (tplNode, error) {
+	n := &tplArray{}
+	p.pos++
+	values, err := p.parseList(']')
+	if err != nil {
+		return nil, err
+	}
+
+	n.values = values
+	return n, nil
+}
+
+// parseList parse the exprs separated by comma until the end
+func (p *tplParser) parseList(end byte) ([]tplNode, error) {
+	var values []tplNode
+	if p.peek() == end {
+		p.pos++
+		return values, nil
+	}
+
+	for {
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		if p.peek() == end {
+			p.pos++
+			return values, nil
+		}
+
+		if err := p.expect(','); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *tplParser) parseIdent() (tplNode, error) {
+	start := p.pos
+	for p.pos < len(p.value) && isIdentChar(p.value[p.pos]) {
+		p.pos++
+	}
+
+	name := p.value[start:p.pos]
+	switch name {
+	case "true", "false":
+		return &tplLiteral{value: tplValue{data: []byte(name), vt: jsonparser.Boolean}}, nil
+	case "null":
+		return &tplLiteral{value: nullValue}, nil
+	}
+
+	limit, ok := tplFuncArgs[name]
+	if !ok {
+		return nil, p.errorf("not support function %s", name)
+	}
+
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	args, err := p.parseList(')')
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) < limit[0] || (limit[1] >= 0 && len(args) > limit[1]) {
+		return nil, p.errorf("function %s has invalid args", name)
+	}
+
+	return &tplFunc{name: name, args: args}, nil
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '-' ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9')
+}
@@ -0,0 +1,95 @@
+package expr
+
+import (
+	"testing"
+)
+
+func TestParseTemplate(t *testing.T) {
+	invalids := []string{
+		"",
+		"$.",
+		"$.a[x]",
+		"$.a[1",
+		`"abc`,
+		"1.2.3",
+		`{"a" $.a}`,
+		`{a: $.a}`,
+		"[$.a,",
+		"unknown($.a)",
+		"number($.a, $.b)",
+		"map($.a)",
+		"$.a $.b",
+	}
+
+	for _, value := range invalids {
+		if _, err := ParseTemplate(value); err == nil {
+			t.Errorf("expect syntax error: %s", value)
+		}
+	}
+}
+
+func TestExecTemplate(t *testing.T) {
+	src := []byte(`{"user":{"name":"zhang\"san","age":"18","tags":["a","b"]},"orders":[{"id":1,"title":"t1"},{"id":2}]}`)
+	cases := []struct {
+		template string
+		expect   string
+	}{
+		{`$.user.name`, `"zhang\"san"`},
+		{`$.user.age`, `"18"`},
+		{`number($.user.age)`, `18`},
+		{`string($.orders[0].id)`, `"1"`},
+		{`$.orders[1].id`, `2`},
+		{`$.orders[*].id`, `[1,2]`},
+		{`$.orders[*].title`, `["t1"]`},
+		{`$.user.nick`, ``},
+		{`default($.user.nick, "anonymous")`, `"anonymous"`},
+		{`default($.user.nick)`, `null`},
+		{`"literal"`, `"literal"`},
+		{`-1.5`, `-1.5`},
+		{`true`, `true`},
+		{`concat($.user.name, "-", $.orders[0].id)`, `"zhang\"san-1"`},
+		{`format("{}: {} orders", $.user.name, 2)`, `"zhang\"san: 2 orders"`},
+		{`map($.orders, {"key": @.id, "name": default(@.title, "none")})`, `[{"key":1,"name":"t1"},{"key":2,"name":"none"}]`},
+		{`map($.user.tags, concat("tag-", @))`, `["tag-a","tag-b"]`},
+		{`{"name": $.user.name, "nick": $.user.nick, "ids": [$.orders[0].id, $.orders[1].id]}`, `{"name":"zhang\"san","ids":[1,2]}`},
+		{`string($.orders[1])`, `"{\"id\":2}"`},
+		{`string(true)`, `"true"`},
+		{`number($.user.name)`, `null`},
+		{`number("1.50")`, `1.5`},
+		{`concat($.user.tags, $.user.nick, null, 1)`, `"[\"a\",\"b\"]1"`},
+		{`format("{}-{}-{}", $.user.age)`, `"18-{}-{}"`},
+		{`format($.user.nick, 1)`, `""`},
+		{`map($.orders[*].id, string(@))`, `["1","2"]`},
+		{`map($.user.name, @)`, ``},
+		{`[map($.user.tags, @), $.user.nick, format("{}", map($.user.tags, @))]`, `[["a","b"],"[\"a\",\"b\"]"]`},
+	}
+
+	for _, c := range cases {
+		tpl, err := ParseTemplate(c.template)
+		if err != nil {
+			t.Errorf("%s: expect no syntax error: %+v", c.template, err)
+			continue
+		}
+
+		if value := tpl.Exec(src); string(value) != c.expect {
+			t.Errorf("%s: expect %s but %s", c.template, c.expect, value)
+		}
+	}
+}
+
+func BenchmarkTemplateExec(b *testing.B) {
+	src := []byte(`{"user":{"name":"zhang\"san","age":"18","tags":["a","b"]},"orders":[{"id":1,"title":"t1"},{"id":2}]}`)
+	tpl, err := ParseTemplate(`{"name": concat($.user.name, "-", $.user.age), "age": number($.user.age), ` +
+		`"tags": map($.user.tags, format("tag-{}", @)), "ids": $.orders[*].id, ` +
+		`"orders": map($.orders, {"key": string(@.id), "name": default(@.title, "none")})}`)
+	if err != nil {
+		b.Fatalf("expect no syntax error: %+v", err)
+	}
+
+	var buf []byte
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = tpl.AppendExec(buf[:0], src)
+	}
+}
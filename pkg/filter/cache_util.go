@@ -8,6 +8,13 @@ import (
 // NewCachedValue returns a cached value
 func NewCachedValue(resp *fasthttp.Response) *goetty.ByteBuf {
 	buf := goetty.NewByteBuf(128)
+	WriteCachedValue(buf, resp)
+	return buf
+}
+
+// WriteCachedValue write the response as a cached value to the buf
+func WriteCachedValue(buf *goetty.ByteBuf, resp *fasthttp.Response) {
+	idx := buf.GetWriteIndex()
 	buf.WriteInt(0)
 	n := 0
 	resp.Header.VisitAll(func(key, value []byte) {
@@ -20,22 +27,34 @@ func NewCachedValue(resp *fasthttp.Response) *goetty.ByteBuf {
 	buf.WriteInt(len(resp.Body()))
 	buf.Write(resp.Body())
 
-	goetty.Int2BytesTo(n, buf.RawBuf())
-	return buf
+	goetty.Int2BytesTo(n, buf.RawBuf()[idx:])
 }
 
-// ReadCachedValueTo read cached value to response
+// ReadCachedValueTo read cached value to response, the buf is not consumed,
+// so the cached value can be read concurrently and repeatedly
 func ReadCachedValueTo(buf *goetty.ByteBuf, resp *fasthttp.Response) {
-	headers, _ := buf.ReadInt()
+	ReadCachedBytesTo(buf.RawBuf()[buf.GetReaderIndex():buf.GetWriteIndex()], resp)
+}
+
+// ReadCachedBytesTo read the bytes of cached value to response
+func ReadCachedBytesTo(data []byte, resp *fasthttp.Response) {
+	headers, data := readInt(data)
 	for i := 0; i < headers; i++ {
-		resp.Header.SetBytesKV(readBytes(buf), readBytes(buf))
+		var key, value []byte
+		key, data = readBytes(data)
+		value, data = readBytes(data)
+		resp.Header.SetBytesKV(key, value)
 	}
 
-	resp.SetBody(readBytes(buf))
+	body, _ := readBytes(data)
+	resp.SetBody(body)
+}
+
+func readInt(data []byte) (int, []byte) {
+	return goetty.Byte2Int(data[:4]), data[4:]
 }
 
-func readBytes(buf *goetty.ByteBuf) []byte {
-	n, _ := buf.ReadInt()
-	_, value, _ := buf.ReadBytes(n)
-	return value
+func readBytes(data []byte) ([]byte, []byte) {
+	n, data := readInt(data)
+	return data[:n], data[n:]
 }
@@ -215,6 +215,7 @@ const (
 	Header      Source = 3
 	Cookie      Source = 4
 	PathValue   Source = 5
+	JWTClaim    Source = 6
 )
 
 var Source_name = map[int32]string{
@@ -224,6 +225,7 @@ var Source_name = map[int32]string{
 	3: "Header",
 	4: "Cookie",
 	5: "PathValue",
+	6: "JWTClaim",
 }
 
 var Source_value = map[string]int32{
@@ -233,6 +235,7 @@ var Source_value = map[string]int32{
 	"Header":      3,
 	"Cookie":      4,
 	"PathValue":   5,
+	"JWTClaim":    6,
 }
 
 func (x Source) Enum() *Source {
@@ -298,33 +301,69 @@ func (RuleType) EnumDescriptor() ([]byte, []int) {
 type CMP int32
 
 const (
-	CMPEQ    CMP = 0
-	CMPLT    CMP = 1
-	CMPLE    CMP = 2
-	CMPGT    CMP = 3
-	CMPGE    CMP = 4
-	CMPIn    CMP = 5
-	CMPMatch CMP = 6
+	CMPEQ        CMP = 0
+	CMPLT        CMP = 1
+	CMPLE        CMP = 2
+	CMPGT        CMP = 3
+	CMPGE        CMP = 4
+	CMPIn        CMP = 5
+	CMPMatch     CMP = 6
+	CMPNE        CMP = 7
+	CMPPrefix    CMP = 8
+	CMPSuffix    CMP = 9
+	CMPExists    CMP = 10
+	CMPNotExists CMP = 11
+	CMPInSet     CMP = 12
+	CMPCIDR      CMP = 13
+	CMPVersionEQ CMP = 14
+	CMPVersionLT CMP = 15
+	CMPVersionLE CMP = 16
+	CMPVersionGT CMP = 17
+	CMPVersionGE CMP = 18
 )
 
 var CMP_name = map[int32]string{
-	0: "CMPEQ",
-	1: "CMPLT",
-	2: "CMPLE",
-	3: "CMPGT",
-	4: "CMPGE",
-	5: "CMPIn",
-	6: "CMPMatch",
+	0:  "CMPEQ",
+	1:  "CMPLT",
+	2:  "CMPLE",
+	3:  "CMPGT",
+	4:  "CMPGE",
+	5:  "CMPIn",
+	6:  "CMPMatch",
+	7:  "CMPNE",
+	8:  "CMPPrefix",
+	9:  "CMPSuffix",
+	10: "CMPExists",
+	11: "CMPNotExists",
+	12: "CMPInSet",
+	13: "CMPCIDR",
+	14: "CMPVersionEQ",
+	15: "CMPVersionLT",
+	16: "CMPVersionLE",
+	17: "CMPVersionGT",
+	18: "CMPVersionGE",
 }
 
 var CMP_value = map[string]int32{
-	"CMPEQ":    0,
-	"CMPLT":    1,
-	"CMPLE":    2,
-	"CMPGT":    3,
-	"CMPGE":    4,
-	"CMPIn":    5,
-	"CMPMatch": 6,
+	"CMPEQ":        0,
+	"CMPLT":        1,
+	"CMPLE":        2,
+	"CMPGT":        3,
+	"CMPGE":        4,
+	"CMPIn":        5,
+	"CMPMatch":     6,
+	"CMPNE":        7,
+	"CMPPrefix":    8,
+	"CMPSuffix":    9,
+	"CMPExists":    10,
+	"CMPNotExists": 11,
+	"CMPInSet":     12,
+	"CMPCIDR":      13,
+	"CMPVersionEQ": 14,
+	"CMPVersionLT": 15,
+	"CMPVersionLE": 16,
+	"CMPVersionGT": 17,
+	"CMPVersionGE": 18,
 }
 
 func (x CMP) Enum() *CMP {
@@ -350,6 +389,239 @@ func (CMP) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_77b4d575d5a68dda, []int{6}
 }
 
+type Logic int32
+
+const (
+	LogicAnd Logic = 0
+	LogicOr  Logic = 1
+	LogicNot Logic = 2
+)
+
+var Logic_name = map[int32]string{
+	0: "LogicAnd",
+	1: "LogicOr",
+	2: "LogicNot",
+}
+
+var Logic_value = map[string]int32{
+	"LogicAnd": 0,
+	"LogicOr":  1,
+	"LogicNot": 2,
+}
+
+func (x Logic) Enum() *Logic {
+	p := new(Logic)
+	*p = x
+	return p
+}
+
+func (x Logic) String() string {
+	return proto.EnumName(Logic_name, int32(x))
+}
+
+func (x *Logic) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Logic_value, data, "Logic")
+	if err != nil {
+		return err
+	}
+	*x = Logic(value)
+	return nil
+}
+
+func (Logic) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{7}
+}
+
+type TransformTarget int32
+
+const (
+	RequestHeader  TransformTarget = 0
+	RequestQuery   TransformTarget = 1
+	RequestCookie  TransformTarget = 2
+	ResponseHeader TransformTarget = 3
+)
+
+var TransformTarget_name = map[int32]string{
+	0: "RequestHeader",
+	1: "RequestQuery",
+	2: "RequestCookie",
+	3: "ResponseHeader",
+}
+
+var TransformTarget_value = map[string]int32{
+	"RequestHeader":  0,
+	"RequestQuery":   1,
+	"RequestCookie":  2,
+	"ResponseHeader": 3,
+}
+
+func (x TransformTarget) Enum() *TransformTarget {
+	p := new(TransformTarget)
+	*p = x
+	return p
+}
+
+func (x TransformTarget) String() string {
+	return proto.EnumName(TransformTarget_name, int32(x))
+}
+
+func (x *TransformTarget) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TransformTarget_value, data, "TransformTarget")
+	if err != nil {
+		return err
+	}
+	*x = TransformTarget(value)
+	return nil
+}
+
+func (TransformTarget) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{8}
+}
+
+type TransformAction int32
+
+const (
+	TransformSet    TransformAction = 0
+	TransformAppend TransformAction = 1
+	TransformRemove TransformAction = 2
+	TransformRename TransformAction = 3
+)
+
+var TransformAction_name = map[int32]string{
+	0: "TransformSet",
+	1: "TransformAppend",
+	2: "TransformRemove",
+	3: "TransformRename",
+}
+
+var TransformAction_value = map[string]int32{
+	"TransformSet":    0,
+	"TransformAppend": 1,
+	"TransformRemove": 2,
+	"TransformRename": 3,
+}
+
+func (x TransformAction) Enum() *TransformAction {
+	p := new(TransformAction)
+	*p = x
+	return p
+}
+
+func (x TransformAction) String() string {
+	return proto.EnumName(TransformAction_name, int32(x))
+}
+
+func (x *TransformAction) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TransformAction_value, data, "TransformAction")
+	if err != nil {
+		return err
+	}
+	*x = TransformAction(value)
+	return nil
+}
+
+func (TransformAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{9}
+}
+
+// ResponseDecoder convert the response of the dispatch node into json, the
+// DecodeAuto select the decoder by the content type
+type ResponseDecoder int32
+
+const (
+	DecodeAuto     ResponseDecoder = 0
+	DecodeNone     ResponseDecoder = 1
+	DecodeXML      ResponseDecoder = 2
+	DecodeForm     ResponseDecoder = 3
+	DecodeText     ResponseDecoder = 4
+	DecodeProtobuf ResponseDecoder = 5
+)
+
+var ResponseDecoder_name = map[int32]string{
+	0: "DecodeAuto",
+	1: "DecodeNone",
+	2: "DecodeXML",
+	3: "DecodeForm",
+	4: "DecodeText",
+	5: "DecodeProtobuf",
+}
+
+var ResponseDecoder_value = map[string]int32{
+	"DecodeAuto":     0,
+	"DecodeNone":     1,
+	"DecodeXML":      2,
+	"DecodeForm":     3,
+	"DecodeText":     4,
+	"DecodeProtobuf": 5,
+}
+
+func (x ResponseDecoder) Enum() *ResponseDecoder {
+	p := new(ResponseDecoder)
+	*p = x
+	return p
+}
+
+func (x ResponseDecoder) String() string {
+	return proto.EnumName(ResponseDecoder_name, int32(x))
+}
+
+func (x *ResponseDecoder) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ResponseDecoder_value, data, "ResponseDecoder")
+	if err != nil {
+		return err
+	}
+	*x = ResponseDecoder(value)
+	return nil
+}
+
+func (ResponseDecoder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{10}
+}
+
+// FailurePolicy is the policy of a failed dispatch node of the aggregated api
+type FailurePolicy int32
+
+const (
+	FailureRequired          FailurePolicy = 0
+	FailureOptional          FailurePolicy = 1
+	FailureOptionalWithError FailurePolicy = 2
+)
+
+var FailurePolicy_name = map[int32]string{
+	0: "FailureRequired",
+	1: "FailureOptional",
+	2: "FailureOptionalWithError",
+}
+
+var FailurePolicy_value = map[string]int32{
+	"FailureRequired":          0,
+	"FailureOptional":          1,
+	"FailureOptionalWithError": 2,
+}
+
+func (x FailurePolicy) Enum() *FailurePolicy {
+	p := new(FailurePolicy)
+	*p = x
+	return p
+}
+
+func (x FailurePolicy) String() string {
+	return proto.EnumName(FailurePolicy_name, int32(x))
+}
+
+func (x *FailurePolicy) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FailurePolicy_value, data, "FailurePolicy")
+	if err != nil {
+		return err
+	}
+	*x = FailurePolicy(value)
+	return nil
+}
+
+func (FailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{11}
+}
+
 type RoutingStrategy int32
 
 const (
@@ -387,7 +659,54 @@ func (x *RoutingStrategy) UnmarshalJSON(data []byte) error {
 }
 
 func (RoutingStrategy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{7}
+	return fileDescriptor_77b4d575d5a68dda, []int{12}
+}
+
+// RolloutState is the state of the progressive rollout
+type RolloutState int32
+
+const (
+	RolloutRunning    RolloutState = 0
+	RolloutSucceeded  RolloutState = 1
+	RolloutRolledBack RolloutState = 2
+	RolloutPaused     RolloutState = 3
+)
+
+var RolloutState_name = map[int32]string{
+	0: "RolloutRunning",
+	1: "RolloutSucceeded",
+	2: "RolloutRolledBack",
+	3: "RolloutPaused",
+}
+
+var RolloutState_value = map[string]int32{
+	"RolloutRunning":    0,
+	"RolloutSucceeded":  1,
+	"RolloutRolledBack": 2,
+	"RolloutPaused":     3,
+}
+
+func (x RolloutState) Enum() *RolloutState {
+	p := new(RolloutState)
+	*p = x
+	return p
+}
+
+func (x RolloutState) String() string {
+	return proto.EnumName(RolloutState_name, int32(x))
+}
+
+func (x *RolloutState) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(RolloutState_value, data, "RolloutState")
+	if err != nil {
+		return err
+	}
+	*x = RolloutState(value)
+	return nil
+}
+
+func (RolloutState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{13}
 }
 
 type MatchRule int32
@@ -430,7 +749,7 @@ func (x *MatchRule) UnmarshalJSON(data []byte) error {
 }
 
 func (MatchRule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{8}
+	return fileDescriptor_77b4d575d5a68dda, []int{14}
 }
 
 type HostType int32
@@ -473,7 +792,7 @@ func (x *HostType) UnmarshalJSON(data []byte) error {
 }
 
 func (HostType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{9}
+	return fileDescriptor_77b4d575d5a68dda, []int{15}
 }
 
 type RateLimitOption int32
@@ -513,7 +832,7 @@ func (x *RateLimitOption) UnmarshalJSON(data []byte) error {
 }
 
 func (RateLimitOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{10}
+	return fileDescriptor_77b4d575d5a68dda, []int{16}
 }
 
 // PluginType plugin type enum
@@ -551,13 +870,15 @@ func (x *PluginType) UnmarshalJSON(data []byte) error {
 }
 
 func (PluginType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{11}
+	return fileDescriptor_77b4d575d5a68dda, []int{17}
 }
 
 // Proxy is a meta data of the gateway proxy
 type Proxy struct {
-	Addr                 string   `protobuf:"bytes,1,opt,name=addr" json:"addr"`
-	AddrRPC              string   `protobuf:"bytes,2,opt,name=addrRPC" json:"addrRPC"`
+	Addr    string `protobuf:"bytes,1,opt,name=addr" json:"addr"`
+	AddrRPC string `protobuf:"bytes,2,opt,name=addrRPC" json:"addrRPC"`
+	// cacheStorage is the id of the shared cache storage, empty if the cache is local
+	CacheStorage         string   `protobuf:"bytes,3,opt,name=cacheStorage" json:"cacheStorage"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -610,6 +931,13 @@ func (m *Proxy) GetAddrRPC() string {
 	return ""
 }
 
+func (m *Proxy) GetCacheStorage() string {
+	if m != nil {
+		return m.CacheStorage
+	}
+	return ""
+}
+
 // Cluster is a set of server has same interface
 type Cluster struct {
 	ID                   uint64      `protobuf:"varint,1,opt,name=id" json:"id"`
@@ -1295,6 +1623,7 @@ type Validation struct {
 	Parameter            Parameter        `protobuf:"bytes,1,opt,name=parameter" json:"parameter"`
 	Required             bool             `protobuf:"varint,2,opt,name=required" json:"required"`
 	Rules                []ValidationRule `protobuf:"bytes,3,rep,name=rules" json:"rules"`
+	Conditions           []Condition      `protobuf:"bytes,4,rep,name=conditions" json:"conditions"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1354,6 +1683,13 @@ func (m *Validation) GetRules() []ValidationRule {
 	return nil
 }
 
+func (m *Validation) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
 // RetryStrategy retry strategy
 type RetryStrategy struct {
 	Interval             int32    `protobuf:"varint,1,opt,name=interval" json:"interval"`
@@ -1420,22 +1756,30 @@ func (m *RetryStrategy) GetCodes() []int32 {
 
 // DispatchNode is the request forward to
 type DispatchNode struct {
-	ClusterID            uint64         `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
-	URLRewrite           string         `protobuf:"bytes,2,opt,name=urlRewrite" json:"urlRewrite"`
-	AttrName             string         `protobuf:"bytes,3,opt,name=attrName" json:"attrName"`
-	Validations          []*Validation  `protobuf:"bytes,4,rep,name=validations" json:"validations,omitempty"`
-	Cache                *Cache         `protobuf:"bytes,5,opt,name=cache" json:"cache,omitempty"`
-	DefaultValue         *HTTPResult    `protobuf:"bytes,6,opt,name=defaultValue" json:"defaultValue,omitempty"`
-	UseDefault           bool           `protobuf:"varint,7,opt,name=useDefault" json:"useDefault"`
-	BatchIndex           int32          `protobuf:"varint,8,opt,name=batchIndex" json:"batchIndex"`
-	RetryStrategy        *RetryStrategy `protobuf:"bytes,9,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
-	WriteTimeout         int64          `protobuf:"varint,10,opt,name=writeTimeout" json:"writeTimeout"`
-	ReadTimeout          int64          `protobuf:"varint,11,opt,name=readTimeout" json:"readTimeout"`
-	HostType             HostType       `protobuf:"varint,12,opt,name=hostType,enum=metapb.HostType" json:"hostType"`
-	CustemHost           string         `protobuf:"bytes,13,opt,name=custemHost" json:"custemHost"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	ClusterID            uint64              `protobuf:"varint,1,opt,name=clusterID" json:"clusterID"`
+	URLRewrite           string              `protobuf:"bytes,2,opt,name=urlRewrite" json:"urlRewrite"`
+	AttrName             string              `protobuf:"bytes,3,opt,name=attrName" json:"attrName"`
+	Validations          []*Validation       `protobuf:"bytes,4,rep,name=validations" json:"validations,omitempty"`
+	Cache                *Cache              `protobuf:"bytes,5,opt,name=cache" json:"cache,omitempty"`
+	DefaultValue         *HTTPResult         `protobuf:"bytes,6,opt,name=defaultValue" json:"defaultValue,omitempty"`
+	UseDefault           bool                `protobuf:"varint,7,opt,name=useDefault" json:"useDefault"`
+	BatchIndex           int32               `protobuf:"varint,8,opt,name=batchIndex" json:"batchIndex"`
+	RetryStrategy        *RetryStrategy      `protobuf:"bytes,9,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	WriteTimeout         int64               `protobuf:"varint,10,opt,name=writeTimeout" json:"writeTimeout"`
+	ReadTimeout          int64               `protobuf:"varint,11,opt,name=readTimeout" json:"readTimeout"`
+	HostType             HostType            `protobuf:"varint,12,opt,name=hostType,enum=metapb.HostType" json:"hostType"`
+	CustemHost           string              `protobuf:"bytes,13,opt,name=custemHost" json:"custemHost"`
+	Transformations      []*Transformation   `protobuf:"bytes,14,rep,name=transformations" json:"transformations,omitempty"`
+	BodyTemplate         string              `protobuf:"bytes,15,opt,name=bodyTemplate" json:"bodyTemplate"`
+	Method               string              `protobuf:"bytes,16,opt,name=method" json:"method"`
+	ContentType          string              `protobuf:"bytes,17,opt,name=contentType" json:"contentType"`
+	FailurePolicy        FailurePolicy       `protobuf:"varint,18,opt,name=failurePolicy,enum=metapb.FailurePolicy" json:"failurePolicy"`
+	ForEach              *ForEach            `protobuf:"bytes,19,opt,name=forEach" json:"forEach,omitempty"`
+	Decoder              ResponseDecoder     `protobuf:"varint,20,opt,name=decoder,enum=metapb.ResponseDecoder" json:"decoder"`
+	Protobuf             *ProtobufDescriptor `protobuf:"bytes,21,opt,name=protobuf" json:"protobuf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *DispatchNode) Reset()         { *m = DispatchNode{} }
@@ -1562,90 +1906,84 @@ func (m *DispatchNode) GetCustemHost() string {
 	return ""
 }
 
-// Cache is used for cache api result
-type Cache struct {
-	Keys                 []Parameter `protobuf:"bytes,1,rep,name=keys" json:"keys"`
-	Deadline             uint64      `protobuf:"varint,2,opt,name=deadline" json:"deadline"`
-	Conditions           []Condition `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *DispatchNode) GetTransformations() []*Transformation {
+	if m != nil {
+		return m.Transformations
+	}
+	return nil
 }
 
-func (m *Cache) Reset()         { *m = Cache{} }
-func (m *Cache) String() string { return proto.CompactTextString(m) }
-func (*Cache) ProtoMessage()    {}
-func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{14}
-}
-func (m *Cache) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Cache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Cache.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *DispatchNode) GetBodyTemplate() string {
+	if m != nil {
+		return m.BodyTemplate
 	}
+	return ""
 }
-func (m *Cache) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Cache.Merge(m, src)
-}
-func (m *Cache) XXX_Size() int {
-	return m.Size()
+
+func (m *DispatchNode) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
-func (m *Cache) XXX_DiscardUnknown() {
-	xxx_messageInfo_Cache.DiscardUnknown(m)
+
+func (m *DispatchNode) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
 }
 
-var xxx_messageInfo_Cache proto.InternalMessageInfo
+func (m *DispatchNode) GetFailurePolicy() FailurePolicy {
+	if m != nil {
+		return m.FailurePolicy
+	}
+	return FailureRequired
+}
 
-func (m *Cache) GetKeys() []Parameter {
+func (m *DispatchNode) GetForEach() *ForEach {
 	if m != nil {
-		return m.Keys
+		return m.ForEach
 	}
 	return nil
 }
 
-func (m *Cache) GetDeadline() uint64 {
+func (m *DispatchNode) GetDecoder() ResponseDecoder {
 	if m != nil {
-		return m.Deadline
+		return m.Decoder
 	}
-	return 0
+	return DecodeAuto
 }
 
-func (m *Cache) GetConditions() []Condition {
+func (m *DispatchNode) GetProtobuf() *ProtobufDescriptor {
 	if m != nil {
-		return m.Conditions
+		return m.Protobuf
 	}
 	return nil
 }
 
-// RenderTemplate the template that render to client
-type RenderTemplate struct {
-	Objects              []*RenderObject `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// ProtobufDescriptor is the descriptor of the protobuf response, the
+// descriptorSet is a serialized FileDescriptorSet, e.g. protoc --descriptor_set_out
+type ProtobufDescriptor struct {
+	DescriptorSet        []byte   `protobuf:"bytes,1,opt,name=descriptorSet" json:"descriptorSet,omitempty"`
+	MessageType          string   `protobuf:"bytes,2,opt,name=messageType" json:"messageType"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RenderTemplate) Reset()         { *m = RenderTemplate{} }
-func (m *RenderTemplate) String() string { return proto.CompactTextString(m) }
-func (*RenderTemplate) ProtoMessage()    {}
-func (*RenderTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{15}
+func (m *ProtobufDescriptor) Reset()         { *m = ProtobufDescriptor{} }
+func (m *ProtobufDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ProtobufDescriptor) ProtoMessage()    {}
+func (*ProtobufDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{14}
 }
-func (m *RenderTemplate) XXX_Unmarshal(b []byte) error {
+func (m *ProtobufDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RenderTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProtobufDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RenderTemplate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProtobufDescriptor.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1655,47 +1993,56 @@ func (m *RenderTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *RenderTemplate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenderTemplate.Merge(m, src)
+func (m *ProtobufDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtobufDescriptor.Merge(m, src)
 }
-func (m *RenderTemplate) XXX_Size() int {
+func (m *ProtobufDescriptor) XXX_Size() int {
 	return m.Size()
 }
-func (m *RenderTemplate) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenderTemplate.DiscardUnknown(m)
+func (m *ProtobufDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtobufDescriptor.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RenderTemplate proto.InternalMessageInfo
+var xxx_messageInfo_ProtobufDescriptor proto.InternalMessageInfo
 
-func (m *RenderTemplate) GetObjects() []*RenderObject {
+func (m *ProtobufDescriptor) GetDescriptorSet() []byte {
 	if m != nil {
-		return m.Objects
+		return m.DescriptorSet
 	}
 	return nil
 }
 
-// RenderObject the object in the render template
-type RenderObject struct {
-	Name                 string        `protobuf:"bytes,1,opt,name=name" json:"name"`
-	Attrs                []*RenderAttr `protobuf:"bytes,2,rep,name=attrs" json:"attrs,omitempty"`
-	FlatAttrs            bool          `protobuf:"varint,3,opt,name=flatAttrs" json:"flatAttrs"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *RenderObject) Reset()         { *m = RenderObject{} }
-func (m *RenderObject) String() string { return proto.CompactTextString(m) }
-func (*RenderObject) ProtoMessage()    {}
-func (*RenderObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{16}
+func (m *ProtobufDescriptor) GetMessageType() string {
+	if m != nil {
+		return m.MessageType
+	}
+	return ""
 }
-func (m *RenderObject) XXX_Unmarshal(b []byte) error {
+
+// ForEach dispatch one request per element of the array in the result of a
+// previous node, the path is the json path of the array, the first element of
+// the path is the attr of the previous node
+type ForEach struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path" json:"path"`
+	Concurrency          int32    `protobuf:"varint,2,opt,name=concurrency" json:"concurrency"`
+	MaxElements          int32    `protobuf:"varint,3,opt,name=maxElements" json:"maxElements"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForEach) Reset()         { *m = ForEach{} }
+func (m *ForEach) String() string { return proto.CompactTextString(m) }
+func (*ForEach) ProtoMessage()    {}
+func (*ForEach) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{15}
+}
+func (m *ForEach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RenderObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ForEach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RenderObject.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ForEach.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1705,60 +2052,63 @@ func (m *RenderObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RenderObject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenderObject.Merge(m, src)
+func (m *ForEach) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForEach.Merge(m, src)
 }
-func (m *RenderObject) XXX_Size() int {
+func (m *ForEach) XXX_Size() int {
 	return m.Size()
 }
-func (m *RenderObject) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenderObject.DiscardUnknown(m)
+func (m *ForEach) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForEach.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RenderObject proto.InternalMessageInfo
+var xxx_messageInfo_ForEach proto.InternalMessageInfo
 
-func (m *RenderObject) GetName() string {
+func (m *ForEach) GetPath() string {
 	if m != nil {
-		return m.Name
+		return m.Path
 	}
 	return ""
 }
 
-func (m *RenderObject) GetAttrs() []*RenderAttr {
+func (m *ForEach) GetConcurrency() int32 {
 	if m != nil {
-		return m.Attrs
+		return m.Concurrency
 	}
-	return nil
+	return 0
 }
 
-func (m *RenderObject) GetFlatAttrs() bool {
+func (m *ForEach) GetMaxElements() int32 {
 	if m != nil {
-		return m.FlatAttrs
+		return m.MaxElements
 	}
-	return false
+	return 0
 }
 
-// RenderAttr the attr in the render object
-type RenderAttr struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name"`
-	ExtractExp           string   `protobuf:"bytes,2,opt,name=extractExp" json:"extractExp"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Transformation is a transformation of the request or the response, the value
+// is a expr for set and append, and is the new name for rename.
+type Transformation struct {
+	Target               TransformTarget `protobuf:"varint,1,opt,name=target,enum=metapb.TransformTarget" json:"target"`
+	Action               TransformAction `protobuf:"varint,2,opt,name=action,enum=metapb.TransformAction" json:"action"`
+	Name                 string          `protobuf:"bytes,3,opt,name=name" json:"name"`
+	Value                string          `protobuf:"bytes,4,opt,name=value" json:"value"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *RenderAttr) Reset()         { *m = RenderAttr{} }
-func (m *RenderAttr) String() string { return proto.CompactTextString(m) }
-func (*RenderAttr) ProtoMessage()    {}
-func (*RenderAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{17}
+func (m *Transformation) Reset()         { *m = Transformation{} }
+func (m *Transformation) String() string { return proto.CompactTextString(m) }
+func (*Transformation) ProtoMessage()    {}
+func (*Transformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{16}
 }
-func (m *RenderAttr) XXX_Unmarshal(b []byte) error {
+func (m *Transformation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RenderAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Transformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RenderAttr.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Transformation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1768,73 +2118,78 @@ func (m *RenderAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RenderAttr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenderAttr.Merge(m, src)
+func (m *Transformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transformation.Merge(m, src)
 }
-func (m *RenderAttr) XXX_Size() int {
+func (m *Transformation) XXX_Size() int {
 	return m.Size()
 }
-func (m *RenderAttr) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenderAttr.DiscardUnknown(m)
+func (m *Transformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transformation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RenderAttr proto.InternalMessageInfo
+var xxx_messageInfo_Transformation proto.InternalMessageInfo
 
-func (m *RenderAttr) GetName() string {
+func (m *Transformation) GetTarget() TransformTarget {
+	if m != nil {
+		return m.Target
+	}
+	return RequestHeader
+}
+
+func (m *Transformation) GetAction() TransformAction {
+	if m != nil {
+		return m.Action
+	}
+	return TransformSet
+}
+
+func (m *Transformation) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *RenderAttr) GetExtractExp() string {
+func (m *Transformation) GetValue() string {
 	if m != nil {
-		return m.ExtractExp
+		return m.Value
 	}
 	return ""
 }
 
-// API is the api for dispatcher
-type API struct {
-	ID                   uint64            `protobuf:"varint,1,opt,name=id" json:"id"`
-	Name                 string            `protobuf:"bytes,2,opt,name=name" json:"name"`
-	URLPattern           string            `protobuf:"bytes,3,opt,name=urlPattern" json:"urlPattern"`
-	Method               string            `protobuf:"bytes,4,opt,name=method" json:"method"`
-	Domain               string            `protobuf:"bytes,5,opt,name=domain" json:"domain"`
-	Status               Status            `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
-	IPAccessControl      *IPAccessControl  `protobuf:"bytes,7,opt,name=ipAccessControl" json:"ipAccessControl,omitempty"`
-	DefaultValue         *HTTPResult       `protobuf:"bytes,8,opt,name=defaultValue" json:"defaultValue,omitempty"`
-	Nodes                []*DispatchNode   `protobuf:"bytes,9,rep,name=nodes" json:"nodes,omitempty"`
-	Perms                []string          `protobuf:"bytes,10,rep,name=perms" json:"perms,omitempty"`
-	AuthFilter           string            `protobuf:"bytes,11,opt,name=authFilter" json:"authFilter"`
-	RenderTemplate       *RenderTemplate   `protobuf:"bytes,12,opt,name=renderTemplate" json:"renderTemplate,omitempty"`
-	UseDefault           bool              `protobuf:"varint,13,opt,name=useDefault" json:"useDefault"`
-	MatchRule            MatchRule         `protobuf:"varint,14,opt,name=matchRule,enum=metapb.MatchRule" json:"matchRule"`
-	Position             uint32            `protobuf:"varint,15,opt,name=position" json:"position"`
-	Tags                 []*PairValue      `protobuf:"bytes,16,rep,name=tags" json:"tags,omitempty"`
-	WebSocketOptions     *WebSocketOptions `protobuf:"bytes,17,opt,name=webSocketOptions" json:"webSocketOptions,omitempty"`
-	MaxQPS               int64             `protobuf:"varint,18,opt,name=maxQPS" json:"maxQPS"`
-	CircuitBreaker       *CircuitBreaker   `protobuf:"bytes,19,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
-	RateLimitOption      RateLimitOption   `protobuf:"varint,20,opt,name=rateLimitOption,enum=metapb.RateLimitOption" json:"rateLimitOption"`
-	UseTLS               bool              `protobuf:"varint,21,opt,name=useTLS" json:"useTLS"`
-	TlsEmbedCert         *TLSEmbedCert     `protobuf:"bytes,22,opt,name=tlsEmbedCert" json:"tlsEmbedCert,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// Cache is used for cache api result, the deadline, the stale durations, the coalesce timeout
+// and the error ttl are in seconds.
+// With httpSemantics, the cache follows the Cache-Control, Expires, Vary and validators
+// of RFC 7234, and the deadline is used if the response has no explicit expiration time.
+type Cache struct {
+	Keys                 []Parameter `protobuf:"bytes,1,rep,name=keys" json:"keys"`
+	Deadline             uint64      `protobuf:"varint,2,opt,name=deadline" json:"deadline"`
+	Conditions           []Condition `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
+	HTTPSemantics        bool        `protobuf:"varint,4,opt,name=httpSemantics" json:"httpSemantics"`
+	StatusCodes          []int32     `protobuf:"varint,5,rep,name=statusCodes" json:"statusCodes,omitempty"`
+	StaleWhileRevalidate uint64      `protobuf:"varint,6,opt,name=staleWhileRevalidate" json:"staleWhileRevalidate"`
+	StaleIfError         uint64      `protobuf:"varint,7,opt,name=staleIfError" json:"staleIfError"`
+	Coalesce             bool        `protobuf:"varint,8,opt,name=coalesce" json:"coalesce"`
+	CoalesceTimeout      uint64      `protobuf:"varint,9,opt,name=coalesceTimeout" json:"coalesceTimeout"`
+	ErrorTTL             uint64      `protobuf:"varint,10,opt,name=errorTTL" json:"errorTTL"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *API) Reset()         { *m = API{} }
-func (m *API) String() string { return proto.CompactTextString(m) }
-func (*API) ProtoMessage()    {}
-func (*API) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{18}
+func (m *Cache) Reset()         { *m = Cache{} }
+func (m *Cache) String() string { return proto.CompactTextString(m) }
+func (*Cache) ProtoMessage()    {}
+func (*Cache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{17}
 }
-func (m *API) XXX_Unmarshal(b []byte) error {
+func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *API) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Cache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_API.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Cache.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1844,193 +2199,158 @@ func (m *API) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *API) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_API.Merge(m, src)
+func (m *Cache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cache.Merge(m, src)
 }
-func (m *API) XXX_Size() int {
+func (m *Cache) XXX_Size() int {
 	return m.Size()
 }
-func (m *API) XXX_DiscardUnknown() {
-	xxx_messageInfo_API.DiscardUnknown(m)
+func (m *Cache) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cache.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_API proto.InternalMessageInfo
+var xxx_messageInfo_Cache proto.InternalMessageInfo
 
-func (m *API) GetID() uint64 {
+func (m *Cache) GetKeys() []Parameter {
 	if m != nil {
-		return m.ID
+		return m.Keys
 	}
-	return 0
+	return nil
 }
 
-func (m *API) GetName() string {
+func (m *Cache) GetDeadline() uint64 {
 	if m != nil {
-		return m.Name
+		return m.Deadline
 	}
-	return ""
+	return 0
 }
 
-func (m *API) GetURLPattern() string {
+func (m *Cache) GetConditions() []Condition {
 	if m != nil {
-		return m.URLPattern
+		return m.Conditions
 	}
-	return ""
+	return nil
 }
 
-func (m *API) GetMethod() string {
+func (m *Cache) GetHTTPSemantics() bool {
 	if m != nil {
-		return m.Method
+		return m.HTTPSemantics
 	}
-	return ""
+	return false
 }
 
-func (m *API) GetDomain() string {
+func (m *Cache) GetStatusCodes() []int32 {
 	if m != nil {
-		return m.Domain
+		return m.StatusCodes
 	}
-	return ""
+	return nil
 }
 
-func (m *API) GetStatus() Status {
+func (m *Cache) GetStaleWhileRevalidate() uint64 {
 	if m != nil {
-		return m.Status
+		return m.StaleWhileRevalidate
 	}
-	return Down
+	return 0
 }
 
-func (m *API) GetIPAccessControl() *IPAccessControl {
+func (m *Cache) GetStaleIfError() uint64 {
 	if m != nil {
-		return m.IPAccessControl
+		return m.StaleIfError
 	}
-	return nil
+	return 0
 }
 
-func (m *API) GetDefaultValue() *HTTPResult {
+func (m *Cache) GetCoalesce() bool {
 	if m != nil {
-		return m.DefaultValue
+		return m.Coalesce
 	}
-	return nil
+	return false
 }
 
-func (m *API) GetNodes() []*DispatchNode {
+func (m *Cache) GetCoalesceTimeout() uint64 {
 	if m != nil {
-		return m.Nodes
+		return m.CoalesceTimeout
 	}
-	return nil
+	return 0
 }
 
-func (m *API) GetPerms() []string {
+func (m *Cache) GetErrorTTL() uint64 {
 	if m != nil {
-		return m.Perms
+		return m.ErrorTTL
 	}
-	return nil
+	return 0
 }
 
-func (m *API) GetAuthFilter() string {
-	if m != nil {
-		return m.AuthFilter
-	}
-	return ""
+// RenderTemplate the template that render to client
+type RenderTemplate struct {
+	Objects              []*RenderObject `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *API) GetRenderTemplate() *RenderTemplate {
-	if m != nil {
-		return m.RenderTemplate
-	}
-	return nil
+func (m *RenderTemplate) Reset()         { *m = RenderTemplate{} }
+func (m *RenderTemplate) String() string { return proto.CompactTextString(m) }
+func (*RenderTemplate) ProtoMessage()    {}
+func (*RenderTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{18}
 }
-
-func (m *API) GetUseDefault() bool {
-	if m != nil {
-		return m.UseDefault
-	}
-	return false
+func (m *RenderTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *API) GetMatchRule() MatchRule {
-	if m != nil {
-		return m.MatchRule
+func (m *RenderTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenderTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return MatchDefault
 }
-
-func (m *API) GetPosition() uint32 {
-	if m != nil {
-		return m.Position
-	}
-	return 0
+func (m *RenderTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderTemplate.Merge(m, src)
 }
-
-func (m *API) GetTags() []*PairValue {
-	if m != nil {
-		return m.Tags
-	}
-	return nil
+func (m *RenderTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenderTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderTemplate.DiscardUnknown(m)
 }
 
-func (m *API) GetWebSocketOptions() *WebSocketOptions {
+var xxx_messageInfo_RenderTemplate proto.InternalMessageInfo
+
+func (m *RenderTemplate) GetObjects() []*RenderObject {
 	if m != nil {
-		return m.WebSocketOptions
+		return m.Objects
 	}
 	return nil
 }
 
-func (m *API) GetMaxQPS() int64 {
-	if m != nil {
-		return m.MaxQPS
-	}
-	return 0
-}
-
-func (m *API) GetCircuitBreaker() *CircuitBreaker {
-	if m != nil {
-		return m.CircuitBreaker
-	}
-	return nil
-}
-
-func (m *API) GetRateLimitOption() RateLimitOption {
-	if m != nil {
-		return m.RateLimitOption
-	}
-	return Wait
-}
-
-func (m *API) GetUseTLS() bool {
-	if m != nil {
-		return m.UseTLS
-	}
-	return false
-}
-
-func (m *API) GetTlsEmbedCert() *TLSEmbedCert {
-	if m != nil {
-		return m.TlsEmbedCert
-	}
-	return nil
-}
-
-// TLSEmbedCert tlsEmbedCert options
-type TLSEmbedCert struct {
-	CertData             []byte   `protobuf:"bytes,1,opt,name=certData" json:"certData,omitempty"`
-	KeyData              []byte   `protobuf:"bytes,2,opt,name=keyData" json:"keyData,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// RenderObject the object in the render template
+type RenderObject struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Attrs                []*RenderAttr `protobuf:"bytes,2,rep,name=attrs" json:"attrs,omitempty"`
+	FlatAttrs            bool          `protobuf:"varint,3,opt,name=flatAttrs" json:"flatAttrs"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *TLSEmbedCert) Reset()         { *m = TLSEmbedCert{} }
-func (m *TLSEmbedCert) String() string { return proto.CompactTextString(m) }
-func (*TLSEmbedCert) ProtoMessage()    {}
-func (*TLSEmbedCert) Descriptor() ([]byte, []int) {
+func (m *RenderObject) Reset()         { *m = RenderObject{} }
+func (m *RenderObject) String() string { return proto.CompactTextString(m) }
+func (*RenderObject) ProtoMessage()    {}
+func (*RenderObject) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77b4d575d5a68dda, []int{19}
 }
-func (m *TLSEmbedCert) XXX_Unmarshal(b []byte) error {
+func (m *RenderObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TLSEmbedCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenderObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TLSEmbedCert.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenderObject.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2040,54 +2360,62 @@ func (m *TLSEmbedCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TLSEmbedCert) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TLSEmbedCert.Merge(m, src)
+func (m *RenderObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderObject.Merge(m, src)
 }
-func (m *TLSEmbedCert) XXX_Size() int {
+func (m *RenderObject) XXX_Size() int {
 	return m.Size()
 }
-func (m *TLSEmbedCert) XXX_DiscardUnknown() {
-	xxx_messageInfo_TLSEmbedCert.DiscardUnknown(m)
+func (m *RenderObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderObject.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TLSEmbedCert proto.InternalMessageInfo
+var xxx_messageInfo_RenderObject proto.InternalMessageInfo
 
-func (m *TLSEmbedCert) GetCertData() []byte {
+func (m *RenderObject) GetName() string {
 	if m != nil {
-		return m.CertData
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *TLSEmbedCert) GetKeyData() []byte {
+func (m *RenderObject) GetAttrs() []*RenderAttr {
 	if m != nil {
-		return m.KeyData
+		return m.Attrs
 	}
 	return nil
 }
 
-// Condition is a condition for routing
-type Condition struct {
-	Parameter            Parameter `protobuf:"bytes,1,opt,name=parameter" json:"parameter"`
-	Cmp                  CMP       `protobuf:"varint,2,opt,name=cmp,enum=metapb.CMP" json:"cmp"`
-	Expect               string    `protobuf:"bytes,3,opt,name=expect" json:"expect"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *RenderObject) GetFlatAttrs() bool {
+	if m != nil {
+		return m.FlatAttrs
+	}
+	return false
 }
 
-func (m *Condition) Reset()         { *m = Condition{} }
-func (m *Condition) String() string { return proto.CompactTextString(m) }
-func (*Condition) ProtoMessage()    {}
-func (*Condition) Descriptor() ([]byte, []int) {
+// RenderAttr the attr in the render object, the expression is used instead of
+// the extractExp if it is set
+type RenderAttr struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ExtractExp           string   `protobuf:"bytes,2,opt,name=extractExp" json:"extractExp"`
+	Expression           string   `protobuf:"bytes,3,opt,name=expression" json:"expression"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenderAttr) Reset()         { *m = RenderAttr{} }
+func (m *RenderAttr) String() string { return proto.CompactTextString(m) }
+func (*RenderAttr) ProtoMessage()    {}
+func (*RenderAttr) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77b4d575d5a68dda, []int{20}
 }
-func (m *Condition) XXX_Unmarshal(b []byte) error {
+func (m *RenderAttr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenderAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Condition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenderAttr.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2097,66 +2425,86 @@ func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Condition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Condition.Merge(m, src)
+func (m *RenderAttr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderAttr.Merge(m, src)
 }
-func (m *Condition) XXX_Size() int {
+func (m *RenderAttr) XXX_Size() int {
 	return m.Size()
 }
-func (m *Condition) XXX_DiscardUnknown() {
-	xxx_messageInfo_Condition.DiscardUnknown(m)
+func (m *RenderAttr) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderAttr.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Condition proto.InternalMessageInfo
+var xxx_messageInfo_RenderAttr proto.InternalMessageInfo
 
-func (m *Condition) GetParameter() Parameter {
+func (m *RenderAttr) GetName() string {
 	if m != nil {
-		return m.Parameter
+		return m.Name
 	}
-	return Parameter{}
+	return ""
 }
 
-func (m *Condition) GetCmp() CMP {
+func (m *RenderAttr) GetExtractExp() string {
 	if m != nil {
-		return m.Cmp
+		return m.ExtractExp
 	}
-	return CMPEQ
+	return ""
 }
 
-func (m *Condition) GetExpect() string {
+func (m *RenderAttr) GetExpression() string {
 	if m != nil {
-		return m.Expect
+		return m.Expression
 	}
 	return ""
 }
 
-// Routing is a routing
-type Routing struct {
-	ID                   uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
-	ClusterID            uint64          `protobuf:"varint,2,opt,name=clusterID" json:"clusterID"`
-	Conditions           []Condition     `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
-	Strategy             RoutingStrategy `protobuf:"varint,4,opt,name=strategy,enum=metapb.RoutingStrategy" json:"strategy"`
-	TrafficRate          int32           `protobuf:"varint,5,opt,name=trafficRate" json:"trafficRate"`
-	Status               Status          `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
-	API                  uint64          `protobuf:"varint,7,opt,name=api" json:"api"`
-	Name                 string          `protobuf:"bytes,8,opt,name=name" json:"name"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// API is the api for dispatcher
+type API struct {
+	ID                   uint64            `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name" json:"name"`
+	URLPattern           string            `protobuf:"bytes,3,opt,name=urlPattern" json:"urlPattern"`
+	Method               string            `protobuf:"bytes,4,opt,name=method" json:"method"`
+	Domain               string            `protobuf:"bytes,5,opt,name=domain" json:"domain"`
+	Status               Status            `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
+	IPAccessControl      *IPAccessControl  `protobuf:"bytes,7,opt,name=ipAccessControl" json:"ipAccessControl,omitempty"`
+	DefaultValue         *HTTPResult       `protobuf:"bytes,8,opt,name=defaultValue" json:"defaultValue,omitempty"`
+	Nodes                []*DispatchNode   `protobuf:"bytes,9,rep,name=nodes" json:"nodes,omitempty"`
+	Perms                []string          `protobuf:"bytes,10,rep,name=perms" json:"perms,omitempty"`
+	AuthFilter           string            `protobuf:"bytes,11,opt,name=authFilter" json:"authFilter"`
+	RenderTemplate       *RenderTemplate   `protobuf:"bytes,12,opt,name=renderTemplate" json:"renderTemplate,omitempty"`
+	UseDefault           bool              `protobuf:"varint,13,opt,name=useDefault" json:"useDefault"`
+	MatchRule            MatchRule         `protobuf:"varint,14,opt,name=matchRule,enum=metapb.MatchRule" json:"matchRule"`
+	Position             uint32            `protobuf:"varint,15,opt,name=position" json:"position"`
+	Tags                 []*PairValue      `protobuf:"bytes,16,rep,name=tags" json:"tags,omitempty"`
+	WebSocketOptions     *WebSocketOptions `protobuf:"bytes,17,opt,name=webSocketOptions" json:"webSocketOptions,omitempty"`
+	MaxQPS               int64             `protobuf:"varint,18,opt,name=maxQPS" json:"maxQPS"`
+	CircuitBreaker       *CircuitBreaker   `protobuf:"bytes,19,opt,name=circuitBreaker" json:"circuitBreaker,omitempty"`
+	RateLimitOption      RateLimitOption   `protobuf:"varint,20,opt,name=rateLimitOption,enum=metapb.RateLimitOption" json:"rateLimitOption"`
+	UseTLS               bool              `protobuf:"varint,21,opt,name=useTLS" json:"useTLS"`
+	TlsEmbedCert         *TLSEmbedCert     `protobuf:"bytes,22,opt,name=tlsEmbedCert" json:"tlsEmbedCert,omitempty"`
+	Streaming            bool              `protobuf:"varint,23,opt,name=streaming" json:"streaming"`
+	SSEOptions           *SSEOptions       `protobuf:"bytes,24,opt,name=sseOptions" json:"sseOptions,omitempty"`
+	Transformations      []*Transformation `protobuf:"bytes,25,rep,name=transformations" json:"transformations,omitempty"`
+	PartialErrors        bool              `protobuf:"varint,26,opt,name=partialErrors" json:"partialErrors"`
+	GraphQLField         string            `protobuf:"bytes,27,opt,name=graphQLField" json:"graphQLField"`
+	CORS                 *CORSPolicy       `protobuf:"bytes,28,opt,name=cors" json:"cors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *Routing) Reset()         { *m = Routing{} }
-func (m *Routing) String() string { return proto.CompactTextString(m) }
-func (*Routing) ProtoMessage()    {}
-func (*Routing) Descriptor() ([]byte, []int) {
+func (m *API) Reset()         { *m = API{} }
+func (m *API) String() string { return proto.CompactTextString(m) }
+func (*API) ProtoMessage()    {}
+func (*API) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77b4d575d5a68dda, []int{21}
 }
-func (m *Routing) XXX_Unmarshal(b []byte) error {
+func (m *API) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Routing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *API) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Routing.Marshal(b, m, deterministic)
+		return xxx_messageInfo_API.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2166,195 +2514,240 @@ func (m *Routing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Routing) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Routing.Merge(m, src)
+func (m *API) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_API.Merge(m, src)
 }
-func (m *Routing) XXX_Size() int {
+func (m *API) XXX_Size() int {
 	return m.Size()
 }
-func (m *Routing) XXX_DiscardUnknown() {
-	xxx_messageInfo_Routing.DiscardUnknown(m)
+func (m *API) XXX_DiscardUnknown() {
+	xxx_messageInfo_API.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Routing proto.InternalMessageInfo
+var xxx_messageInfo_API proto.InternalMessageInfo
 
-func (m *Routing) GetID() uint64 {
+func (m *API) GetID() uint64 {
 	if m != nil {
 		return m.ID
 	}
 	return 0
 }
 
-func (m *Routing) GetClusterID() uint64 {
+func (m *API) GetName() string {
 	if m != nil {
-		return m.ClusterID
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *Routing) GetConditions() []Condition {
+func (m *API) GetURLPattern() string {
 	if m != nil {
-		return m.Conditions
+		return m.URLPattern
 	}
-	return nil
+	return ""
 }
 
-func (m *Routing) GetStrategy() RoutingStrategy {
+func (m *API) GetMethod() string {
 	if m != nil {
-		return m.Strategy
+		return m.Method
 	}
-	return Copy
+	return ""
 }
 
-func (m *Routing) GetTrafficRate() int32 {
+func (m *API) GetDomain() string {
 	if m != nil {
-		return m.TrafficRate
+		return m.Domain
 	}
-	return 0
+	return ""
 }
 
-func (m *Routing) GetStatus() Status {
+func (m *API) GetStatus() Status {
 	if m != nil {
 		return m.Status
 	}
 	return Down
 }
 
-func (m *Routing) GetAPI() uint64 {
+func (m *API) GetIPAccessControl() *IPAccessControl {
 	if m != nil {
-		return m.API
+		return m.IPAccessControl
 	}
-	return 0
+	return nil
 }
 
-func (m *Routing) GetName() string {
+func (m *API) GetDefaultValue() *HTTPResult {
 	if m != nil {
-		return m.Name
+		return m.DefaultValue
 	}
-	return ""
+	return nil
 }
 
-// WebSocketOptions websocket options
-type WebSocketOptions struct {
-	Origin               string   `protobuf:"bytes,1,opt,name=origin" json:"origin"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *API) GetNodes() []*DispatchNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
 }
 
-func (m *WebSocketOptions) Reset()         { *m = WebSocketOptions{} }
-func (m *WebSocketOptions) String() string { return proto.CompactTextString(m) }
-func (*WebSocketOptions) ProtoMessage()    {}
-func (*WebSocketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{22}
+func (m *API) GetPerms() []string {
+	if m != nil {
+		return m.Perms
+	}
+	return nil
 }
-func (m *WebSocketOptions) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *API) GetAuthFilter() string {
+	if m != nil {
+		return m.AuthFilter
+	}
+	return ""
 }
-func (m *WebSocketOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_WebSocketOptions.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *API) GetRenderTemplate() *RenderTemplate {
+	if m != nil {
+		return m.RenderTemplate
 	}
+	return nil
 }
-func (m *WebSocketOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WebSocketOptions.Merge(m, src)
+
+func (m *API) GetUseDefault() bool {
+	if m != nil {
+		return m.UseDefault
+	}
+	return false
 }
-func (m *WebSocketOptions) XXX_Size() int {
-	return m.Size()
+
+func (m *API) GetMatchRule() MatchRule {
+	if m != nil {
+		return m.MatchRule
+	}
+	return MatchDefault
 }
-func (m *WebSocketOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_WebSocketOptions.DiscardUnknown(m)
+
+func (m *API) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
 }
 
-var xxx_messageInfo_WebSocketOptions proto.InternalMessageInfo
+func (m *API) GetTags() []*PairValue {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
 
-func (m *WebSocketOptions) GetOrigin() string {
+func (m *API) GetWebSocketOptions() *WebSocketOptions {
 	if m != nil {
-		return m.Origin
+		return m.WebSocketOptions
 	}
-	return ""
+	return nil
 }
 
-// System system
-type System struct {
-	Count                CountMetric `protobuf:"bytes,1,opt,name=count" json:"count"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *API) GetMaxQPS() int64 {
+	if m != nil {
+		return m.MaxQPS
+	}
+	return 0
 }
 
-func (m *System) Reset()         { *m = System{} }
-func (m *System) String() string { return proto.CompactTextString(m) }
-func (*System) ProtoMessage()    {}
-func (*System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{23}
+func (m *API) GetCircuitBreaker() *CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
 }
-func (m *System) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *API) GetRateLimitOption() RateLimitOption {
+	if m != nil {
+		return m.RateLimitOption
+	}
+	return Wait
 }
-func (m *System) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_System.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *API) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
 	}
+	return false
 }
-func (m *System) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_System.Merge(m, src)
+
+func (m *API) GetTlsEmbedCert() *TLSEmbedCert {
+	if m != nil {
+		return m.TlsEmbedCert
+	}
+	return nil
 }
-func (m *System) XXX_Size() int {
-	return m.Size()
+
+func (m *API) GetStreaming() bool {
+	if m != nil {
+		return m.Streaming
+	}
+	return false
 }
-func (m *System) XXX_DiscardUnknown() {
-	xxx_messageInfo_System.DiscardUnknown(m)
+
+func (m *API) GetSSEOptions() *SSEOptions {
+	if m != nil {
+		return m.SSEOptions
+	}
+	return nil
 }
 
-var xxx_messageInfo_System proto.InternalMessageInfo
+func (m *API) GetTransformations() []*Transformation {
+	if m != nil {
+		return m.Transformations
+	}
+	return nil
+}
 
-func (m *System) GetCount() CountMetric {
+func (m *API) GetPartialErrors() bool {
 	if m != nil {
-		return m.Count
+		return m.PartialErrors
 	}
-	return CountMetric{}
+	return false
 }
 
-// CountMetric count metric
-type CountMetric struct {
-	Cluster              int64    `protobuf:"varint,1,opt,name=cluster" json:"cluster"`
-	Server               int64    `protobuf:"varint,2,opt,name=server" json:"server"`
-	API                  int64    `protobuf:"varint,3,opt,name=api" json:"api"`
-	Routing              int64    `protobuf:"varint,4,opt,name=routing" json:"routing"`
-	Plugin               int64    `protobuf:"varint,5,opt,name=plugin" json:"plugin"`
-	AppliedPlugin        int64    `protobuf:"varint,6,opt,name=appliedPlugin" json:"appliedPlugin"`
+func (m *API) GetGraphQLField() string {
+	if m != nil {
+		return m.GraphQLField
+	}
+	return ""
+}
+
+func (m *API) GetCORS() *CORSPolicy {
+	if m != nil {
+		return m.CORS
+	}
+	return nil
+}
+
+// CORSPolicy is the cross-origin resource sharing policy of the api, the origin is
+// exact or with a wildcard, e.g. https://*.example.com, and * allows any origin
+type CORSPolicy struct {
+	AllowOrigins         []string `protobuf:"bytes,1,rep,name=allowOrigins" json:"allowOrigins,omitempty"`
+	AllowMethods         []string `protobuf:"bytes,2,rep,name=allowMethods" json:"allowMethods,omitempty"`
+	AllowHeaders         []string `protobuf:"bytes,3,rep,name=allowHeaders" json:"allowHeaders,omitempty"`
+	ExposeHeaders        []string `protobuf:"bytes,4,rep,name=exposeHeaders" json:"exposeHeaders,omitempty"`
+	AllowCredentials     bool     `protobuf:"varint,5,opt,name=allowCredentials" json:"allowCredentials"`
+	MaxAge               int64    `protobuf:"varint,6,opt,name=maxAge" json:"maxAge"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CountMetric) Reset()         { *m = CountMetric{} }
-func (m *CountMetric) String() string { return proto.CompactTextString(m) }
-func (*CountMetric) ProtoMessage()    {}
-func (*CountMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{24}
+func (m *CORSPolicy) Reset()         { *m = CORSPolicy{} }
+func (m *CORSPolicy) String() string { return proto.CompactTextString(m) }
+func (*CORSPolicy) ProtoMessage()    {}
+func (*CORSPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{22}
 }
-func (m *CountMetric) XXX_Unmarshal(b []byte) error {
+func (m *CORSPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CountMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CORSPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CountMetric.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CORSPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2364,89 +2757,81 @@ func (m *CountMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CountMetric) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountMetric.Merge(m, src)
+func (m *CORSPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CORSPolicy.Merge(m, src)
 }
-func (m *CountMetric) XXX_Size() int {
+func (m *CORSPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *CountMetric) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountMetric.DiscardUnknown(m)
+func (m *CORSPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CORSPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CountMetric proto.InternalMessageInfo
+var xxx_messageInfo_CORSPolicy proto.InternalMessageInfo
 
-func (m *CountMetric) GetCluster() int64 {
+func (m *CORSPolicy) GetAllowOrigins() []string {
 	if m != nil {
-		return m.Cluster
+		return m.AllowOrigins
 	}
-	return 0
+	return nil
 }
 
-func (m *CountMetric) GetServer() int64 {
+func (m *CORSPolicy) GetAllowMethods() []string {
 	if m != nil {
-		return m.Server
+		return m.AllowMethods
 	}
-	return 0
+	return nil
 }
 
-func (m *CountMetric) GetAPI() int64 {
+func (m *CORSPolicy) GetAllowHeaders() []string {
 	if m != nil {
-		return m.API
+		return m.AllowHeaders
 	}
-	return 0
+	return nil
 }
 
-func (m *CountMetric) GetRouting() int64 {
+func (m *CORSPolicy) GetExposeHeaders() []string {
 	if m != nil {
-		return m.Routing
+		return m.ExposeHeaders
 	}
-	return 0
+	return nil
 }
 
-func (m *CountMetric) GetPlugin() int64 {
+func (m *CORSPolicy) GetAllowCredentials() bool {
 	if m != nil {
-		return m.Plugin
+		return m.AllowCredentials
 	}
-	return 0
+	return false
 }
 
-func (m *CountMetric) GetAppliedPlugin() int64 {
+func (m *CORSPolicy) GetMaxAge() int64 {
 	if m != nil {
-		return m.AppliedPlugin
+		return m.MaxAge
 	}
 	return 0
 }
 
-// Plugin plugin
-type Plugin struct {
-	ID                   uint64     `protobuf:"varint,1,opt,name=id" json:"id"`
-	Name                 string     `protobuf:"bytes,2,opt,name=name" json:"name"`
-	Author               string     `protobuf:"bytes,3,opt,name=author" json:"author"`
-	Email                string     `protobuf:"bytes,4,opt,name=email" json:"email"`
-	Status               Status     `protobuf:"varint,5,opt,name=status,enum=metapb.Status" json:"status"`
-	UpdateAt             int64      `protobuf:"varint,6,opt,name=updateAt" json:"updateAt"`
-	Version              int64      `protobuf:"varint,7,opt,name=version" json:"version"`
-	Type                 PluginType `protobuf:"varint,8,opt,name=type,enum=metapb.PluginType" json:"type"`
-	Content              []byte     `protobuf:"bytes,9,opt,name=content" json:"content,omitempty"`
-	Cfg                  []byte     `protobuf:"bytes,10,opt,name=cfg" json:"cfg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+// TLSEmbedCert tlsEmbedCert options
+type TLSEmbedCert struct {
+	CertData             []byte   `protobuf:"bytes,1,opt,name=certData" json:"certData,omitempty"`
+	KeyData              []byte   `protobuf:"bytes,2,opt,name=keyData" json:"keyData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Plugin) Reset()         { *m = Plugin{} }
-func (m *Plugin) String() string { return proto.CompactTextString(m) }
-func (*Plugin) ProtoMessage()    {}
-func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{25}
+func (m *TLSEmbedCert) Reset()         { *m = TLSEmbedCert{} }
+func (m *TLSEmbedCert) String() string { return proto.CompactTextString(m) }
+func (*TLSEmbedCert) ProtoMessage()    {}
+func (*TLSEmbedCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{23}
 }
-func (m *Plugin) XXX_Unmarshal(b []byte) error {
+func (m *TLSEmbedCert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Plugin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TLSEmbedCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Plugin.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TLSEmbedCert.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2456,109 +2841,143 @@ func (m *Plugin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Plugin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Plugin.Merge(m, src)
+func (m *TLSEmbedCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSEmbedCert.Merge(m, src)
 }
-func (m *Plugin) XXX_Size() int {
+func (m *TLSEmbedCert) XXX_Size() int {
 	return m.Size()
 }
-func (m *Plugin) XXX_DiscardUnknown() {
-	xxx_messageInfo_Plugin.DiscardUnknown(m)
+func (m *TLSEmbedCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSEmbedCert.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Plugin proto.InternalMessageInfo
+var xxx_messageInfo_TLSEmbedCert proto.InternalMessageInfo
 
-func (m *Plugin) GetID() uint64 {
+func (m *TLSEmbedCert) GetCertData() []byte {
 	if m != nil {
-		return m.ID
+		return m.CertData
 	}
-	return 0
+	return nil
 }
 
-func (m *Plugin) GetName() string {
+func (m *TLSEmbedCert) GetKeyData() []byte {
 	if m != nil {
-		return m.Name
+		return m.KeyData
 	}
-	return ""
+	return nil
 }
 
-func (m *Plugin) GetAuthor() string {
-	if m != nil {
-		return m.Author
-	}
-	return ""
+// Condition is a condition for routing, the condition is a group of the
+// children if the children is not empty
+type Condition struct {
+	Parameter            Parameter   `protobuf:"bytes,1,opt,name=parameter" json:"parameter"`
+	Cmp                  CMP         `protobuf:"varint,2,opt,name=cmp,enum=metapb.CMP" json:"cmp"`
+	Expect               string      `protobuf:"bytes,3,opt,name=expect" json:"expect"`
+	Logic                Logic       `protobuf:"varint,4,opt,name=logic,enum=metapb.Logic" json:"logic"`
+	Children             []Condition `protobuf:"bytes,5,rep,name=children" json:"children"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Plugin) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+func (*Condition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{24}
 }
-
-func (m *Plugin) GetStatus() Status {
-	if m != nil {
-		return m.Status
+func (m *Condition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Condition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Down
+}
+func (m *Condition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Condition.Merge(m, src)
+}
+func (m *Condition) XXX_Size() int {
+	return m.Size()
+}
+func (m *Condition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Condition.DiscardUnknown(m)
 }
 
-func (m *Plugin) GetUpdateAt() int64 {
+var xxx_messageInfo_Condition proto.InternalMessageInfo
+
+func (m *Condition) GetParameter() Parameter {
 	if m != nil {
-		return m.UpdateAt
+		return m.Parameter
 	}
-	return 0
+	return Parameter{}
 }
 
-func (m *Plugin) GetVersion() int64 {
+func (m *Condition) GetCmp() CMP {
 	if m != nil {
-		return m.Version
+		return m.Cmp
 	}
-	return 0
+	return CMPEQ
 }
 
-func (m *Plugin) GetType() PluginType {
+func (m *Condition) GetExpect() string {
 	if m != nil {
-		return m.Type
+		return m.Expect
 	}
-	return JavaScript
+	return ""
 }
 
-func (m *Plugin) GetContent() []byte {
+func (m *Condition) GetLogic() Logic {
 	if m != nil {
-		return m.Content
+		return m.Logic
 	}
-	return nil
+	return LogicAnd
 }
 
-func (m *Plugin) GetCfg() []byte {
+func (m *Condition) GetChildren() []Condition {
 	if m != nil {
-		return m.Cfg
+		return m.Children
 	}
 	return nil
 }
 
-// AppliedPlugins applied plugins
-type AppliedPlugins struct {
-	ID                   uint64   `protobuf:"varint,1,opt,name=id" json:"id"`
-	AppliedIDs           []uint64 `protobuf:"varint,2,rep,name=appliedIDs" json:"appliedIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Routing is a routing
+type Routing struct {
+	ID                   uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
+	ClusterID            uint64          `protobuf:"varint,2,opt,name=clusterID" json:"clusterID"`
+	Conditions           []Condition     `protobuf:"bytes,3,rep,name=conditions" json:"conditions"`
+	Strategy             RoutingStrategy `protobuf:"varint,4,opt,name=strategy,enum=metapb.RoutingStrategy" json:"strategy"`
+	TrafficRate          int32           `protobuf:"varint,5,opt,name=trafficRate" json:"trafficRate"`
+	Status               Status          `protobuf:"varint,6,opt,name=status,enum=metapb.Status" json:"status"`
+	API                  uint64          `protobuf:"varint,7,opt,name=api" json:"api"`
+	Name                 string          `protobuf:"bytes,8,opt,name=name" json:"name"`
+	Rollout              *Rollout        `protobuf:"bytes,9,opt,name=rollout" json:"rollout,omitempty"`
+	StickyKey            *Parameter      `protobuf:"bytes,10,opt,name=stickyKey" json:"stickyKey,omitempty"`
+	Compare              *ShadowCompare  `protobuf:"bytes,11,opt,name=compare" json:"compare,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *AppliedPlugins) Reset()         { *m = AppliedPlugins{} }
-func (m *AppliedPlugins) String() string { return proto.CompactTextString(m) }
-func (*AppliedPlugins) ProtoMessage()    {}
-func (*AppliedPlugins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{26}
+func (m *Routing) Reset()         { *m = Routing{} }
+func (m *Routing) String() string { return proto.CompactTextString(m) }
+func (*Routing) ProtoMessage()    {}
+func (*Routing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{25}
 }
-func (m *AppliedPlugins) XXX_Unmarshal(b []byte) error {
+func (m *Routing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AppliedPlugins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Routing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AppliedPlugins.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Routing.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2568,2111 +2987,6491 @@ func (m *AppliedPlugins) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *AppliedPlugins) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppliedPlugins.Merge(m, src)
+func (m *Routing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Routing.Merge(m, src)
 }
-func (m *AppliedPlugins) XXX_Size() int {
+func (m *Routing) XXX_Size() int {
 	return m.Size()
 }
-func (m *AppliedPlugins) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppliedPlugins.DiscardUnknown(m)
+func (m *Routing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Routing.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppliedPlugins proto.InternalMessageInfo
+var xxx_messageInfo_Routing proto.InternalMessageInfo
 
-func (m *AppliedPlugins) GetID() uint64 {
+func (m *Routing) GetID() uint64 {
 	if m != nil {
 		return m.ID
 	}
 	return 0
 }
 
-func (m *AppliedPlugins) GetAppliedIDs() []uint64 {
+func (m *Routing) GetClusterID() uint64 {
 	if m != nil {
-		return m.AppliedIDs
+		return m.ClusterID
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("metapb.Status", Status_name, Status_value)
-	proto.RegisterEnum("metapb.CircuitStatus", CircuitStatus_name, CircuitStatus_value)
-	proto.RegisterEnum("metapb.LoadBalance", LoadBalance_name, LoadBalance_value)
-	proto.RegisterEnum("metapb.Protocol", Protocol_name, Protocol_value)
-	proto.RegisterEnum("metapb.Source", Source_name, Source_value)
-	proto.RegisterEnum("metapb.RuleType", RuleType_name, RuleType_value)
-	proto.RegisterEnum("metapb.CMP", CMP_name, CMP_value)
-	proto.RegisterEnum("metapb.RoutingStrategy", RoutingStrategy_name, RoutingStrategy_value)
-	proto.RegisterEnum("metapb.MatchRule", MatchRule_name, MatchRule_value)
-	proto.RegisterEnum("metapb.HostType", HostType_name, HostType_value)
-	proto.RegisterEnum("metapb.RateLimitOption", RateLimitOption_name, RateLimitOption_value)
-	proto.RegisterEnum("metapb.PluginType", PluginType_name, PluginType_value)
-	proto.RegisterType((*Proxy)(nil), "metapb.Proxy")
-	proto.RegisterType((*Cluster)(nil), "metapb.Cluster")
-	proto.RegisterType((*HeathCheck)(nil), "metapb.HeathCheck")
-	proto.RegisterType((*CircuitBreaker)(nil), "metapb.CircuitBreaker")
-	proto.RegisterType((*Server)(nil), "metapb.Server")
-	proto.RegisterType((*Bind)(nil), "metapb.Bind")
-	proto.RegisterType((*PairValue)(nil), "metapb.PairValue")
-	proto.RegisterType((*IPAccessControl)(nil), "metapb.IPAccessControl")
-	proto.RegisterType((*HTTPResult)(nil), "metapb.HTTPResult")
-	proto.RegisterType((*Parameter)(nil), "metapb.Parameter")
-	proto.RegisterType((*ValidationRule)(nil), "metapb.ValidationRule")
-	proto.RegisterType((*Validation)(nil), "metapb.Validation")
-	proto.RegisterType((*RetryStrategy)(nil), "metapb.RetryStrategy")
-	proto.RegisterType((*DispatchNode)(nil), "metapb.DispatchNode")
-	proto.RegisterType((*Cache)(nil), "metapb.Cache")
-	proto.RegisterType((*RenderTemplate)(nil), "metapb.RenderTemplate")
-	proto.RegisterType((*RenderObject)(nil), "metapb.RenderObject")
-	proto.RegisterType((*RenderAttr)(nil), "metapb.RenderAttr")
-	proto.RegisterType((*API)(nil), "metapb.API")
-	proto.RegisterType((*TLSEmbedCert)(nil), "metapb.TLSEmbedCert")
-	proto.RegisterType((*Condition)(nil), "metapb.Condition")
-	proto.RegisterType((*Routing)(nil), "metapb.Routing")
-	proto.RegisterType((*WebSocketOptions)(nil), "metapb.WebSocketOptions")
-	proto.RegisterType((*System)(nil), "metapb.System")
-	proto.RegisterType((*CountMetric)(nil), "metapb.CountMetric")
-	proto.RegisterType((*Plugin)(nil), "metapb.Plugin")
-	proto.RegisterType((*AppliedPlugins)(nil), "metapb.AppliedPlugins")
+func (m *Routing) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("metapb.proto", fileDescriptor_77b4d575d5a68dda) }
+func (m *Routing) GetStrategy() RoutingStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return Copy
+}
 
-var fileDescriptor_77b4d575d5a68dda = []byte{
-	// 2249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0xf5, 0xcf, 0xd2, 0x93, 0x6c, 0x33, 0xb3, 0xc9, 0x2e, 0x11, 0xa4, 0x4e, 0xc0, 0x6d,
-	0xb3, 0x86, 0x76, 0x91, 0x2d, 0x8c, 0x5d, 0xb4, 0xe9, 0x16, 0x45, 0x65, 0x39, 0x9b, 0x78, 0x61,
-	0xc7, 0x0a, 0xa5, 0x6c, 0x80, 0xa2, 0x97, 0x31, 0x39, 0x96, 0xb8, 0xa6, 0x48, 0x76, 0x38, 0x74,
-	0x2c, 0xa0, 0xc7, 0xb6, 0xa7, 0x02, 0xbd, 0xf4, 0xd0, 0x7e, 0x9d, 0x9e, 0xf6, 0xd0, 0xc3, 0x1e,
-	0x8a, 0x1e, 0x83, 0x36, 0x3d, 0xf6, 0x4b, 0x14, 0x6f, 0x86, 0x43, 0x0d, 0x65, 0x27, 0xdd, 0xf8,
-	0x24, 0xf1, 0xf7, 0x7e, 0xc3, 0x79, 0xf3, 0xfe, 0x0f, 0xa1, 0x37, 0x67, 0x82, 0xa6, 0x27, 0x0f,
-	0x52, 0x9e, 0x88, 0x84, 0xb4, 0xd4, 0xd3, 0xed, 0x9b, 0xd3, 0x64, 0x9a, 0x48, 0xe8, 0x53, 0xfc,
-	0xa7, 0xa4, 0xee, 0x00, 0x9a, 0x23, 0x9e, 0x5c, 0x2c, 0x88, 0x03, 0x0d, 0x1a, 0x04, 0xdc, 0xb1,
-	0xee, 0x59, 0x3b, 0x9d, 0xbd, 0xc6, 0xb7, 0xaf, 0xee, 0xae, 0x79, 0x12, 0x21, 0xdb, 0xb0, 0x8e,
-	0xbf, 0xde, 0x68, 0xe8, 0xd4, 0x0c, 0xa1, 0x06, 0xdd, 0xdf, 0xc2, 0xfa, 0x30, 0xca, 0x33, 0xc1,
-	0x38, 0xb9, 0x0d, 0xb5, 0x30, 0x90, 0xaf, 0x68, 0xec, 0x01, 0xb2, 0x5e, 0xbf, 0xba, 0x5b, 0x3b,
-	0xd8, 0xf7, 0x6a, 0x61, 0x80, 0x1b, 0xc4, 0x74, 0xce, 0x2a, 0xef, 0x90, 0x08, 0xf9, 0x02, 0xba,
-	0x51, 0x42, 0x83, 0x3d, 0x1a, 0xd1, 0xd8, 0x67, 0x4e, 0xfd, 0x9e, 0xb5, 0xb3, 0xb9, 0xfb, 0xde,
-	0x83, 0xe2, 0x14, 0x87, 0x4b, 0x51, 0xb1, 0xca, 0x64, 0xbb, 0x7f, 0xb4, 0x00, 0x9e, 0x30, 0x2a,
-	0x66, 0xc3, 0x19, 0xf3, 0xcf, 0x70, 0x97, 0x94, 0x8a, 0x59, 0xf5, 0x18, 0x88, 0xa0, 0xe4, 0x24,
-	0x09, 0x16, 0xd5, 0xfd, 0x11, 0x21, 0x7d, 0xd8, 0xf0, 0x71, 0xf1, 0x41, 0x2c, 0x18, 0x3f, 0xa7,
-	0x91, 0xd4, 0xa0, 0x5e, 0x50, 0xaa, 0x22, 0x34, 0x86, 0x08, 0xe7, 0x2c, 0xc9, 0x85, 0xd3, 0x30,
-	0x58, 0x1a, 0x74, 0x7f, 0x57, 0x83, 0xcd, 0x61, 0xc8, 0xfd, 0x3c, 0x14, 0x7b, 0x9c, 0xd1, 0x33,
-	0xc6, 0xc9, 0x0e, 0xf4, 0xfc, 0x28, 0xc9, 0xd8, 0xa4, 0x58, 0x67, 0x19, 0xeb, 0x2a, 0x12, 0xf2,
-	0x00, 0xb6, 0x66, 0x34, 0x3a, 0x9d, 0x70, 0x7a, 0x7a, 0x1a, 0xfa, 0x1e, 0x15, 0xca, 0x5a, 0xcd,
-	0x82, 0xbc, 0x2a, 0x44, 0x3e, 0xa7, 0x82, 0xc9, 0x93, 0x8f, 0x18, 0x0f, 0x93, 0xa0, 0xa2, 0xfa,
-	0xaa, 0x90, 0x7c, 0x06, 0xe4, 0x94, 0x86, 0x51, 0xce, 0x19, 0x2e, 0x9f, 0x24, 0x43, 0xdc, 0x5c,
-	0x9e, 0x43, 0x6f, 0x71, 0x85, 0x9c, 0xec, 0xc2, 0x8d, 0x2c, 0xf7, 0x7d, 0xc6, 0x02, 0x85, 0x1e,
-	0xa7, 0x2c, 0x76, 0x9a, 0xc6, 0xa2, 0xcb, 0x62, 0xf7, 0xbf, 0x35, 0x68, 0x8d, 0x19, 0x3f, 0xff,
-	0xff, 0x31, 0x21, 0x83, 0xae, 0x76, 0x29, 0xe8, 0x76, 0xa1, 0x2d, 0x03, 0xd4, 0x4f, 0xa2, 0x22,
-	0x20, 0x6c, 0x1d, 0x10, 0xa3, 0x02, 0x2f, 0xf8, 0x25, 0x8f, 0xdc, 0x81, 0xd6, 0x9c, 0x5e, 0x3c,
-	0x1b, 0x8d, 0x2b, 0xae, 0x29, 0x30, 0xb2, 0x0b, 0x30, 0x2b, 0xe3, 0x44, 0xea, 0xdf, 0xdd, 0x25,
-	0xfa, 0x9d, 0xcb, 0x08, 0xf2, 0x0c, 0x16, 0xf9, 0x05, 0x6c, 0xfa, 0x15, 0x67, 0x3a, 0x2d, 0xb9,
-	0xee, 0x7d, 0xbd, 0xae, 0xea, 0x6a, 0x6f, 0x85, 0x8d, 0x1a, 0xbd, 0x64, 0xe1, 0x74, 0x26, 0x9c,
-	0x75, 0x53, 0x23, 0x85, 0x91, 0xc7, 0xca, 0x7d, 0x87, 0xe1, 0x3c, 0x14, 0xc7, 0xa9, 0x08, 0x93,
-	0xd8, 0x69, 0xcb, 0xa3, 0x7e, 0xa0, 0x5f, 0xef, 0x55, 0xc5, 0xa6, 0x5f, 0x0d, 0xd8, 0x3d, 0x84,
-	0xc6, 0x5e, 0x18, 0x07, 0xc4, 0x85, 0x8e, 0xaf, 0x32, 0xf1, 0x60, 0xbf, 0xb0, 0xb8, 0x5a, 0xb1,
-	0x84, 0xc9, 0x3d, 0x68, 0x67, 0xd2, 0x31, 0x07, 0xfb, 0xd2, 0xec, 0x9a, 0x52, 0xa2, 0xee, 0x00,
-	0x3a, 0x23, 0x1a, 0xf2, 0xaf, 0x69, 0x94, 0xb3, 0x32, 0x6b, 0xad, 0x4b, 0x59, 0x7b, 0x1b, 0x9a,
-	0xe7, 0x48, 0xa9, 0x38, 0x4f, 0x41, 0xee, 0x11, 0x6c, 0x1d, 0x8c, 0x06, 0xbe, 0xcf, 0xb2, 0x6c,
-	0x98, 0xc4, 0x82, 0x4b, 0xe7, 0x74, 0x5e, 0xce, 0x42, 0xc1, 0xa2, 0x30, 0xc3, 0x14, 0xa8, 0xef,
-	0x74, 0xbc, 0x25, 0x80, 0xd2, 0x93, 0x88, 0xfa, 0x67, 0x52, 0x5a, 0x53, 0xd2, 0x12, 0x70, 0xff,
-	0x8c, 0x39, 0x3e, 0x99, 0x8c, 0x3c, 0x96, 0xe5, 0x91, 0x20, 0xa4, 0xc8, 0x64, 0xd4, 0xa9, 0x57,
-	0xe4, 0xf0, 0xc7, 0xb0, 0x3e, 0x63, 0x34, 0x60, 0x3c, 0x93, 0xcb, 0xbb, 0xbb, 0x37, 0xca, 0x70,
-	0xd1, 0x67, 0xf1, 0x34, 0x03, 0xc9, 0x7e, 0x92, 0x9c, 0x85, 0x2c, 0x73, 0xea, 0x6f, 0x24, 0x17,
-	0x0c, 0xb4, 0x80, 0x9f, 0x04, 0xd5, 0x34, 0x91, 0x88, 0x9b, 0xa0, 0xa1, 0x38, 0x9d, 0x33, 0x2c,
-	0x7d, 0x6f, 0x36, 0xd4, 0x27, 0xd0, 0xca, 0x92, 0x9c, 0xfb, 0xca, 0x52, 0x9b, 0xbb, 0x9b, 0x7a,
-	0xb3, 0xb1, 0x44, 0x75, 0x50, 0x28, 0x0e, 0x9a, 0x35, 0x8c, 0x03, 0x76, 0x21, 0xa3, 0x5e, 0xef,
-	0xa7, 0x20, 0xf7, 0x1b, 0xd8, 0xfc, 0x9a, 0x46, 0x61, 0x40, 0xd1, 0xeb, 0x5e, 0x1e, 0x61, 0x6e,
-	0xb6, 0x79, 0x1e, 0xb1, 0xc9, 0x22, 0x55, 0x3b, 0x1b, 0x69, 0xe2, 0x15, 0xb8, 0xf6, 0xaf, 0xe6,
-	0x91, 0x1f, 0x02, 0xb0, 0x8b, 0x94, 0xb3, 0x2c, 0xc3, 0x88, 0x33, 0xbd, 0x67, 0xe0, 0xee, 0x5f,
-	0x2d, 0x80, 0xe5, 0x66, 0xe4, 0x73, 0xe8, 0xa4, 0xfa, 0xac, 0x72, 0xa7, 0x8a, 0xd1, 0x0a, 0x81,
-	0x8e, 0xb6, 0x92, 0x89, 0xd1, 0xc6, 0xd9, 0x6f, 0xf2, 0x90, 0xb3, 0x40, 0xee, 0xd4, 0x2e, 0xb5,
-	0x29, 0x50, 0xb2, 0x0b, 0x4d, 0xd4, 0x4c, 0x7b, 0xa2, 0xcc, 0xac, 0xea, 0x41, 0xb5, 0x1d, 0x24,
-	0xd5, 0x0d, 0x61, 0xc3, 0x63, 0x82, 0x2f, 0xc6, 0x02, 0x33, 0x61, 0xba, 0xc0, 0x6d, 0x42, 0x5d,
-	0xbc, 0x2d, 0xc3, 0x6e, 0x25, 0x8a, 0x8c, 0x39, 0xbd, 0xc0, 0x42, 0x9b, 0x55, 0x6a, 0x6a, 0x89,
-	0x92, 0x9b, 0xd0, 0x44, 0xaf, 0x2a, 0x45, 0x9a, 0x9e, 0x7a, 0x70, 0xff, 0xd9, 0x80, 0xde, 0x7e,
-	0x98, 0xa5, 0x54, 0xf8, 0xb3, 0xa7, 0x49, 0xc0, 0xbe, 0x57, 0x8e, 0xed, 0x02, 0xe4, 0x3c, 0xf2,
-	0xd8, 0x4b, 0x1e, 0x0a, 0x9d, 0x1f, 0xa4, 0x28, 0x7d, 0xf0, 0xdc, 0x3b, 0x2c, 0x24, 0x9e, 0xc1,
-	0x42, 0x05, 0xa9, 0x10, 0xfc, 0x29, 0xc6, 0x50, 0xdd, 0xf0, 0x49, 0x89, 0x92, 0xcf, 0xa0, 0x7b,
-	0x5e, 0x1a, 0x25, 0x73, 0x1a, 0xd2, 0x5e, 0xe4, 0x0a, 0x7b, 0x99, 0x34, 0xf2, 0x21, 0x34, 0x7d,
-	0xea, 0xcf, 0x58, 0x51, 0xf1, 0x36, 0xca, 0xca, 0x85, 0xa0, 0xa7, 0x64, 0xe4, 0xe7, 0xd0, 0x0b,
-	0xd8, 0x29, 0xcd, 0x23, 0x21, 0x83, 0xbf, 0xa8, 0x72, 0xcb, 0xea, 0x58, 0xe6, 0x9e, 0x54, 0xca,
-	0xf2, 0x2a, 0x6c, 0x0c, 0xa8, 0x3c, 0x63, 0xfb, 0x0a, 0x92, 0x95, 0x4e, 0xbb, 0xd9, 0xc0, 0x91,
-	0x75, 0x82, 0x56, 0x3c, 0x90, 0xd1, 0xdd, 0x36, 0x7c, 0x60, 0xe0, 0xe4, 0x0b, 0xd8, 0xe0, 0xa6,
-	0x6b, 0x9d, 0x8e, 0x54, 0xe5, 0x56, 0x19, 0xd5, 0xa6, 0xd0, 0xab, 0x72, 0xb1, 0xd3, 0x4a, 0x63,
-	0xea, 0x4e, 0x0b, 0x66, 0xa7, 0x35, 0x25, 0xe4, 0x3e, 0x74, 0x39, 0xa3, 0x81, 0x26, 0x76, 0x0d,
-	0xa2, 0x29, 0xc0, 0xfc, 0x9a, 0x25, 0x99, 0x90, 0xf9, 0xd5, 0xab, 0xe6, 0xd7, 0x93, 0x02, 0xd7,
-	0x7e, 0xd2, 0x3c, 0x3c, 0xa8, 0x8f, 0x91, 0x30, 0x47, 0x86, 0xb3, 0x61, 0xe6, 0xd7, 0x12, 0x77,
-	0xff, 0x64, 0x41, 0x53, 0xfa, 0x80, 0x7c, 0x0c, 0x8d, 0x33, 0xb6, 0xc8, 0x64, 0x51, 0x7c, 0x4b,
-	0x56, 0x49, 0x12, 0x86, 0x49, 0xc0, 0x68, 0x10, 0x85, 0x31, 0xab, 0x96, 0x6f, 0x8d, 0x92, 0x9f,
-	0x00, 0xf8, 0x49, 0x1c, 0x84, 0x2a, 0x4a, 0x56, 0xea, 0xdb, 0x50, 0x4b, 0x4a, 0x8d, 0x4a, 0xaa,
-	0xfb, 0x4b, 0xd8, 0xf4, 0x58, 0x1c, 0x30, 0x3e, 0x61, 0xf3, 0x34, 0x52, 0xf3, 0xc5, 0x7a, 0x72,
-	0xf2, 0x0d, 0xf3, 0x85, 0x56, 0xee, 0xe6, 0xd2, 0x0d, 0x48, 0x3c, 0x96, 0x42, 0x4f, 0x93, 0xdc,
-	0x73, 0xe8, 0x99, 0x82, 0xb7, 0xd4, 0xc4, 0x1d, 0x68, 0x62, 0x5c, 0xeb, 0x62, 0x4d, 0xaa, 0xef,
-	0x1d, 0x08, 0xc1, 0x3d, 0x45, 0xc0, 0x7c, 0x3b, 0x8d, 0xa8, 0x18, 0x48, 0x76, 0xdd, 0x88, 0xad,
-	0x25, 0xec, 0x1e, 0x02, 0x2c, 0x17, 0xbe, 0x65, 0x57, 0x59, 0xf9, 0x04, 0xa7, 0xbe, 0x78, 0x74,
-	0x91, 0xae, 0x56, 0x3e, 0x8d, 0xbb, 0x7f, 0x68, 0x43, 0x7d, 0x30, 0x3a, 0xb8, 0xe6, 0x30, 0xab,
-	0x72, 0x7f, 0x44, 0x85, 0x60, 0x3c, 0x2e, 0x32, 0xd9, 0xcc, 0xfd, 0x42, 0xe2, 0x19, 0x2c, 0x39,
-	0xb8, 0x30, 0x31, 0x4b, 0x02, 0xd9, 0x64, 0x3a, 0xe5, 0xe0, 0x22, 0x31, 0x94, 0x06, 0xc9, 0x9c,
-	0x86, 0x6a, 0xe8, 0x2a, 0xa5, 0x0a, 0x93, 0xdd, 0x45, 0x50, 0x91, 0x67, 0x32, 0x69, 0xcd, 0xee,
-	0x22, 0xd1, 0xb2, 0xbb, 0xc8, 0x27, 0xf2, 0x2b, 0xd8, 0x0a, 0xd3, 0x4a, 0x63, 0x96, 0xf9, 0xda,
-	0x5d, 0x8e, 0x1c, 0x2b, 0x7d, 0x7b, 0xef, 0x03, 0x4c, 0xf8, 0xd7, 0xaf, 0xee, 0xae, 0x36, 0x74,
-	0x6f, 0xf5, 0x45, 0x97, 0x8a, 0x48, 0xfb, 0x9d, 0x8a, 0x48, 0x1f, 0x9a, 0xb1, 0x2c, 0xbf, 0x9d,
-	0x6a, 0xa4, 0x99, 0xc5, 0xd7, 0x53, 0x14, 0x2c, 0xd5, 0x29, 0xe3, 0xf3, 0xcc, 0x01, 0x39, 0x29,
-	0xa8, 0x07, 0xf4, 0x2e, 0xcd, 0xc5, 0xec, 0xcb, 0x30, 0xc2, 0x1e, 0xd5, 0x35, 0xbd, 0xbb, 0xc4,
-	0x71, 0xa4, 0xe3, 0x95, 0x28, 0x97, 0x79, 0x6d, 0x34, 0x9e, 0x6a, 0x0e, 0x78, 0x2b, 0xec, 0x95,
-	0x62, 0xb7, 0xf1, 0x86, 0x62, 0xf7, 0x39, 0x74, 0xe6, 0xa8, 0x35, 0xf6, 0x2e, 0x67, 0x53, 0x3a,
-	0xa6, 0xcc, 0xc1, 0x23, 0x2d, 0xd0, 0x81, 0x5c, 0x32, 0x31, 0xbb, 0xd3, 0x24, 0x93, 0xf9, 0xe8,
-	0x6c, 0xdd, 0xb3, 0x76, 0x36, 0xca, 0x19, 0xb7, 0x40, 0xc9, 0x8f, 0xa0, 0x21, 0xe8, 0x34, 0x73,
-	0xec, 0x37, 0xcd, 0x2d, 0x52, 0x4c, 0xf6, 0xc1, 0x7e, 0xc9, 0x4e, 0xc6, 0x89, 0x7f, 0xc6, 0x8a,
-	0x21, 0x31, 0x73, 0x6e, 0xc8, 0x73, 0x3a, 0x7a, 0xc9, 0x8b, 0x15, 0xb9, 0x77, 0x69, 0x85, 0x31,
-	0x50, 0x93, 0x2b, 0x06, 0xea, 0xcb, 0xc3, 0xf1, 0x7b, 0xef, 0x34, 0x1c, 0x5f, 0x31, 0xfe, 0xde,
-	0xbc, 0xce, 0xf8, 0x8b, 0x6a, 0xe6, 0x19, 0x9b, 0x1c, 0x8e, 0x9d, 0x5b, 0x86, 0x3b, 0x0a, 0x8c,
-	0xfc, 0x14, 0x7a, 0x22, 0xca, 0x1e, 0xcd, 0x4f, 0x58, 0x30, 0x64, 0x5c, 0x38, 0xef, 0x4b, 0x25,
-	0xcb, 0xf8, 0x9a, 0x1c, 0x8e, 0x4b, 0x99, 0x57, 0x61, 0xba, 0x23, 0xe8, 0x99, 0x52, 0xf4, 0x8e,
-	0xcf, 0xb8, 0xd8, 0xa7, 0x82, 0xaa, 0xd9, 0xb3, 0x08, 0xe4, 0x12, 0xc5, 0xdb, 0xe1, 0x19, 0x5b,
-	0x48, 0x42, 0xcd, 0x20, 0x68, 0xd0, 0xfd, 0xbd, 0x05, 0x9d, 0xb2, 0x04, 0x5f, 0x77, 0xa6, 0xfa,
-	0x10, 0xea, 0xfe, 0x3c, 0x2d, 0x86, 0xc9, 0x6e, 0x69, 0xec, 0xa3, 0x51, 0x41, 0x45, 0x29, 0xda,
-	0x84, 0x5d, 0xa4, 0xcc, 0x17, 0x95, 0x61, 0xa2, 0xc0, 0xdc, 0xbf, 0xd7, 0x60, 0xdd, 0x4b, 0x72,
-	0x11, 0xc6, 0xd3, 0xb7, 0x96, 0xb9, 0xca, 0xb0, 0x53, 0xbb, 0x7a, 0xd8, 0xb9, 0x6e, 0xbf, 0x21,
-	0x0f, 0xa1, 0x9d, 0xe9, 0x2e, 0xdf, 0x58, 0x71, 0xbc, 0xd2, 0x4d, 0x37, 0xf6, 0xf2, 0x8a, 0xa2,
-	0x1b, 0xfd, 0x7d, 0xe8, 0x0a, 0xe3, 0x92, 0x6c, 0x5e, 0x46, 0x4d, 0xc1, 0x3b, 0x16, 0xc7, 0x1f,
-	0x40, 0x9d, 0xa6, 0xa1, 0x2c, 0x88, 0x8d, 0xbd, 0x6e, 0x61, 0x0a, 0x6c, 0x05, 0x1e, 0xe2, 0x65,
-	0xcd, 0x6f, 0xaf, 0xd6, 0x7c, 0xf7, 0xc7, 0x60, 0xbf, 0xb8, 0x22, 0x77, 0x12, 0x1e, 0x4e, 0xc3,
-	0xb8, 0xd2, 0x87, 0x0a, 0xcc, 0x7d, 0x08, 0xad, 0xf1, 0x02, 0x67, 0x01, 0xf2, 0x29, 0x8e, 0x9d,
-	0x79, 0x2c, 0x8a, 0x00, 0x78, 0x6f, 0x69, 0xb9, 0x3c, 0x16, 0x47, 0x4c, 0xf0, 0xd0, 0xd7, 0xc3,
-	0xaf, 0xe4, 0xb9, 0xff, 0xb0, 0xa0, 0x6b, 0x08, 0x31, 0xe6, 0x0a, 0x67, 0x54, 0xbe, 0x2c, 0x68,
-	0x10, 0x15, 0x51, 0x57, 0x3b, 0xe9, 0xc0, 0x32, 0x89, 0x15, 0xa6, 0xcf, 0xac, 0x3e, 0x1b, 0x5c,
-	0x3e, 0xf3, 0x36, 0xac, 0x73, 0xe5, 0x8b, 0xea, 0xe7, 0x8e, 0x02, 0xc4, 0x97, 0xa7, 0x51, 0x3e,
-	0x2d, 0x7a, 0x53, 0xf9, 0x72, 0x85, 0x91, 0x3e, 0x6c, 0xd0, 0x34, 0x8d, 0x42, 0x16, 0x8c, 0x14,
-	0xa9, 0x65, 0x7e, 0x58, 0xa9, 0x88, 0xdc, 0xbf, 0xd5, 0xa0, 0xa5, 0xfe, 0x5e, 0xb3, 0xf1, 0xde,
-	0x81, 0x16, 0x96, 0xf9, 0x84, 0x57, 0x23, 0x5e, 0x61, 0x78, 0xad, 0x62, 0x73, 0x1a, 0x46, 0x95,
-	0x0e, 0xab, 0x20, 0x23, 0x4a, 0x9a, 0xdf, 0x23, 0x4a, 0xee, 0x41, 0x3b, 0x4f, 0x03, 0x2a, 0xd8,
-	0x40, 0x54, 0xce, 0x53, 0xa2, 0x68, 0xb4, 0x73, 0xc6, 0xe5, 0xed, 0xca, 0xbc, 0xf6, 0x6b, 0x90,
-	0x7c, 0x02, 0x0d, 0x81, 0x03, 0xa5, 0xba, 0xec, 0x97, 0x0d, 0x52, 0x9d, 0xde, 0x18, 0x29, 0x25,
-	0x8b, 0x38, 0x78, 0x59, 0x8d, 0x05, 0x8b, 0x85, 0x9c, 0x85, 0x7b, 0x9e, 0x7e, 0x24, 0x36, 0xd4,
-	0xfd, 0xd3, 0xa9, 0x9c, 0x72, 0x7b, 0x1e, 0xfe, 0x75, 0x0f, 0x61, 0x73, 0x60, 0x5a, 0x35, 0x7b,
-	0xab, 0x2d, 0xb7, 0x01, 0x0a, 0x1f, 0x1c, 0xec, 0xab, 0x49, 0xac, 0xe1, 0x19, 0x48, 0xff, 0x23,
-	0x68, 0x29, 0x0b, 0x90, 0x36, 0x34, 0xf6, 0x93, 0x97, 0xb1, 0xbd, 0x46, 0x5a, 0x50, 0x7b, 0x9e,
-	0xda, 0x16, 0xe9, 0xc2, 0xfa, 0xf3, 0xf8, 0x2c, 0x46, 0xb0, 0xd6, 0x7f, 0x00, 0x1b, 0x45, 0xad,
-	0x5f, 0xf2, 0x8f, 0x53, 0x86, 0xfc, 0x36, 0x34, 0x9e, 0xd0, 0xe8, 0xd4, 0xb6, 0x48, 0x07, 0x9a,
-	0xf2, 0x7b, 0x92, 0x5d, 0xeb, 0x0f, 0xa1, 0x6b, 0x7c, 0xd5, 0x23, 0x9b, 0x00, 0x5e, 0x92, 0xc7,
-	0x81, 0x97, 0x9c, 0x84, 0xb8, 0x06, 0xa0, 0x75, 0x30, 0x7a, 0x42, 0xb3, 0x99, 0x6d, 0xa1, 0xec,
-	0x45, 0x38, 0x9d, 0x09, 0x25, 0xab, 0xe1, 0xfb, 0x3c, 0x1a, 0x07, 0x76, 0xbd, 0xff, 0x33, 0x68,
-	0xeb, 0x2f, 0x41, 0x72, 0x97, 0xc9, 0x64, 0xa4, 0xf6, 0x7b, 0xcc, 0x53, 0x5f, 0xed, 0xb7, 0x9f,
-	0x9f, 0x9c, 0x24, 0x76, 0x8d, 0x6c, 0x41, 0x77, 0x9c, 0xf2, 0x30, 0x9e, 0x0e, 0xa3, 0x24, 0xc7,
-	0xb5, 0xbf, 0x86, 0x96, 0xba, 0x7c, 0xa3, 0xe8, 0x59, 0xce, 0xe4, 0x1d, 0x22, 0x8c, 0xa7, 0xf6,
-	0x1a, 0xe9, 0x41, 0xfb, 0xcb, 0x84, 0xcf, 0xb1, 0x5c, 0xdb, 0x16, 0x3e, 0x7d, 0x35, 0x3e, 0x7e,
-	0xba, 0x97, 0x04, 0x0b, 0xbb, 0x86, 0x8a, 0x3d, 0x91, 0x9f, 0x10, 0xec, 0x3a, 0xfe, 0x1f, 0xca,
-	0x2f, 0x04, 0x76, 0x83, 0x6c, 0x40, 0x67, 0x44, 0xc5, 0x4c, 0x36, 0x60, 0xbb, 0xd9, 0xbf, 0x0d,
-	0x6d, 0x7d, 0xf9, 0x96, 0x67, 0xcb, 0x23, 0xe6, 0xb1, 0x29, 0xbb, 0x48, 0xed, 0xb5, 0xfe, 0x73,
-	0xa8, 0x0f, 0x8f, 0x46, 0xd2, 0x18, 0x47, 0xa3, 0x47, 0xcf, 0xec, 0xb5, 0xe2, 0xef, 0xe1, 0xa4,
-	0x30, 0xd1, 0xd1, 0xe8, 0xf0, 0x91, 0x5d, 0x2b, 0xfe, 0x3e, 0x9e, 0xd8, 0x75, 0xfd, 0xf7, 0x91,
-	0xdd, 0x28, 0xfe, 0x1e, 0xc4, 0x76, 0x13, 0x35, 0x1b, 0x1e, 0x8d, 0xe4, 0x2c, 0x61, 0xb7, 0xfa,
-	0xf7, 0x61, 0x6b, 0xa5, 0x66, 0xa2, 0x25, 0x86, 0x49, 0xba, 0x50, 0x3b, 0x8c, 0xd3, 0x28, 0x14,
-	0xb6, 0xd5, 0x7f, 0x08, 0x9d, 0x72, 0xfc, 0x20, 0x36, 0xf4, 0xe4, 0x43, 0x31, 0xb4, 0xa8, 0xc3,
-	0x4b, 0x64, 0x10, 0x45, 0xea, 0xf0, 0xea, 0x29, 0x5e, 0xd8, 0xb5, 0xfe, 0x00, 0xda, 0xfa, 0xca,
-	0x83, 0xa7, 0xc2, 0xff, 0xc7, 0xb2, 0x98, 0xd9, 0x6b, 0xe4, 0x16, 0xdc, 0xc0, 0x67, 0xf5, 0xc5,
-	0x6f, 0x10, 0x04, 0x9c, 0x65, 0x99, 0x72, 0x1e, 0xc2, 0xc3, 0x3c, 0x13, 0xc9, 0xdc, 0xae, 0xf5,
-	0x3f, 0x82, 0xad, 0x95, 0x96, 0x8e, 0x5a, 0xbe, 0xa0, 0xa1, 0x50, 0x5e, 0xf7, 0x18, 0x5e, 0x1b,
-	0x6c, 0xab, 0x7f, 0x07, 0x60, 0x99, 0x0d, 0xf8, 0x9a, 0xaf, 0xe8, 0x39, 0x1d, 0xfb, 0x3c, 0x4c,
-	0x85, 0xbd, 0xb6, 0x77, 0xf3, 0xbb, 0x7f, 0x6f, 0xaf, 0x7d, 0xfb, 0x7a, 0xdb, 0xfa, 0xee, 0xf5,
-	0xb6, 0xf5, 0xaf, 0xd7, 0xdb, 0xd6, 0x5f, 0xfe, 0xb3, 0xbd, 0xf6, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xc8, 0x5c, 0x9e, 0x49, 0xf6, 0x16, 0x00, 0x00,
+func (m *Routing) GetTrafficRate() int32 {
+	if m != nil {
+		return m.TrafficRate
+	}
+	return 0
 }
 
-func (m *Proxy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Routing) GetStatus() Status {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return Down
 }
 
-func (m *Proxy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
-	i += copy(dAtA[i:], m.Addr)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AddrRPC)))
-	i += copy(dAtA[i:], m.AddrRPC)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Routing) GetAPI() uint64 {
+	if m != nil {
+		return m.API
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Cluster) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Routing) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.LoadBalance))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Routing) GetRollout() *Rollout {
+	if m != nil {
+		return m.Rollout
 	}
-	return i, nil
+	return nil
 }
 
-func (m *HeathCheck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Routing) GetStickyKey() *Parameter {
+	if m != nil {
+		return m.StickyKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HeathCheck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
-	i += copy(dAtA[i:], m.Path)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
-	i += copy(dAtA[i:], m.Body)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.CheckInterval))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Timeout))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Routing) GetCompare() *ShadowCompare {
+	if m != nil {
+		return m.Compare
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+// ShadowCompare compare the response of the copy routing with the primary
+// response, the status code, the headers and the json body are compared.
+type ShadowCompare struct {
+	Headers              []string `protobuf:"bytes,1,rep,name=headers" json:"headers,omitempty"`
+	IgnoredPaths         []string `protobuf:"bytes,2,rep,name=ignoredPaths" json:"ignoredPaths,omitempty"`
+	SampleRate           int32    `protobuf:"varint,3,opt,name=sampleRate" json:"sampleRate"`
+	MaxSamples           int32    `protobuf:"varint,4,opt,name=maxSamples" json:"maxSamples"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShadowCompare) Reset()         { *m = ShadowCompare{} }
+func (m *ShadowCompare) String() string { return proto.CompactTextString(m) }
+func (*ShadowCompare) ProtoMessage()    {}
+func (*ShadowCompare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{26}
+}
+func (m *ShadowCompare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShadowCompare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShadowCompare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ShadowCompare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShadowCompare.Merge(m, src)
+}
+func (m *ShadowCompare) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShadowCompare) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShadowCompare.DiscardUnknown(m)
 }
 
-func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.CloseTimeout))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.HalfTrafficRate))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.RateCheckPeriod))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.FailureRateToClose))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.SucceedRateToOpen))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ShadowCompare proto.InternalMessageInfo
+
+func (m *ShadowCompare) GetHeaders() []string {
+	if m != nil {
+		return m.Headers
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Server) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ShadowCompare) GetIgnoredPaths() []string {
+	if m != nil {
+		return m.IgnoredPaths
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Server) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
-	i += copy(dAtA[i:], m.Addr)
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Protocol))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
-	if m.HeathCheck != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.HeathCheck.Size()))
-		n1, err1 := m.HeathCheck.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
-		}
-		i += n1
+func (m *ShadowCompare) GetSampleRate() int32 {
+	if m != nil {
+		return m.SampleRate
 	}
-	if m.CircuitBreaker != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
-		n2, err2 := m.CircuitBreaker.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
+	return 0
+}
+
+func (m *ShadowCompare) GetMaxSamples() int32 {
+	if m != nil {
+		return m.MaxSamples
+	}
+	return 0
+}
+
+// ShadowDiff is a sample of the mismatched responses of a copy routing
+type ShadowDiff struct {
+	RoutingID            uint64   `protobuf:"varint,1,opt,name=routingID" json:"routingID"`
+	At                   int64    `protobuf:"varint,2,opt,name=at" json:"at"`
+	Method               string   `protobuf:"bytes,3,opt,name=method" json:"method"`
+	URI                  string   `protobuf:"bytes,4,opt,name=uri" json:"uri"`
+	Proxy                string   `protobuf:"bytes,5,opt,name=proxy" json:"proxy"`
+	Diffs                []string `protobuf:"bytes,6,rep,name=diffs" json:"diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShadowDiff) Reset()         { *m = ShadowDiff{} }
+func (m *ShadowDiff) String() string { return proto.CompactTextString(m) }
+func (*ShadowDiff) ProtoMessage()    {}
+func (*ShadowDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{27}
+}
+func (m *ShadowDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShadowDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShadowDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i += n2
+		return b[:n], nil
 	}
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Weight))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.RateLimitOption))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *ShadowDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShadowDiff.Merge(m, src)
+}
+func (m *ShadowDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShadowDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShadowDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShadowDiff proto.InternalMessageInfo
+
+func (m *ShadowDiff) GetRoutingID() uint64 {
+	if m != nil {
+		return m.RoutingID
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Bind) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ShadowDiff) GetAt() int64 {
+	if m != nil {
+		return m.At
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Bind) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ShadowDiff) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return i, nil
+	return ""
 }
 
-func (m *PairValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ShadowDiff) GetURI() string {
+	if m != nil {
+		return m.URI
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PairValue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
-	i += copy(dAtA[i:], m.Value)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ShadowDiff) GetProxy() string {
+	if m != nil {
+		return m.Proxy
 	}
-	return i, nil
+	return ""
 }
 
-func (m *IPAccessControl) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ShadowDiff) GetDiffs() []string {
+	if m != nil {
+		return m.Diffs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IPAccessControl) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+// Rollout is the progressive canary of a split routing, the traffic rate of the
+// routing is increased by steps, and the routing is set to down if the canary
+// cluster is worse than the baseline cluster.
+type Rollout struct {
+	Steps                  []int32       `protobuf:"varint,1,rep,name=steps" json:"steps,omitempty"`
+	StepInterval           int64         `protobuf:"varint,2,opt,name=stepInterval" json:"stepInterval"`
+	BaselineClusterID      uint64        `protobuf:"varint,3,opt,name=baselineClusterID" json:"baselineClusterID"`
+	CheckPeriod            int64         `protobuf:"varint,4,opt,name=checkPeriod" json:"checkPeriod"`
+	MinRequests            int64         `protobuf:"varint,5,opt,name=minRequests" json:"minRequests"`
+	MaxFailureRateIncrease int32         `protobuf:"varint,6,opt,name=maxFailureRateIncrease" json:"maxFailureRateIncrease"`
+	MaxLatencyIncrease     int32         `protobuf:"varint,7,opt,name=maxLatencyIncrease" json:"maxLatencyIncrease"`
+	Status                 RolloutStatus `protobuf:"bytes,8,opt,name=status" json:"status"`
+	XXX_NoUnkeyedLiteral   struct{}      `json:"-"`
+	XXX_unrecognized       []byte        `json:"-"`
+	XXX_sizecache          int32         `json:"-"`
+}
+
+func (m *Rollout) Reset()         { *m = Rollout{} }
+func (m *Rollout) String() string { return proto.CompactTextString(m) }
+func (*Rollout) ProtoMessage()    {}
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{28}
+}
+func (m *Rollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Rollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Rollout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Blacklist) > 0 {
-		for _, s := range m.Blacklist {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *Rollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rollout.Merge(m, src)
+}
+func (m *Rollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *Rollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rollout.DiscardUnknown(m)
 }
 
-func (m *HTTPResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Rollout proto.InternalMessageInfo
+
+func (m *Rollout) GetSteps() []int32 {
+	if m != nil {
+		return m.Steps
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HTTPResult) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Body != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
-		i += copy(dAtA[i:], m.Body)
-	}
-	if len(m.Headers) > 0 {
-		for _, msg := range m.Headers {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Cookies) > 0 {
-		for _, msg := range m.Cookies {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Code))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Rollout) GetStepInterval() int64 {
+	if m != nil {
+		return m.StepInterval
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Parameter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Rollout) GetBaselineClusterID() uint64 {
+	if m != nil {
+		return m.BaselineClusterID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Source))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Index))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Rollout) GetCheckPeriod() int64 {
+	if m != nil {
+		return m.CheckPeriod
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ValidationRule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Rollout) GetMinRequests() int64 {
+	if m != nil {
+		return m.MinRequests
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidationRule) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.RuleType))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expression)))
-	i += copy(dAtA[i:], m.Expression)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Rollout) GetMaxFailureRateIncrease() int32 {
+	if m != nil {
+		return m.MaxFailureRateIncrease
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Validation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Rollout) GetMaxLatencyIncrease() int32 {
+	if m != nil {
+		return m.MaxLatencyIncrease
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Validation) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
-	n3, err3 := m.Parameter.MarshalTo(dAtA[i:])
-	if err3 != nil {
-		return 0, err3
+func (m *Rollout) GetStatus() RolloutStatus {
+	if m != nil {
+		return m.Status
 	}
-	i += n3
-	dAtA[i] = 0x10
-	i++
-	if m.Required {
-		dAtA[i] = 1
+	return RolloutStatus{}
+}
+
+// RolloutStatus is the status of the progressive rollout
+type RolloutStatus struct {
+	State                RolloutState   `protobuf:"varint,1,opt,name=state,enum=metapb.RolloutState" json:"state"`
+	Step                 int32          `protobuf:"varint,2,opt,name=step" json:"step"`
+	StepStartAt          int64          `protobuf:"varint,3,opt,name=stepStartAt" json:"stepStartAt"`
+	History              []RolloutEvent `protobuf:"bytes,4,rep,name=history" json:"history"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RolloutStatus) Reset()         { *m = RolloutStatus{} }
+func (m *RolloutStatus) String() string { return proto.CompactTextString(m) }
+func (*RolloutStatus) ProtoMessage()    {}
+func (*RolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{29}
+}
+func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutStatus.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0
-	}
-	i++
-	if len(m.Rules) > 0 {
-		for _, msg := range m.Rules {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *RolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStatus.Merge(m, src)
+}
+func (m *RolloutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStatus.DiscardUnknown(m)
 }
 
-func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
+
+func (m *RolloutStatus) GetState() RolloutState {
+	if m != nil {
+		return m.State
 	}
-	return dAtA[:n], nil
+	return RolloutRunning
 }
 
-func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Interval))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxTimes))
-	if len(m.Codes) > 0 {
-		for _, num := range m.Codes {
-			dAtA[i] = 0x18
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(num))
-		}
+func (m *RolloutStatus) GetStep() int32 {
+	if m != nil {
+		return m.Step
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *RolloutStatus) GetStepStartAt() int64 {
+	if m != nil {
+		return m.StepStartAt
 	}
-	return i, nil
+	return 0
 }
 
-func (m *DispatchNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RolloutStatus) GetHistory() []RolloutEvent {
+	if m != nil {
+		return m.History
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DispatchNode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLRewrite)))
-	i += copy(dAtA[i:], m.URLRewrite)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AttrName)))
-	i += copy(dAtA[i:], m.AttrName)
-	if len(m.Validations) > 0 {
-		for _, msg := range m.Validations {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Cache != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.Cache.Size()))
-		n4, err4 := m.Cache.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
-		}
-		i += n4
-	}
-	if m.DefaultValue != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
-		n5, err5 := m.DefaultValue.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
-		}
-		i += n5
-	}
-	dAtA[i] = 0x38
-	i++
-	if m.UseDefault {
-		dAtA[i] = 1
+// RolloutEvent is a step change of the progressive rollout
+type RolloutEvent struct {
+	At                   int64        `protobuf:"varint,1,opt,name=at" json:"at"`
+	Step                 int32        `protobuf:"varint,2,opt,name=step" json:"step"`
+	TrafficRate          int32        `protobuf:"varint,3,opt,name=trafficRate" json:"trafficRate"`
+	State                RolloutState `protobuf:"varint,4,opt,name=state,enum=metapb.RolloutState" json:"state"`
+	Reason               string       `protobuf:"bytes,5,opt,name=reason" json:"reason"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RolloutEvent) Reset()         { *m = RolloutEvent{} }
+func (m *RolloutEvent) String() string { return proto.CompactTextString(m) }
+func (*RolloutEvent) ProtoMessage()    {}
+func (*RolloutEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{30}
+}
+func (m *RolloutEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutEvent.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0
-	}
-	i++
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.BatchIndex))
-	if m.RetryStrategy != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.RetryStrategy.Size()))
-		n6, err6 := m.RetryStrategy.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i += n6
-	}
-	dAtA[i] = 0x50
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
-	dAtA[i] = 0x58
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
-	dAtA[i] = 0x60
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.HostType))
-	dAtA[i] = 0x6a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.CustemHost)))
-	i += copy(dAtA[i:], m.CustemHost)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *RolloutEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutEvent.Merge(m, src)
+}
+func (m *RolloutEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutEvent.DiscardUnknown(m)
 }
 
-func (m *Cache) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RolloutEvent proto.InternalMessageInfo
+
+func (m *RolloutEvent) GetAt() int64 {
+	if m != nil {
+		return m.At
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Cache) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, msg := range m.Keys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *RolloutEvent) GetStep() int32 {
+	if m != nil {
+		return m.Step
 	}
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Deadline))
-	if len(m.Conditions) > 0 {
-		for _, msg := range m.Conditions {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return 0
+}
+
+func (m *RolloutEvent) GetTrafficRate() int32 {
+	if m != nil {
+		return m.TrafficRate
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *RolloutEvent) GetState() RolloutState {
+	if m != nil {
+		return m.State
 	}
-	return i, nil
+	return RolloutRunning
 }
 
-func (m *RenderTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RolloutEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RenderTemplate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+// WebSocketOptions websocket options
+type WebSocketOptions struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin" json:"origin"`
+	Secure               bool     `protobuf:"varint,2,opt,name=secure" json:"secure"`
+	IdleTimeout          int64    `protobuf:"varint,3,opt,name=idleTimeout" json:"idleTimeout"`
+	PingInterval         int64    `protobuf:"varint,4,opt,name=pingInterval" json:"pingInterval"`
+	PongTimeout          int64    `protobuf:"varint,5,opt,name=pongTimeout" json:"pongTimeout"`
+	MaxConnections       int64    `protobuf:"varint,6,opt,name=maxConnections" json:"maxConnections"`
+	MaxMessageSize       int64    `protobuf:"varint,7,opt,name=maxMessageSize" json:"maxMessageSize"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebSocketOptions) Reset()         { *m = WebSocketOptions{} }
+func (m *WebSocketOptions) String() string { return proto.CompactTextString(m) }
+func (*WebSocketOptions) ProtoMessage()    {}
+func (*WebSocketOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{31}
+}
+func (m *WebSocketOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebSocketOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebSocketOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *WebSocketOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketOptions.Merge(m, src)
+}
+func (m *WebSocketOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebSocketOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketOptions.DiscardUnknown(m)
 }
 
-func (m *RenderObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WebSocketOptions proto.InternalMessageInfo
+
+func (m *WebSocketOptions) GetOrigin() string {
+	if m != nil {
+		return m.Origin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RenderObject) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	if len(m.Attrs) > 0 {
-		for _, msg := range m.Attrs {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	dAtA[i] = 0x18
-	i++
-	if m.FlatAttrs {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+func (m *WebSocketOptions) GetSecure() bool {
+	if m != nil {
+		return m.Secure
 	}
-	i++
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return false
+}
+
+func (m *WebSocketOptions) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
 	}
-	return i, nil
+	return 0
 }
 
-func (m *RenderAttr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *WebSocketOptions) GetPingInterval() int64 {
+	if m != nil {
+		return m.PingInterval
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RenderAttr) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ExtractExp)))
-	i += copy(dAtA[i:], m.ExtractExp)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *WebSocketOptions) GetPongTimeout() int64 {
+	if m != nil {
+		return m.PongTimeout
 	}
-	return i, nil
+	return 0
 }
 
-func (m *API) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *WebSocketOptions) GetMaxConnections() int64 {
+	if m != nil {
+		return m.MaxConnections
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *API) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLPattern)))
-	i += copy(dAtA[i:], m.URLPattern)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
-	i += copy(dAtA[i:], m.Method)
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Domain)))
-	i += copy(dAtA[i:], m.Domain)
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
-	if m.IPAccessControl != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.IPAccessControl.Size()))
-		n7, err7 := m.IPAccessControl.MarshalTo(dAtA[i:])
-		if err7 != nil {
-			return 0, err7
-		}
-		i += n7
-	}
-	if m.DefaultValue != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
-		n8, err8 := m.DefaultValue.MarshalTo(dAtA[i:])
-		if err8 != nil {
-			return 0, err8
-		}
-		i += n8
-	}
-	if len(m.Nodes) > 0 {
-		for _, msg := range m.Nodes {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Perms) > 0 {
-		for _, s := range m.Perms {
-			dAtA[i] = 0x52
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AuthFilter)))
-	i += copy(dAtA[i:], m.AuthFilter)
-	if m.RenderTemplate != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.RenderTemplate.Size()))
-		n9, err9 := m.RenderTemplate.MarshalTo(dAtA[i:])
-		if err9 != nil {
-			return 0, err9
-		}
-		i += n9
-	}
-	dAtA[i] = 0x68
-	i++
-	if m.UseDefault {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	dAtA[i] = 0x70
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MatchRule))
-	dAtA[i] = 0x78
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Position))
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.WebSocketOptions != nil {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.WebSocketOptions.Size()))
-		n10, err10 := m.WebSocketOptions.MarshalTo(dAtA[i:])
-		if err10 != nil {
-			return 0, err10
-		}
-		i += n10
-	}
-	dAtA[i] = 0x90
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
-	if m.CircuitBreaker != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
-		n11, err11 := m.CircuitBreaker.MarshalTo(dAtA[i:])
-		if err11 != nil {
-			return 0, err11
-		}
-		i += n11
-	}
-	dAtA[i] = 0xa0
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.RateLimitOption))
-	dAtA[i] = 0xa8
-	i++
-	dAtA[i] = 0x1
-	i++
-	if m.UseTLS {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	if m.TlsEmbedCert != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(m.TlsEmbedCert.Size()))
-		n12, err12 := m.TlsEmbedCert.MarshalTo(dAtA[i:])
-		if err12 != nil {
-			return 0, err12
-		}
-		i += n12
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *WebSocketOptions) GetMaxMessageSize() int64 {
+	if m != nil {
+		return m.MaxMessageSize
 	}
-	return i, nil
+	return 0
 }
 
-func (m *TLSEmbedCert) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type SSEOptions struct {
+	IdleTimeout          int64    `protobuf:"varint,1,opt,name=idleTimeout" json:"idleTimeout"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TLSEmbedCert) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CertData != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.CertData)))
-		i += copy(dAtA[i:], m.CertData)
-	}
-	if m.KeyData != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.KeyData)))
-		i += copy(dAtA[i:], m.KeyData)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SSEOptions) Reset()         { *m = SSEOptions{} }
+func (m *SSEOptions) String() string { return proto.CompactTextString(m) }
+func (*SSEOptions) ProtoMessage()    {}
+func (*SSEOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{32}
+}
+func (m *SSEOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSEOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSEOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *SSEOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSEOptions.Merge(m, src)
+}
+func (m *SSEOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSEOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSEOptions.DiscardUnknown(m)
 }
 
-func (m *Condition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SSEOptions proto.InternalMessageInfo
+
+func (m *SSEOptions) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
-	n13, err13 := m.Parameter.MarshalTo(dAtA[i:])
-	if err13 != nil {
-		return 0, err13
-	}
-	i += n13
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Cmp))
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expect)))
-	i += copy(dAtA[i:], m.Expect)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// System system
+type System struct {
+	Count                CountMetric `protobuf:"bytes,1,opt,name=count" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Routing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *System) Reset()         { *m = System{} }
+func (m *System) String() string { return proto.CompactTextString(m) }
+func (*System) ProtoMessage()    {}
+func (*System) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{33}
 }
-
-func (m *Routing) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
-	if len(m.Conditions) > 0 {
-		for _, msg := range m.Conditions {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *System) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *System) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_System.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Strategy))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.TrafficRate))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *System) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_System.Merge(m, src)
+}
+func (m *System) XXX_Size() int {
+	return m.Size()
+}
+func (m *System) XXX_DiscardUnknown() {
+	xxx_messageInfo_System.DiscardUnknown(m)
 }
 
-func (m *WebSocketOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_System proto.InternalMessageInfo
+
+func (m *System) GetCount() CountMetric {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return CountMetric{}
 }
 
-func (m *WebSocketOptions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Origin)))
-	i += copy(dAtA[i:], m.Origin)
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+// CountMetric count metric
+type CountMetric struct {
+	Cluster              int64    `protobuf:"varint,1,opt,name=cluster" json:"cluster"`
+	Server               int64    `protobuf:"varint,2,opt,name=server" json:"server"`
+	API                  int64    `protobuf:"varint,3,opt,name=api" json:"api"`
+	Routing              int64    `protobuf:"varint,4,opt,name=routing" json:"routing"`
+	Plugin               int64    `protobuf:"varint,5,opt,name=plugin" json:"plugin"`
+	AppliedPlugin        int64    `protobuf:"varint,6,opt,name=appliedPlugin" json:"appliedPlugin"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountMetric) Reset()         { *m = CountMetric{} }
+func (m *CountMetric) String() string { return proto.CompactTextString(m) }
+func (*CountMetric) ProtoMessage()    {}
+func (*CountMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{34}
+}
+func (m *CountMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountMetric.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *CountMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountMetric.Merge(m, src)
+}
+func (m *CountMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountMetric.DiscardUnknown(m)
 }
 
-func (m *System) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CountMetric proto.InternalMessageInfo
+
+func (m *CountMetric) GetCluster() int64 {
+	if m != nil {
+		return m.Cluster
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *System) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Count.Size()))
-	n14, err14 := m.Count.MarshalTo(dAtA[i:])
-	if err14 != nil {
-		return 0, err14
+func (m *CountMetric) GetServer() int64 {
+	if m != nil {
+		return m.Server
 	}
-	i += n14
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *CountMetric) GetAPI() int64 {
+	if m != nil {
+		return m.API
 	}
-	return i, nil
+	return 0
 }
 
-func (m *CountMetric) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CountMetric) GetRouting() int64 {
+	if m != nil {
+		return m.Routing
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CountMetric) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Cluster))
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Server))
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
-	dAtA[i] = 0x20
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Routing))
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Plugin))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.AppliedPlugin))
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CountMetric) GetPlugin() int64 {
+	if m != nil {
+		return m.Plugin
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Plugin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CountMetric) GetAppliedPlugin() int64 {
+	if m != nil {
+		return m.AppliedPlugin
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Plugin) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
-	i += copy(dAtA[i:], m.Name)
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Author)))
-	i += copy(dAtA[i:], m.Author)
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Email)))
-	i += copy(dAtA[i:], m.Email)
-	dAtA[i] = 0x28
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
-	dAtA[i] = 0x30
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.UpdateAt))
-	dAtA[i] = 0x38
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Version))
-	dAtA[i] = 0x40
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
-	if m.Content != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Content)))
-		i += copy(dAtA[i:], m.Content)
-	}
-	if m.Cfg != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Cfg)))
-		i += copy(dAtA[i:], m.Cfg)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// Plugin plugin
+type Plugin struct {
+	ID                   uint64     `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name" json:"name"`
+	Author               string     `protobuf:"bytes,3,opt,name=author" json:"author"`
+	Email                string     `protobuf:"bytes,4,opt,name=email" json:"email"`
+	Status               Status     `protobuf:"varint,5,opt,name=status,enum=metapb.Status" json:"status"`
+	UpdateAt             int64      `protobuf:"varint,6,opt,name=updateAt" json:"updateAt"`
+	Version              int64      `protobuf:"varint,7,opt,name=version" json:"version"`
+	Type                 PluginType `protobuf:"varint,8,opt,name=type,enum=metapb.PluginType" json:"type"`
+	Content              []byte     `protobuf:"bytes,9,opt,name=content" json:"content,omitempty"`
+	Cfg                  []byte     `protobuf:"bytes,10,opt,name=cfg" json:"cfg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *AppliedPlugins) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Plugin) Reset()         { *m = Plugin{} }
+func (m *Plugin) String() string { return proto.CompactTextString(m) }
+func (*Plugin) ProtoMessage()    {}
+func (*Plugin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{35}
 }
-
-func (m *AppliedPlugins) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
-	if len(m.AppliedIDs) > 0 {
-		for _, num := range m.AppliedIDs {
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintMetapb(dAtA, i, uint64(num))
+func (m *Plugin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Plugin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Plugin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *Plugin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Plugin.Merge(m, src)
+}
+func (m *Plugin) XXX_Size() int {
+	return m.Size()
+}
+func (m *Plugin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Plugin.DiscardUnknown(m)
 }
 
-func encodeVarintMetapb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Plugin proto.InternalMessageInfo
+
+func (m *Plugin) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return 0
 }
-func (m *Proxy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.AddrRPC)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *Plugin) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *Cluster) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.LoadBalance))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Plugin) GetAuthor() string {
+	if m != nil {
+		return m.Author
 	}
-	return n
+	return ""
 }
 
-func (m *HeathCheck) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Body)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.CheckInterval))
-	n += 1 + sovMetapb(uint64(m.Timeout))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Plugin) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	return n
+	return ""
 }
 
-func (m *CircuitBreaker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.CloseTimeout))
-	n += 1 + sovMetapb(uint64(m.HalfTrafficRate))
-	n += 1 + sovMetapb(uint64(m.RateCheckPeriod))
-	n += 1 + sovMetapb(uint64(m.FailureRateToClose))
-	n += 1 + sovMetapb(uint64(m.SucceedRateToOpen))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Plugin) GetStatus() Status {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return Down
 }
 
-func (m *Server) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	l = len(m.Addr)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Protocol))
-	n += 1 + sovMetapb(uint64(m.MaxQPS))
-	if m.HeathCheck != nil {
-		l = m.HeathCheck.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.CircuitBreaker != nil {
-		l = m.CircuitBreaker.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	n += 1 + sovMetapb(uint64(m.Weight))
-	n += 1 + sovMetapb(uint64(m.RateLimitOption))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Plugin) GetUpdateAt() int64 {
+	if m != nil {
+		return m.UpdateAt
 	}
-	return n
+	return 0
 }
 
-func (m *Bind) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovMetapb(uint64(m.ClusterID))
-	n += 1 + sovMetapb(uint64(m.ServerID))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Plugin) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return n
+	return 0
 }
 
-func (m *PairValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Plugin) GetType() PluginType {
+	if m != nil {
+		return m.Type
 	}
-	return n
+	return JavaScript
 }
 
-func (m *IPAccessControl) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Whitelist) > 0 {
-		for _, s := range m.Whitelist {
-			l = len(s)
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
-	if len(m.Blacklist) > 0 {
-		for _, s := range m.Blacklist {
-			l = len(s)
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Plugin) GetContent() []byte {
+	if m != nil {
+		return m.Content
 	}
-	return n
+	return nil
 }
 
-func (m *HTTPResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Body != nil {
-		l = len(m.Body)
-		n += 1 + l + sovMetapb(uint64(l))
+func (m *Plugin) GetCfg() []byte {
+	if m != nil {
+		return m.Cfg
 	}
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
+	return nil
+}
+
+// AppliedPlugins applied plugins
+type AppliedPlugins struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=id" json:"id"`
+	AppliedIDs           []uint64 `protobuf:"varint,2,rep,name=appliedIDs" json:"appliedIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AppliedPlugins) Reset()         { *m = AppliedPlugins{} }
+func (m *AppliedPlugins) String() string { return proto.CompactTextString(m) }
+func (*AppliedPlugins) ProtoMessage()    {}
+func (*AppliedPlugins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{36}
+}
+func (m *AppliedPlugins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppliedPlugins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppliedPlugins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Cookies) > 0 {
-		for _, e := range m.Cookies {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+}
+func (m *AppliedPlugins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppliedPlugins.Merge(m, src)
+}
+func (m *AppliedPlugins) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppliedPlugins) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppliedPlugins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppliedPlugins proto.InternalMessageInfo
+
+func (m *AppliedPlugins) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	n += 1 + sovMetapb(uint64(m.Code))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *AppliedPlugins) GetAppliedIDs() []uint64 {
+	if m != nil {
+		return m.AppliedIDs
 	}
-	return n
+	return nil
 }
 
-func (m *Parameter) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("metapb.Status", Status_name, Status_value)
+	proto.RegisterEnum("metapb.CircuitStatus", CircuitStatus_name, CircuitStatus_value)
+	proto.RegisterEnum("metapb.LoadBalance", LoadBalance_name, LoadBalance_value)
+	proto.RegisterEnum("metapb.Protocol", Protocol_name, Protocol_value)
+	proto.RegisterEnum("metapb.Source", Source_name, Source_value)
+	proto.RegisterEnum("metapb.RuleType", RuleType_name, RuleType_value)
+	proto.RegisterEnum("metapb.CMP", CMP_name, CMP_value)
+	proto.RegisterEnum("metapb.Logic", Logic_name, Logic_value)
+	proto.RegisterEnum("metapb.TransformTarget", TransformTarget_name, TransformTarget_value)
+	proto.RegisterEnum("metapb.TransformAction", TransformAction_name, TransformAction_value)
+	proto.RegisterEnum("metapb.ResponseDecoder", ResponseDecoder_name, ResponseDecoder_value)
+	proto.RegisterEnum("metapb.FailurePolicy", FailurePolicy_name, FailurePolicy_value)
+	proto.RegisterEnum("metapb.RoutingStrategy", RoutingStrategy_name, RoutingStrategy_value)
+	proto.RegisterEnum("metapb.RolloutState", RolloutState_name, RolloutState_value)
+	proto.RegisterEnum("metapb.MatchRule", MatchRule_name, MatchRule_value)
+	proto.RegisterEnum("metapb.HostType", HostType_name, HostType_value)
+	proto.RegisterEnum("metapb.RateLimitOption", RateLimitOption_name, RateLimitOption_value)
+	proto.RegisterEnum("metapb.PluginType", PluginType_name, PluginType_value)
+	proto.RegisterType((*Proxy)(nil), "metapb.Proxy")
+	proto.RegisterType((*Cluster)(nil), "metapb.Cluster")
+	proto.RegisterType((*HeathCheck)(nil), "metapb.HeathCheck")
+	proto.RegisterType((*CircuitBreaker)(nil), "metapb.CircuitBreaker")
+	proto.RegisterType((*Server)(nil), "metapb.Server")
+	proto.RegisterType((*Bind)(nil), "metapb.Bind")
+	proto.RegisterType((*PairValue)(nil), "metapb.PairValue")
+	proto.RegisterType((*IPAccessControl)(nil), "metapb.IPAccessControl")
+	proto.RegisterType((*HTTPResult)(nil), "metapb.HTTPResult")
+	proto.RegisterType((*Parameter)(nil), "metapb.Parameter")
+	proto.RegisterType((*ValidationRule)(nil), "metapb.ValidationRule")
+	proto.RegisterType((*Validation)(nil), "metapb.Validation")
+	proto.RegisterType((*RetryStrategy)(nil), "metapb.RetryStrategy")
+	proto.RegisterType((*DispatchNode)(nil), "metapb.DispatchNode")
+	proto.RegisterType((*ProtobufDescriptor)(nil), "metapb.ProtobufDescriptor")
+	proto.RegisterType((*ForEach)(nil), "metapb.ForEach")
+	proto.RegisterType((*Transformation)(nil), "metapb.Transformation")
+	proto.RegisterType((*Cache)(nil), "metapb.Cache")
+	proto.RegisterType((*RenderTemplate)(nil), "metapb.RenderTemplate")
+	proto.RegisterType((*RenderObject)(nil), "metapb.RenderObject")
+	proto.RegisterType((*RenderAttr)(nil), "metapb.RenderAttr")
+	proto.RegisterType((*API)(nil), "metapb.API")
+	proto.RegisterType((*CORSPolicy)(nil), "metapb.CORSPolicy")
+	proto.RegisterType((*TLSEmbedCert)(nil), "metapb.TLSEmbedCert")
+	proto.RegisterType((*Condition)(nil), "metapb.Condition")
+	proto.RegisterType((*Routing)(nil), "metapb.Routing")
+	proto.RegisterType((*ShadowCompare)(nil), "metapb.ShadowCompare")
+	proto.RegisterType((*ShadowDiff)(nil), "metapb.ShadowDiff")
+	proto.RegisterType((*Rollout)(nil), "metapb.Rollout")
+	proto.RegisterType((*RolloutStatus)(nil), "metapb.RolloutStatus")
+	proto.RegisterType((*RolloutEvent)(nil), "metapb.RolloutEvent")
+	proto.RegisterType((*WebSocketOptions)(nil), "metapb.WebSocketOptions")
+	proto.RegisterType((*SSEOptions)(nil), "metapb.SSEOptions")
+	proto.RegisterType((*System)(nil), "metapb.System")
+	proto.RegisterType((*CountMetric)(nil), "metapb.CountMetric")
+	proto.RegisterType((*Plugin)(nil), "metapb.Plugin")
+	proto.RegisterType((*AppliedPlugins)(nil), "metapb.AppliedPlugins")
+}
+
+func init() { proto.RegisterFile("metapb.proto", fileDescriptor_77b4d575d5a68dda) }
+
+var fileDescriptor_77b4d575d5a68dda = []byte{
+	// 3694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0x4f, 0x6f, 0x1c, 0xc9,
+	0x75, 0xe7, 0xfc, 0x23, 0x87, 0x6f, 0x38, 0x64, 0xab, 0x44, 0xed, 0xb6, 0x15, 0x59, 0x2b, 0xb4,
+	0x1d, 0x7b, 0x77, 0xbc, 0xd0, 0x2e, 0xe8, 0xdd, 0xd8, 0x1b, 0x1b, 0x81, 0xc9, 0x21, 0x25, 0xd1,
+	0x21, 0xa5, 0x51, 0xcf, 0xec, 0x2a, 0x08, 0x90, 0x43, 0xb1, 0xbb, 0x66, 0xa6, 0x97, 0x3d, 0xdd,
+	0xed, 0xea, 0x6a, 0x89, 0x0c, 0x72, 0xcc, 0x2d, 0x39, 0xfa, 0x90, 0xdc, 0xf3, 0x11, 0x82, 0x00,
+	0xb9, 0xfa, 0xb4, 0x47, 0x63, 0x91, 0x4b, 0x2e, 0x8b, 0x44, 0x41, 0x90, 0x43, 0x80, 0x7c, 0x86,
+	0xe0, 0xd5, 0x9f, 0x9e, 0xaa, 0x21, 0x29, 0xef, 0xea, 0xc4, 0xa9, 0xdf, 0xfb, 0x55, 0x57, 0xd5,
+	0x7b, 0xf5, 0x5e, 0xbd, 0x7a, 0x45, 0xd8, 0x5a, 0x30, 0x41, 0x8b, 0xb3, 0x87, 0x05, 0xcf, 0x45,
+	0x4e, 0xd6, 0x55, 0xeb, 0xee, 0xee, 0x2c, 0x9f, 0xe5, 0x12, 0xfa, 0x08, 0x7f, 0x29, 0x69, 0x70,
+	0x0e, 0x9d, 0x11, 0xcf, 0x2f, 0x2e, 0x89, 0x0f, 0x6d, 0x1a, 0xc7, 0xdc, 0x6f, 0x3c, 0x68, 0xbc,
+	0xbf, 0x79, 0xd0, 0xfe, 0xea, 0x9b, 0xf7, 0xd6, 0x42, 0x89, 0x90, 0xfb, 0xb0, 0x81, 0x7f, 0xc3,
+	0xd1, 0xd0, 0x6f, 0x5a, 0x42, 0x03, 0x92, 0xf7, 0x61, 0x2b, 0xa2, 0xd1, 0x9c, 0x8d, 0x45, 0xce,
+	0xe9, 0x8c, 0xf9, 0x2d, 0x8b, 0xe4, 0x48, 0x82, 0xbf, 0x81, 0x8d, 0x61, 0x5a, 0x95, 0x82, 0x71,
+	0x72, 0x17, 0x9a, 0x49, 0x2c, 0x07, 0x6b, 0x1f, 0x00, 0x52, 0x5f, 0x7f, 0xf3, 0x5e, 0xf3, 0xf8,
+	0x30, 0x6c, 0x26, 0x31, 0x4e, 0x25, 0xa3, 0x0b, 0xe6, 0x8c, 0x26, 0x11, 0xf2, 0x0b, 0xe8, 0xa5,
+	0x39, 0x8d, 0x0f, 0x68, 0x4a, 0xb3, 0x48, 0x8d, 0xb4, 0xbd, 0x77, 0xfb, 0xa1, 0x5e, 0xef, 0xc9,
+	0x52, 0xa4, 0x7b, 0xd9, 0xec, 0xe0, 0xef, 0x1b, 0x00, 0x4f, 0x18, 0x15, 0xf3, 0xe1, 0x9c, 0x45,
+	0xe7, 0x38, 0x4a, 0x41, 0xc5, 0xdc, 0x5d, 0x30, 0x22, 0x28, 0x39, 0xcb, 0xe3, 0x4b, 0x77, 0x7c,
+	0x44, 0xc8, 0x00, 0xfa, 0x11, 0x76, 0x3e, 0xce, 0x04, 0xe3, 0x2f, 0x69, 0x2a, 0x67, 0xd0, 0xd2,
+	0x14, 0x57, 0x84, 0x6a, 0x13, 0xc9, 0x82, 0xe5, 0x95, 0xf0, 0xdb, 0x16, 0xcb, 0x80, 0xc1, 0xdf,
+	0x36, 0x61, 0x7b, 0x98, 0xf0, 0xa8, 0x4a, 0xc4, 0x01, 0x67, 0xf4, 0x9c, 0x71, 0xa9, 0xc9, 0x34,
+	0x2f, 0xd9, 0x44, 0xf7, 0x6b, 0x58, 0xfd, 0x1c, 0x09, 0x79, 0x08, 0x3b, 0x73, 0x9a, 0x4e, 0x27,
+	0x9c, 0x4e, 0xa7, 0x49, 0x14, 0x52, 0xa1, 0xb4, 0xd5, 0xd1, 0xe4, 0x55, 0x21, 0xf2, 0x39, 0x15,
+	0x4c, 0xae, 0x7c, 0xc4, 0x78, 0x92, 0xc7, 0xce, 0xd4, 0x57, 0x85, 0xe4, 0x13, 0x20, 0x53, 0x9a,
+	0xa4, 0x15, 0x67, 0xd8, 0x7d, 0x92, 0x0f, 0x71, 0x70, 0xbf, 0x6d, 0x0d, 0x71, 0x8d, 0x9c, 0xec,
+	0xc1, 0xad, 0xb2, 0x8a, 0x22, 0xc6, 0x62, 0x85, 0x3e, 0x2b, 0x58, 0xe6, 0x77, 0xac, 0x4e, 0x57,
+	0xc5, 0xc1, 0xff, 0x36, 0x61, 0x7d, 0xcc, 0xf8, 0xcb, 0x3f, 0xbc, 0x27, 0xe4, 0xf6, 0x6c, 0x5e,
+	0xd9, 0x9e, 0x7b, 0xd0, 0x95, 0x5b, 0x39, 0xca, 0x53, 0xbd, 0x21, 0x3c, 0xb3, 0x21, 0x46, 0x1a,
+	0xd7, 0xfc, 0x9a, 0x47, 0xee, 0xc1, 0xfa, 0x82, 0x5e, 0x3c, 0x1f, 0x8d, 0x1d, 0xd3, 0x68, 0x8c,
+	0xec, 0x01, 0xcc, 0xeb, 0x7d, 0x22, 0xe7, 0xdf, 0xdb, 0x23, 0xe6, 0x9b, 0xcb, 0x1d, 0x14, 0x5a,
+	0x2c, 0xf2, 0x67, 0xb0, 0x1d, 0x39, 0xc6, 0xf4, 0xd7, 0x65, 0xbf, 0x77, 0x4c, 0x3f, 0xd7, 0xd4,
+	0xe1, 0x0a, 0x1b, 0x67, 0xf4, 0x8a, 0x25, 0xb3, 0xb9, 0xf0, 0x37, 0xec, 0x19, 0x29, 0x8c, 0x3c,
+	0x56, 0xe6, 0x3b, 0x49, 0x16, 0x89, 0x78, 0x56, 0x88, 0x24, 0xcf, 0xfc, 0xae, 0x5c, 0xea, 0xbb,
+	0xe6, 0xf3, 0xa1, 0x2b, 0xb6, 0xed, 0x6a, 0xc1, 0xc1, 0x09, 0xb4, 0x0f, 0x92, 0x2c, 0x26, 0x01,
+	0x6c, 0x46, 0xca, 0x13, 0x8f, 0x0f, 0xb5, 0xc6, 0x55, 0x8f, 0x25, 0x4c, 0x1e, 0x40, 0xb7, 0x94,
+	0x86, 0x39, 0x3e, 0xf4, 0x9b, 0x16, 0xa5, 0x46, 0x83, 0x7d, 0xd8, 0x1c, 0xd1, 0x84, 0x7f, 0x41,
+	0xd3, 0x8a, 0xd5, 0x5e, 0xdb, 0xb8, 0xe2, 0xb5, 0x77, 0xa1, 0xf3, 0x12, 0x29, 0x8e, 0xf1, 0x14,
+	0x14, 0x9c, 0xc2, 0xce, 0xf1, 0x68, 0x3f, 0x8a, 0x58, 0x59, 0x0e, 0xf3, 0x4c, 0x70, 0x69, 0x9c,
+	0xcd, 0x57, 0xf3, 0x44, 0xb0, 0x34, 0x29, 0xd1, 0x05, 0x5a, 0xef, 0x6f, 0x86, 0x4b, 0x00, 0xa5,
+	0x67, 0x29, 0x8d, 0xce, 0xa5, 0xb4, 0xa9, 0xa4, 0x35, 0x10, 0xfc, 0x16, 0x7d, 0x7c, 0x32, 0x19,
+	0x85, 0xac, 0xac, 0x52, 0x41, 0x88, 0xf6, 0x64, 0x9c, 0xd3, 0x96, 0xf6, 0xe1, 0x9f, 0xc0, 0xc6,
+	0x9c, 0xd1, 0x98, 0xf1, 0x52, 0x76, 0xef, 0xed, 0xdd, 0xaa, 0xb7, 0x8b, 0x59, 0x4b, 0x68, 0x18,
+	0x48, 0x8e, 0xf2, 0xfc, 0x3c, 0x61, 0xa5, 0xdf, 0xba, 0x91, 0xac, 0x19, 0xa8, 0x81, 0x28, 0x8f,
+	0x5d, 0x37, 0x91, 0x48, 0x90, 0xa3, 0xa2, 0x38, 0x5d, 0x30, 0x0c, 0x7d, 0x37, 0x2b, 0xea, 0x43,
+	0x58, 0x2f, 0xf3, 0x8a, 0x47, 0x4a, 0x53, 0xdb, 0x7b, 0xdb, 0x66, 0xb0, 0xb1, 0x44, 0xcd, 0xa6,
+	0x50, 0x1c, 0x54, 0x6b, 0x92, 0xc5, 0xec, 0xc2, 0x6f, 0x59, 0xe3, 0x29, 0x28, 0xf8, 0x12, 0xb6,
+	0xbf, 0xa0, 0x69, 0x12, 0x53, 0xb4, 0x7a, 0x58, 0xa5, 0xe8, 0x9b, 0x5d, 0x5e, 0xa5, 0x6c, 0x72,
+	0x59, 0xa8, 0x91, 0x2d, 0x37, 0x09, 0x35, 0x6e, 0xec, 0x6b, 0x78, 0xe4, 0x87, 0x00, 0xec, 0xa2,
+	0xe0, 0xac, 0x2c, 0x71, 0xc7, 0xd9, 0xd6, 0xb3, 0xf0, 0xe0, 0xeb, 0x06, 0xc0, 0x72, 0x30, 0xf2,
+	0x29, 0x6c, 0x16, 0x66, 0xad, 0x72, 0x24, 0x47, 0x69, 0x5a, 0x60, 0x76, 0x5b, 0xcd, 0xc4, 0xdd,
+	0xc6, 0xd9, 0x6f, 0xaa, 0x84, 0xb3, 0x58, 0x8e, 0xd4, 0xad, 0x67, 0xa3, 0x51, 0xb2, 0x07, 0x1d,
+	0x9c, 0x99, 0xb1, 0x44, 0xed, 0x59, 0xee, 0x42, 0x8d, 0x1e, 0x24, 0x95, 0xfc, 0x0c, 0x20, 0xca,
+	0xb3, 0x38, 0x41, 0x69, 0xe9, 0xb7, 0x5d, 0x13, 0x0e, 0x8d, 0xc4, 0x2c, 0x6a, 0x49, 0x0d, 0x12,
+	0xe8, 0x87, 0x4c, 0xf0, 0xcb, 0xb1, 0x40, 0x17, 0x9a, 0x5d, 0xe2, 0xfc, 0x12, 0x13, 0xf5, 0x1b,
+	0x96, 0xc2, 0x6b, 0x14, 0x19, 0x0b, 0x7a, 0x81, 0x11, 0xba, 0x74, 0x82, 0x71, 0x8d, 0x92, 0x5d,
+	0xe8, 0xe0, 0x76, 0x50, 0x2b, 0xe8, 0x84, 0xaa, 0x11, 0xfc, 0xcf, 0x06, 0x6c, 0x1d, 0x26, 0x65,
+	0x41, 0x45, 0x34, 0x7f, 0x9a, 0xc7, 0xec, 0x5b, 0x39, 0xe7, 0x1e, 0x40, 0xc5, 0xd3, 0x90, 0xbd,
+	0xe2, 0x89, 0x30, 0x8e, 0x45, 0x74, 0xcc, 0x84, 0xcf, 0xc3, 0x13, 0x2d, 0x09, 0x2d, 0x16, 0x4e,
+	0x90, 0x0a, 0xc1, 0x9f, 0xe2, 0xe6, 0xb3, 0x0f, 0xe9, 0x1a, 0x25, 0x9f, 0x40, 0xef, 0x65, 0xad,
+	0x4d, 0xa3, 0x2f, 0x72, 0x8d, 0xa2, 0x6d, 0x1a, 0xf9, 0x01, 0x74, 0xe4, 0x31, 0xaf, 0x43, 0x65,
+	0xbf, 0xd6, 0x2f, 0x82, 0xa1, 0x92, 0x91, 0x5f, 0xc2, 0x56, 0xcc, 0xa6, 0xb4, 0x4a, 0x85, 0xf4,
+	0x1a, 0x1d, 0x1e, 0x97, 0x61, 0xb5, 0x76, 0x5a, 0x39, 0xa9, 0x46, 0xe8, 0xb0, 0x71, 0x27, 0x56,
+	0x25, 0x3b, 0x54, 0x90, 0xbf, 0x61, 0xed, 0x0f, 0x0b, 0x47, 0xd6, 0x19, 0x6a, 0xf1, 0x58, 0xba,
+	0x45, 0xd7, 0xb2, 0x81, 0x85, 0x93, 0x5f, 0x40, 0x9f, 0xdb, 0xa6, 0xf5, 0x37, 0xe5, 0x54, 0xee,
+	0xd4, 0xee, 0x60, 0x0b, 0x43, 0x97, 0x8b, 0x47, 0xb4, 0x54, 0xa6, 0x39, 0xa2, 0xc1, 0x3e, 0xa2,
+	0x6d, 0x09, 0xf9, 0x11, 0xf4, 0x38, 0xa3, 0xb1, 0x21, 0xf6, 0x2c, 0xa2, 0x2d, 0x40, 0xc7, 0x9c,
+	0xe7, 0xa5, 0x90, 0x8e, 0xb9, 0xe5, 0x3a, 0xe6, 0x13, 0x8d, 0x1b, 0x3b, 0x19, 0x1e, 0x2e, 0x34,
+	0xc2, 0x9d, 0xb0, 0x40, 0x86, 0xdf, 0xb7, 0x1d, 0x73, 0x89, 0x93, 0x5f, 0xc1, 0x8e, 0xe0, 0x34,
+	0x2b, 0xa7, 0x39, 0x5f, 0x68, 0x8b, 0x6e, 0xbb, 0xae, 0x33, 0x71, 0xc4, 0xe1, 0x2a, 0x1d, 0x57,
+	0x8b, 0x31, 0x73, 0xc2, 0x16, 0x45, 0x8a, 0x39, 0xc6, 0x8e, 0x9d, 0xda, 0xd9, 0x12, 0x79, 0xa2,
+	0x32, 0x31, 0xcf, 0x63, 0xdf, 0xb3, 0x38, 0x1a, 0x43, 0x5d, 0x44, 0x79, 0x26, 0x58, 0xa6, 0x96,
+	0x79, 0xcb, 0xa2, 0xd8, 0x02, 0xb2, 0x0f, 0x7d, 0x9d, 0x56, 0x8c, 0xf2, 0x34, 0x89, 0x2e, 0x7d,
+	0x22, 0x15, 0x52, 0x9b, 0xe6, 0x91, 0x2d, 0x34, 0x69, 0x97, 0xd3, 0x83, 0x7c, 0x00, 0x1b, 0xd3,
+	0x9c, 0x1f, 0xd1, 0x68, 0xee, 0xdf, 0x96, 0x76, 0xdd, 0xa9, 0x3b, 0x2b, 0x38, 0x34, 0x72, 0xf2,
+	0x33, 0xd8, 0x88, 0x19, 0xfa, 0x20, 0xf7, 0x77, 0x57, 0x4e, 0x53, 0x56, 0x16, 0x79, 0x86, 0x1b,
+	0x4b, 0x8a, 0x4d, 0xea, 0xa6, 0xd9, 0xe4, 0x4f, 0x74, 0xca, 0x71, 0x56, 0x4d, 0xfd, 0x3b, 0x72,
+	0x90, 0xbb, 0x4e, 0xca, 0x71, 0x56, 0x4d, 0x0f, 0x59, 0x19, 0xf1, 0xa4, 0x10, 0x39, 0x0f, 0x6b,
+	0x6e, 0x70, 0x06, 0xe4, 0xaa, 0x9c, 0xfc, 0x10, 0xfa, 0x71, 0xdd, 0x1a, 0x33, 0xa1, 0x4f, 0x2b,
+	0x17, 0x44, 0x15, 0x2e, 0x58, 0x59, 0xd2, 0x99, 0x0a, 0xe1, 0x76, 0x30, 0xb6, 0x05, 0x41, 0x09,
+	0x1b, 0x7a, 0xa1, 0x6f, 0xc8, 0x70, 0x95, 0x3d, 0xa2, 0x8a, 0x73, 0x96, 0x45, 0x97, 0x4e, 0xb4,
+	0xb2, 0x05, 0x72, 0x50, 0x7a, 0x71, 0x94, 0xb2, 0x05, 0xcb, 0x44, 0xe9, 0x1c, 0x34, 0xb6, 0x20,
+	0xf8, 0xe7, 0x06, 0x6c, 0xbb, 0x7b, 0x89, 0x7c, 0x0a, 0xeb, 0x82, 0xf2, 0x99, 0x5e, 0x8e, 0xa5,
+	0xdb, 0x9a, 0x37, 0x91, 0x62, 0xb3, 0x53, 0x14, 0x19, 0xbb, 0xd1, 0x48, 0x98, 0xe3, 0xe6, 0xba,
+	0x6e, 0xfb, 0x91, 0x15, 0xb2, 0x35, 0xb9, 0x3e, 0x53, 0x5b, 0x37, 0x27, 0x1f, 0xed, 0xab, 0xc9,
+	0xc7, 0xd7, 0x2d, 0xe8, 0xc8, 0x20, 0x45, 0x7e, 0x02, 0xed, 0x73, 0x76, 0x59, 0xfa, 0x0d, 0xf7,
+	0x84, 0x58, 0x3d, 0xaf, 0x24, 0x09, 0xe3, 0x68, 0xcc, 0x68, 0x9c, 0x26, 0x19, 0x73, 0x13, 0x23,
+	0x83, 0xae, 0x1c, 0x3b, 0xad, 0x6f, 0x7d, 0xec, 0x60, 0x6c, 0x9a, 0x0b, 0x51, 0x8c, 0xd9, 0x82,
+	0x66, 0x22, 0x89, 0x4a, 0x39, 0xeb, 0xee, 0xc1, 0x1d, 0x1d, 0xd9, 0xfb, 0x18, 0x26, 0x6b, 0x61,
+	0xe8, 0x72, 0xc9, 0x03, 0xe8, 0x95, 0x82, 0x8a, 0xaa, 0x1c, 0xca, 0x43, 0xa6, 0x23, 0x0f, 0x19,
+	0x1b, 0x22, 0x3f, 0x87, 0xdd, 0x52, 0xd0, 0x94, 0xbd, 0x98, 0x27, 0x29, 0x0b, 0x99, 0x0e, 0xe2,
+	0x2a, 0x18, 0x9b, 0x55, 0x5c, 0xcb, 0xc0, 0x48, 0x20, 0xf1, 0xe3, 0xe9, 0x11, 0xe7, 0x39, 0xf7,
+	0x37, 0xac, 0x1e, 0x8e, 0x04, 0xb5, 0x13, 0xe5, 0x34, 0x65, 0x65, 0xc4, 0xfc, 0xae, 0x15, 0xa8,
+	0x6b, 0x14, 0x2f, 0x23, 0xe6, 0xb7, 0x89, 0x8e, 0x9b, 0xd6, 0xe7, 0x56, 0x85, 0xe4, 0x43, 0xe8,
+	0x32, 0xfc, 0xf4, 0x64, 0x72, 0x22, 0xe3, 0x6d, 0xfb, 0xc0, 0xd3, 0xfa, 0xe8, 0x1e, 0x69, 0x3c,
+	0xac, 0x19, 0xc1, 0xaf, 0x60, 0x3b, 0x64, 0x59, 0xcc, 0x78, 0x1d, 0x9b, 0x1e, 0xc2, 0x46, 0x7e,
+	0xf6, 0x25, 0x8b, 0x84, 0xb1, 0xef, 0xee, 0xd2, 0xcf, 0x91, 0xf8, 0x4c, 0x0a, 0x43, 0x43, 0x0a,
+	0x5e, 0xc2, 0x96, 0x2d, 0x78, 0x43, 0xc2, 0xf6, 0x3e, 0x74, 0xf0, 0xec, 0x34, 0x99, 0x24, 0x71,
+	0xbf, 0xbb, 0x2f, 0x04, 0x0f, 0x15, 0x01, 0xcf, 0xf4, 0x69, 0x4a, 0xc5, 0xbe, 0x64, 0xb7, 0x2c,
+	0xb5, 0x2c, 0xe1, 0x80, 0x03, 0x2c, 0x3b, 0xbe, 0x61, 0x54, 0x99, 0x96, 0x09, 0x4e, 0x23, 0x71,
+	0x74, 0x51, 0xac, 0xa6, 0x65, 0x06, 0x5f, 0x49, 0xde, 0x5a, 0x37, 0x24, 0x6f, 0x5f, 0x01, 0xb4,
+	0xf6, 0x47, 0xc7, 0x6f, 0x79, 0x1f, 0x57, 0x59, 0xc8, 0x88, 0x0a, 0xc1, 0xb8, 0x19, 0xc3, 0xce,
+	0x42, 0xb4, 0x24, 0xb4, 0x58, 0xd6, 0x49, 0xd1, 0xbe, 0xe6, 0xa4, 0xb8, 0x07, 0xeb, 0x71, 0xbe,
+	0xa0, 0x89, 0xba, 0x37, 0xd6, 0x52, 0x85, 0xc9, 0x04, 0x59, 0x6e, 0x67, 0x7f, 0x7d, 0x25, 0x41,
+	0x96, 0xa8, 0x61, 0x2b, 0x0e, 0xf9, 0x4b, 0xd8, 0x49, 0x0a, 0xe7, 0x6e, 0x21, 0xb7, 0x6d, 0x6f,
+	0x19, 0x54, 0x56, 0xae, 0x1e, 0x07, 0xef, 0x62, 0xea, 0xf1, 0xfa, 0x9b, 0xf7, 0x56, 0xef, 0x24,
+	0xe1, 0xea, 0x87, 0xae, 0xa4, 0x33, 0xdd, 0xef, 0x94, 0xce, 0x0c, 0xa0, 0x93, 0x49, 0x1f, 0xdd,
+	0x74, 0xf7, 0xa3, 0x9d, 0x06, 0x86, 0x8a, 0x82, 0x49, 0x63, 0xc1, 0xf8, 0xa2, 0xf4, 0x41, 0x5e,
+	0x76, 0x54, 0x03, 0xad, 0x4b, 0x2b, 0x31, 0x7f, 0x94, 0xa4, 0x98, 0x66, 0xf7, 0x6c, 0xeb, 0x2e,
+	0x71, 0xbc, 0x95, 0x72, 0xc7, 0x17, 0x64, 0x86, 0x61, 0x25, 0x00, 0xae, 0xa7, 0x84, 0x2b, 0xec,
+	0x95, 0xb4, 0xab, 0x7f, 0x43, 0xda, 0xf5, 0x29, 0x6c, 0x2e, 0x70, 0xd6, 0x98, 0x7e, 0xfb, 0xdb,
+	0xd2, 0x30, 0x75, 0xb0, 0x3b, 0x35, 0x02, 0xb3, 0xdd, 0x6b, 0x26, 0x06, 0x8a, 0x22, 0x2f, 0x65,
+	0xe0, 0x93, 0x89, 0x45, 0xbf, 0xbe, 0xa6, 0x6b, 0x94, 0xfc, 0x31, 0xb4, 0x05, 0x9d, 0x95, 0xbe,
+	0x77, 0xd3, 0xd5, 0x4b, 0x8a, 0xc9, 0x21, 0x78, 0xaf, 0xd8, 0xd9, 0x38, 0x8f, 0xce, 0x99, 0xbe,
+	0xe7, 0x96, 0x32, 0xc5, 0xe8, 0xed, 0xf9, 0xa6, 0xcb, 0x8b, 0x15, 0x79, 0x78, 0xa5, 0x87, 0x55,
+	0x13, 0x20, 0xd7, 0xd4, 0x04, 0xae, 0xde, 0xef, 0x6f, 0x7f, 0xa7, 0xfb, 0xfd, 0x35, 0x37, 0xf8,
+	0xdd, 0xb7, 0xb9, 0xc1, 0xe3, 0x34, 0xab, 0x92, 0x4d, 0x4e, 0xc6, 0xfe, 0x1d, 0xcb, 0x1c, 0x1a,
+	0x23, 0x3f, 0x87, 0x2d, 0x91, 0x96, 0x47, 0x8b, 0x33, 0x16, 0x0f, 0x19, 0x17, 0xfe, 0x3b, 0x0f,
+	0x1a, 0xf6, 0xfe, 0x9a, 0x9c, 0x8c, 0x6b, 0x59, 0xe8, 0x30, 0x31, 0x40, 0x95, 0x82, 0x33, 0xba,
+	0x48, 0xb2, 0x99, 0xff, 0xae, 0x1d, 0xa0, 0x6a, 0x98, 0x1c, 0x00, 0x94, 0x25, 0x33, 0x2a, 0xf6,
+	0xdd, 0x2d, 0x3f, 0x1e, 0x1f, 0x69, 0xc9, 0xc1, 0x36, 0xba, 0xff, 0xb2, 0x1d, 0x5a, 0xbd, 0xae,
+	0x4b, 0x4a, 0xbf, 0xf7, 0xdd, 0x92, 0xd2, 0x01, 0xf4, 0x0b, 0xca, 0x45, 0x42, 0x53, 0x19, 0xfd,
+	0x4b, 0xff, 0xae, 0x35, 0x5b, 0x57, 0x84, 0xfa, 0x98, 0x71, 0x5a, 0xcc, 0x9f, 0x9f, 0x3c, 0x4a,
+	0x58, 0x1a, 0xfb, 0x7f, 0x24, 0x1d, 0x65, 0x57, 0x87, 0xa8, 0xad, 0xc7, 0x96, 0x2c, 0x74, 0x98,
+	0xe4, 0x63, 0xbc, 0xcc, 0xf3, 0xd2, 0xbf, 0xe7, 0xae, 0x72, 0xf8, 0x2c, 0x1c, 0xeb, 0xf4, 0xb3,
+	0xfb, 0xfa, 0x9b, 0xf7, 0xda, 0xd8, 0x0e, 0x25, 0x33, 0xf8, 0xbf, 0x06, 0xc0, 0x52, 0x4c, 0x02,
+	0xd8, 0xa2, 0x69, 0x9a, 0xbf, 0x7a, 0xc6, 0x93, 0x59, 0x92, 0x95, 0xba, 0x92, 0xe1, 0x60, 0x35,
+	0xe7, 0x54, 0x06, 0xbf, 0x52, 0xd7, 0x33, 0x1c, 0xac, 0xe6, 0x3c, 0xd1, 0x45, 0x8b, 0x96, 0xc5,
+	0xd1, 0x18, 0xa6, 0x90, 0xec, 0xa2, 0xc8, 0x4b, 0x66, 0x48, 0x6d, 0x49, 0x72, 0x41, 0xf2, 0x31,
+	0x78, 0xb2, 0xd7, 0x90, 0xb3, 0x98, 0x65, 0xa8, 0xa5, 0xd2, 0xef, 0x58, 0xba, 0xbb, 0x22, 0xd5,
+	0x3e, 0xb1, 0x3f, 0x53, 0x19, 0x82, 0xed, 0x13, 0xfb, 0x33, 0x16, 0x8c, 0x60, 0xcb, 0xde, 0x50,
+	0xf2, 0xe4, 0x67, 0x5c, 0x1c, 0x52, 0x41, 0x55, 0x0e, 0xab, 0x63, 0x5f, 0x8d, 0x62, 0x4d, 0xf4,
+	0x9c, 0x5d, 0x4a, 0x42, 0xd3, 0x22, 0x18, 0x30, 0xf8, 0xef, 0x06, 0x6c, 0xd6, 0xe9, 0xd1, 0xdb,
+	0x56, 0x12, 0x7e, 0x00, 0xad, 0x68, 0x51, 0xe8, 0xfc, 0xb1, 0x57, 0x1b, 0xee, 0x74, 0xa4, 0xa9,
+	0x28, 0xc5, 0x95, 0xb1, 0x8b, 0x82, 0x45, 0xc2, 0x39, 0x19, 0x35, 0x46, 0x3e, 0x80, 0x4e, 0x9a,
+	0xcf, 0x92, 0x48, 0x1e, 0x51, 0xdb, 0xcb, 0x1b, 0xed, 0x09, 0x82, 0x26, 0x87, 0x94, 0x0c, 0xf2,
+	0x53, 0xe8, 0x46, 0xf3, 0x24, 0x8d, 0xb9, 0x2c, 0x75, 0xbe, 0x31, 0xd1, 0xab, 0x89, 0xc1, 0xbf,
+	0xb7, 0x60, 0x23, 0xcc, 0x2b, 0x81, 0x4e, 0xf5, 0xa6, 0x93, 0xd7, 0xa9, 0x04, 0x34, 0xaf, 0xaf,
+	0x04, 0xbc, 0x75, 0xae, 0xf9, 0x19, 0x74, 0x4b, 0x73, 0x05, 0x6e, 0xaf, 0xc4, 0x22, 0x35, 0x37,
+	0x73, 0xeb, 0x35, 0xf3, 0x37, 0x74, 0xbc, 0x17, 0x08, 0xab, 0xf4, 0x6c, 0x97, 0x78, 0x6d, 0xc1,
+	0x77, 0x3c, 0xaf, 0xbf, 0x0f, 0x2d, 0x5a, 0x24, 0x3a, 0xb5, 0xec, 0x69, 0x55, 0x60, 0x76, 0x12,
+	0x22, 0x5e, 0xa7, 0x21, 0xdd, 0x2b, 0x69, 0xc8, 0x07, 0xb0, 0xc1, 0xf3, 0x34, 0x35, 0x89, 0xa4,
+	0x75, 0xe7, 0x0b, 0x15, 0x1c, 0x1a, 0x39, 0xf9, 0x08, 0xc3, 0x5c, 0x12, 0x9d, 0x5f, 0xfe, 0x39,
+	0xbb, 0x94, 0xc9, 0xe4, 0x75, 0x7b, 0x2a, 0x5c, 0x72, 0xc8, 0x47, 0x58, 0x01, 0x5c, 0x14, 0x94,
+	0x33, 0xbf, 0xe7, 0xd6, 0x09, 0xc6, 0x73, 0x1a, 0xe7, 0xaf, 0x86, 0x4a, 0x18, 0x1a, 0x56, 0xf0,
+	0x8f, 0x0d, 0xe8, 0x3b, 0x22, 0xe2, 0x2f, 0x2b, 0x8e, 0x2a, 0x08, 0x98, 0x26, 0xfa, 0x76, 0x32,
+	0xcb, 0x72, 0xce, 0xe2, 0x11, 0x15, 0xf3, 0xda, 0xff, 0x6d, 0x0c, 0xcf, 0xe0, 0x92, 0x2e, 0x8a,
+	0x54, 0x56, 0xda, 0x9d, 0x2b, 0x98, 0x85, 0x23, 0x6b, 0x41, 0x2f, 0xc6, 0x12, 0x28, 0x9d, 0x0a,
+	0xa4, 0x85, 0x07, 0xbf, 0x6b, 0x00, 0xa8, 0xb9, 0x1d, 0x26, 0xd3, 0x29, 0x2a, 0x83, 0x2b, 0x4b,
+	0xd7, 0x85, 0xa6, 0x5b, 0x5a, 0xed, 0x9b, 0xa1, 0x11, 0x84, 0x4b, 0x0e, 0xd9, 0x85, 0x26, 0x15,
+	0x7e, 0xd3, 0x8a, 0x05, 0x4d, 0x2a, 0xac, 0x8c, 0xae, 0x75, 0x4d, 0x46, 0xf7, 0x7d, 0x68, 0x55,
+	0x3c, 0xd1, 0xc9, 0x5e, 0x6d, 0xd5, 0xcf, 0xc3, 0xe3, 0x10, 0x71, 0xbc, 0x9f, 0x15, 0xf8, 0x00,
+	0xe5, 0xe4, 0x7b, 0x0a, 0xc2, 0xd4, 0x27, 0x4e, 0xa6, 0x53, 0xdc, 0x3d, 0x32, 0xf5, 0x91, 0x8d,
+	0xe0, 0xef, 0xa4, 0xf3, 0x28, 0x73, 0xee, 0x42, 0xa7, 0x14, 0xac, 0x50, 0x8a, 0xed, 0x84, 0xaa,
+	0xa1, 0x2e, 0x2b, 0xac, 0xa8, 0x5f, 0x69, 0xec, 0x09, 0x3b, 0x12, 0xf2, 0x18, 0x6e, 0x9d, 0xd1,
+	0x92, 0xe1, 0xa5, 0x6d, 0x58, 0x3b, 0x5a, 0x4b, 0x6a, 0xe2, 0x7b, 0x7a, 0xaa, 0xb7, 0x0e, 0x56,
+	0x09, 0xe1, 0xd5, 0x3e, 0xf2, 0x46, 0x6d, 0x3d, 0xae, 0xd8, 0xcf, 0x0a, 0xb6, 0x00, 0x79, 0x8b,
+	0x24, 0x0b, 0xd9, 0x6f, 0x2a, 0x56, 0x0a, 0x15, 0x7e, 0x6b, 0x9e, 0x25, 0x20, 0xbf, 0x84, 0x77,
+	0x16, 0xf4, 0xe2, 0xd1, 0xf2, 0x8d, 0xe5, 0x38, 0x8b, 0x38, 0xa3, 0xa5, 0x8a, 0xc4, 0xc6, 0xb6,
+	0x37, 0x70, 0xf0, 0xf9, 0x66, 0x41, 0x2f, 0x4e, 0xa8, 0xc0, 0x5b, 0x7c, 0xdd, 0x73, 0xc3, 0xea,
+	0x79, 0x8d, 0x9c, 0xfc, 0xb4, 0xf6, 0xd6, 0xee, 0x4a, 0x45, 0x4c, 0x69, 0xfb, 0x3a, 0xa7, 0x0d,
+	0xfe, 0xb5, 0x01, 0x7d, 0x47, 0x4e, 0x3e, 0x46, 0x9b, 0xe0, 0x5e, 0x55, 0x17, 0xff, 0xdd, 0x6b,
+	0xbe, 0x52, 0x57, 0x69, 0x25, 0x11, 0x3d, 0x1b, 0xad, 0xe2, 0xd4, 0x21, 0x24, 0x82, 0xea, 0xc2,
+	0xbf, 0x63, 0x41, 0xb9, 0xd8, 0x17, 0xce, 0x9b, 0x95, 0x2d, 0x20, 0x9f, 0xc0, 0xc6, 0x3c, 0x29,
+	0x45, 0xce, 0x2f, 0x75, 0xd1, 0x72, 0x75, 0xd4, 0xa3, 0x97, 0x2c, 0x33, 0xb5, 0x06, 0x43, 0x0d,
+	0xfe, 0xa5, 0x01, 0x5b, 0xb6, 0x5c, 0xef, 0xef, 0xc6, 0xca, 0xfe, 0x7e, 0xe3, 0xf4, 0xec, 0x38,
+	0xd8, 0xba, 0x29, 0x0e, 0xd6, 0x2a, 0x69, 0x7f, 0x5b, 0x95, 0xdc, 0x83, 0x75, 0x34, 0x4a, 0xbe,
+	0x72, 0x0f, 0x52, 0x58, 0xf0, 0x4f, 0x4d, 0xf0, 0x5e, 0x5c, 0x93, 0xc0, 0xe6, 0x32, 0xaf, 0x70,
+	0xae, 0x8c, 0x1a, 0x43, 0x69, 0xc9, 0xa2, 0x8a, 0x33, 0xa7, 0xba, 0xae, 0x31, 0x5c, 0x48, 0x12,
+	0xa7, 0xf5, 0x75, 0xdc, 0xd1, 0xb3, 0x25, 0x40, 0xcf, 0x2a, 0x30, 0x14, 0x18, 0xcf, 0xb2, 0xf7,
+	0xb9, 0x23, 0xc1, 0x2f, 0x16, 0x79, 0x36, 0x33, 0x5f, 0x74, 0x36, 0xba, 0x25, 0x20, 0x1f, 0xc2,
+	0xf6, 0x82, 0x5e, 0x0c, 0xf3, 0x2c, 0x63, 0x91, 0x3a, 0xc2, 0xec, 0x54, 0x63, 0x45, 0xa6, 0xd9,
+	0xa7, 0xaa, 0xde, 0x35, 0x4e, 0xfe, 0x9a, 0x39, 0xcf, 0x65, 0x2b, 0xb2, 0xe0, 0x13, 0xb0, 0xb2,
+	0xd0, 0xd5, 0x35, 0x36, 0x6e, 0x58, 0x63, 0xf0, 0x19, 0xac, 0x8f, 0x2f, 0xb1, 0x88, 0x4a, 0x3e,
+	0xc2, 0x7a, 0x7d, 0x95, 0x09, 0x9d, 0x7c, 0xdc, 0x5e, 0x9e, 0xaa, 0x55, 0x26, 0x4e, 0x99, 0xe0,
+	0xcb, 0x64, 0x40, 0xf2, 0x82, 0x7f, 0x6b, 0x40, 0xcf, 0x12, 0x62, 0xbe, 0xa3, 0x0f, 0x6a, 0x67,
+	0x38, 0x03, 0x2a, 0xa3, 0xe0, 0x63, 0x9a, 0x13, 0xa2, 0x34, 0x66, 0xce, 0x43, 0x65, 0x8c, 0xab,
+	0xe7, 0xe1, 0x7d, 0xd8, 0xd0, 0x91, 0xd9, 0x7d, 0x60, 0xd6, 0x20, 0x7e, 0xbc, 0x48, 0xab, 0x99,
+	0xbe, 0x4a, 0xd7, 0x1f, 0x57, 0x18, 0x66, 0xd1, 0xb4, 0x28, 0xd2, 0x84, 0xc5, 0x23, 0x45, 0xb2,
+	0xd5, 0xee, 0x8a, 0x82, 0xdf, 0x35, 0x61, 0x5d, 0xfd, 0x7c, 0xcb, 0x3a, 0xc1, 0x3d, 0x58, 0xc7,
+	0x5b, 0x69, 0xce, 0xdd, 0x13, 0x42, 0x61, 0x78, 0x04, 0xb0, 0x05, 0x4d, 0x52, 0xb7, 0x44, 0x27,
+	0x21, 0x2b, 0x83, 0xe8, 0x7c, 0x8b, 0x0c, 0xe2, 0x01, 0x74, 0xab, 0x22, 0xa6, 0x82, 0xed, 0x0b,
+	0x67, 0x3d, 0x35, 0x8a, 0x4a, 0x7b, 0xc9, 0xb8, 0x2c, 0x89, 0xd8, 0x3b, 0xc7, 0x80, 0xe4, 0x43,
+	0x68, 0x0b, 0xac, 0xaf, 0xaa, 0xe7, 0xd5, 0x3a, 0xed, 0x57, 0xab, 0xb7, 0x6a, 0xf1, 0x92, 0x85,
+	0x27, 0xbb, 0x2e, 0x5f, 0xcb, 0xc4, 0x63, 0x2b, 0x34, 0x4d, 0xe2, 0x41, 0x2b, 0x9a, 0xce, 0x64,
+	0x86, 0xb1, 0x15, 0xe2, 0xcf, 0xe0, 0x04, 0xb6, 0xf7, 0x6d, 0xad, 0x96, 0x6f, 0xd4, 0xe5, 0x7d,
+	0x00, 0x6d, 0x83, 0xe3, 0x43, 0x95, 0x17, 0xb4, 0x43, 0x0b, 0x19, 0xfc, 0x18, 0xd6, 0x75, 0xb8,
+	0xed, 0x42, 0xfb, 0x30, 0x7f, 0x95, 0x79, 0x6b, 0x64, 0x1d, 0x9a, 0x9f, 0x17, 0x5e, 0x83, 0xf4,
+	0x60, 0xe3, 0xf3, 0xec, 0x3c, 0x43, 0xb0, 0x39, 0x78, 0x08, 0x7d, 0x7d, 0x35, 0x5d, 0xf2, 0xf1,
+	0xe1, 0xdd, 0x5b, 0xc3, 0x5f, 0x4f, 0x68, 0x3a, 0xf5, 0x1a, 0x64, 0x13, 0x3a, 0xf2, 0x05, 0xdf,
+	0x6b, 0x0e, 0x86, 0xd0, 0xb3, 0xfe, 0x8f, 0x82, 0x6c, 0x03, 0x84, 0x79, 0x95, 0xc5, 0x61, 0x7e,
+	0x96, 0x60, 0x1f, 0x80, 0xf5, 0xe3, 0xd1, 0x13, 0x5a, 0xce, 0xbd, 0x06, 0xca, 0x5e, 0xe0, 0xf3,
+	0xb4, 0x92, 0x35, 0xf1, 0x7b, 0x21, 0xcd, 0x62, 0xaf, 0x35, 0xf8, 0x53, 0xe8, 0x9a, 0xb7, 0x77,
+	0x39, 0xca, 0x64, 0x32, 0x52, 0xe3, 0x3d, 0xe6, 0x45, 0xa4, 0xc6, 0x3b, 0xac, 0xce, 0xce, 0x72,
+	0xaf, 0x49, 0x76, 0xa0, 0x37, 0x2e, 0x78, 0x92, 0xcd, 0x86, 0x69, 0x5e, 0x61, 0xdf, 0x2f, 0x61,
+	0x5d, 0x3d, 0x77, 0xa2, 0xe8, 0x79, 0xc5, 0xe4, 0xe3, 0x4b, 0x92, 0xcd, 0xbc, 0x35, 0xb2, 0x05,
+	0xdd, 0x47, 0x39, 0x5f, 0xe0, 0x55, 0xc1, 0x6b, 0x60, 0xeb, 0xd7, 0xe3, 0x67, 0x4f, 0x0f, 0xf2,
+	0xf8, 0xd2, 0x6b, 0xe2, 0xc4, 0xd4, 0x3d, 0xc7, 0x6b, 0xe1, 0xef, 0xa1, 0x7c, 0x93, 0xf5, 0xda,
+	0xa4, 0x8f, 0x4f, 0xaf, 0x62, 0x2e, 0xeb, 0x05, 0x5e, 0x47, 0x76, 0x7a, 0x31, 0x19, 0xa6, 0x34,
+	0x59, 0x78, 0xeb, 0x83, 0xbb, 0xd0, 0x35, 0x8f, 0x9f, 0x72, 0xa5, 0x55, 0xca, 0x42, 0x36, 0x63,
+	0x17, 0x85, 0xb7, 0x36, 0xf8, 0x6d, 0x13, 0x5a, 0xc3, 0xd3, 0x91, 0xd4, 0xcd, 0xe9, 0xe8, 0xe8,
+	0xb9, 0xb7, 0xa6, 0x7f, 0x9e, 0x4c, 0xb4, 0xc6, 0x4e, 0x47, 0x27, 0x47, 0x5e, 0x53, 0xff, 0x7c,
+	0x3c, 0xf1, 0x5a, 0xe6, 0xe7, 0x91, 0xd7, 0xd6, 0x3f, 0x8f, 0x33, 0x35, 0xe6, 0xf0, 0x74, 0x24,
+	0x2b, 0x21, 0xde, 0xba, 0x16, 0x3c, 0x3d, 0xf2, 0x36, 0x70, 0x6e, 0xc3, 0xd3, 0xd1, 0x88, 0xb3,
+	0x69, 0x72, 0xe1, 0x75, 0x75, 0x73, 0x5c, 0x4d, 0xb1, 0xb9, 0xa9, 0x9b, 0x47, 0x17, 0x49, 0x29,
+	0x4a, 0x0f, 0x88, 0x07, 0x5b, 0xd8, 0x2f, 0x17, 0x1a, 0xe9, 0xe9, 0xef, 0x1e, 0x67, 0x63, 0x26,
+	0xbc, 0x2d, 0xb4, 0xfa, 0xf0, 0x74, 0x34, 0x3c, 0x3e, 0x0c, 0xbd, 0xbe, 0x26, 0x7f, 0xa1, 0x36,
+	0xf5, 0xd1, 0x73, 0x6f, 0xdb, 0x45, 0x4e, 0x26, 0xde, 0xce, 0x0a, 0x72, 0xe4, 0x79, 0x2e, 0xf2,
+	0x78, 0xe2, 0xdd, 0x5a, 0x41, 0x8e, 0x3c, 0x32, 0xf8, 0x18, 0x3a, 0xf2, 0x16, 0x84, 0xa3, 0xcb,
+	0x1f, 0xfb, 0x59, 0xec, 0xad, 0xe1, 0xe8, 0xb2, 0xf5, 0x8c, 0x2b, 0xcb, 0xc8, 0xc6, 0xd3, 0x5c,
+	0x78, 0xcd, 0xc1, 0x5f, 0xc1, 0xce, 0x4a, 0xcd, 0x9f, 0xdc, 0x82, 0xbe, 0xce, 0x74, 0xb4, 0xcd,
+	0xd6, 0x70, 0x24, 0x0d, 0x49, 0x9b, 0x7b, 0x0d, 0x8b, 0xa4, 0x8d, 0xd9, 0x24, 0x04, 0xb6, 0xcd,
+	0xd3, 0x8c, 0x31, 0xf6, 0xe0, 0x0c, 0x76, 0x56, 0xde, 0x06, 0xf0, 0x5b, 0x35, 0x84, 0xca, 0x59,
+	0x23, 0xb7, 0x6d, 0x52, 0x51, 0xb0, 0x2c, 0xf6, 0x1a, 0x0e, 0x18, 0xb2, 0x45, 0xfe, 0x12, 0x87,
+	0x70, 0x41, 0x8c, 0x5e, 0x5e, 0x6b, 0x20, 0x60, 0x67, 0xe5, 0x49, 0x08, 0xb7, 0x8b, 0xfa, 0xb9,
+	0x5f, 0x89, 0xdc, 0x5b, 0x5b, 0xb6, 0x9f, 0xe6, 0x19, 0xf3, 0x1a, 0x68, 0x3d, 0xd5, 0xfe, 0x8b,
+	0xd3, 0x13, 0xaf, 0xb9, 0x14, 0xe3, 0x06, 0xf6, 0x5a, 0xcb, 0xf6, 0x84, 0x5d, 0x08, 0xaf, 0x8d,
+	0x2b, 0x53, 0x6d, 0xf3, 0x40, 0xe4, 0x75, 0x06, 0x2f, 0xa0, 0xef, 0x3c, 0x78, 0xe1, 0xdc, 0x34,
+	0x10, 0xea, 0x47, 0x71, 0x6f, 0xcd, 0x02, 0xd5, 0x41, 0x47, 0x53, 0xaf, 0x41, 0xee, 0x81, 0xbf,
+	0x02, 0xbe, 0x48, 0xc4, 0x5c, 0x16, 0x45, 0xbc, 0xe6, 0xe0, 0x47, 0xb0, 0xb3, 0x72, 0xc3, 0x43,
+	0xdf, 0x1c, 0xe6, 0xc5, 0xa5, 0xda, 0xe4, 0xe3, 0x22, 0x4d, 0x84, 0xd7, 0x18, 0x9c, 0xd5, 0xe9,
+	0x91, 0xcc, 0x50, 0xa4, 0xfa, 0x55, 0x3b, 0xac, 0xb2, 0x4c, 0xf9, 0xe4, 0x2e, 0x78, 0x86, 0xa3,
+	0xfe, 0xb7, 0x87, 0xa1, 0x6a, 0xef, 0xc0, 0x2d, 0xc3, 0xcc, 0xd3, 0x94, 0xc5, 0x07, 0x34, 0x3a,
+	0xf7, 0x9a, 0xd2, 0xa4, 0x0a, 0x1e, 0xd1, 0xaa, 0x64, 0xe8, 0xee, 0x9f, 0xc1, 0x66, 0x5d, 0x23,
+	0x44, 0xc3, 0xc9, 0x86, 0xae, 0x2c, 0x2a, 0x97, 0x97, 0xc8, 0x7e, 0x9a, 0x7a, 0x8d, 0x65, 0x2b,
+	0xbb, 0xf4, 0x9a, 0x83, 0x7d, 0xe8, 0x9a, 0x17, 0x52, 0xd4, 0x27, 0xfe, 0x56, 0x85, 0x15, 0x6f,
+	0x0d, 0x27, 0x80, 0x6d, 0xf5, 0x9f, 0x45, 0xfb, 0x71, 0x8c, 0xf5, 0x6e, 0x15, 0xb2, 0x10, 0x1e,
+	0x56, 0xa5, 0xc8, 0x17, 0x5e, 0x73, 0xf0, 0x63, 0xd8, 0x59, 0xa9, 0xbb, 0xa1, 0x26, 0x5e, 0xd0,
+	0x44, 0xa8, 0x58, 0x17, 0x32, 0x7c, 0x01, 0xf0, 0x1a, 0x83, 0x7b, 0x00, 0xcb, 0x33, 0x00, 0x3f,
+	0xf3, 0x6b, 0xfa, 0x92, 0x8e, 0xe5, 0xfb, 0x9c, 0xb7, 0x76, 0xb0, 0xfb, 0xfb, 0xff, 0xbc, 0xbf,
+	0xf6, 0xd5, 0xeb, 0xfb, 0x8d, 0xdf, 0xbf, 0xbe, 0xdf, 0xf8, 0x8f, 0xd7, 0xf7, 0x1b, 0xff, 0xf0,
+	0x5f, 0xf7, 0xd7, 0xfe, 0x7f, 0x00, 0xe0, 0x2b, 0xe8, 0x87, 0x88, 0x27, 0x00, 0x00,
+}
+
+func (m *Proxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Proxy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Source))
-	n += 1 + sovMetapb(uint64(m.Index))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
+	i += copy(dAtA[i:], m.Addr)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AddrRPC)))
+	i += copy(dAtA[i:], m.AddrRPC)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.CacheStorage)))
+	i += copy(dAtA[i:], m.CacheStorage)
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidationRule) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Cluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.RuleType))
-	l = len(m.Expression)
-	n += 1 + l + sovMetapb(uint64(l))
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.LoadBalance))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Validation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HeathCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HeathCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Parameter.Size()
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 2
-	if len(m.Rules) > 0 {
-		for _, e := range m.Rules {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+	i += copy(dAtA[i:], m.Body)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CheckInterval))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Timeout))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RetryStrategy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.Interval))
-	n += 1 + sovMetapb(uint64(m.MaxTimes))
-	if len(m.Codes) > 0 {
-		for _, e := range m.Codes {
-			n += 1 + sovMetapb(uint64(e))
-		}
-	}
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CloseTimeout))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.HalfTrafficRate))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RateCheckPeriod))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.FailureRateToClose))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.SucceedRateToOpen))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DispatchNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Server) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Server) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.ClusterID))
-	l = len(m.URLRewrite)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.AttrName)
-	n += 1 + l + sovMetapb(uint64(l))
-	if len(m.Validations) > 0 {
-		for _, e := range m.Validations {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Addr)))
+	i += copy(dAtA[i:], m.Addr)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Protocol))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.HeathCheck != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.HeathCheck.Size()))
+		n1, err1 := m.HeathCheck.MarshalTo(dAtA[i:])
+		if err1 != nil {
+			return 0, err1
 		}
+		i += n1
 	}
-	if m.Cache != nil {
-		l = m.Cache.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.DefaultValue != nil {
-		l = m.DefaultValue.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	n += 2
-	n += 1 + sovMetapb(uint64(m.BatchIndex))
-	if m.RetryStrategy != nil {
-		l = m.RetryStrategy.Size()
-		n += 1 + l + sovMetapb(uint64(l))
+	if m.CircuitBreaker != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
+		n2, err2 := m.CircuitBreaker.MarshalTo(dAtA[i:])
+		if err2 != nil {
+			return 0, err2
+		}
+		i += n2
 	}
-	n += 1 + sovMetapb(uint64(m.WriteTimeout))
-	n += 1 + sovMetapb(uint64(m.ReadTimeout))
-	n += 1 + sovMetapb(uint64(m.HostType))
-	l = len(m.CustemHost)
-	n += 1 + l + sovMetapb(uint64(l))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Weight))
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RateLimitOption))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Cache) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Bind) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Bind) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
-	n += 1 + sovMetapb(uint64(m.Deadline))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ServerID))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RenderTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PairValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PairValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
-	}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RenderObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IPAccessControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IPAccessControl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	if len(m.Attrs) > 0 {
-		for _, e := range m.Attrs {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for _, s := range m.Blacklist {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	n += 2
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RenderAttr) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HTTPResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.ExtractExp)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *API) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HTTPResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.URLPattern)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Method)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Domain)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Status))
-	if m.IPAccessControl != nil {
-		l = m.IPAccessControl.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.DefaultValue != nil {
-		l = m.DefaultValue.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
-		}
+	if m.Body != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Body)))
+		i += copy(dAtA[i:], m.Body)
 	}
-	if len(m.Perms) > 0 {
-		for _, s := range m.Perms {
-			l = len(s)
-			n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.AuthFilter)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.RenderTemplate != nil {
-		l = m.RenderTemplate.Size()
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	n += 2
-	n += 1 + sovMetapb(uint64(m.MatchRule))
-	n += 1 + sovMetapb(uint64(m.Position))
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 2 + l + sovMetapb(uint64(l))
+	if len(m.Cookies) > 0 {
+		for _, msg := range m.Cookies {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.WebSocketOptions != nil {
-		l = m.WebSocketOptions.Size()
-		n += 2 + l + sovMetapb(uint64(l))
-	}
-	n += 2 + sovMetapb(uint64(m.MaxQPS))
-	if m.CircuitBreaker != nil {
-		l = m.CircuitBreaker.Size()
-		n += 2 + l + sovMetapb(uint64(l))
-	}
-	n += 2 + sovMetapb(uint64(m.RateLimitOption))
-	n += 3
-	if m.TlsEmbedCert != nil {
-		l = m.TlsEmbedCert.Size()
-		n += 2 + l + sovMetapb(uint64(l))
-	}
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Code))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TLSEmbedCert) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Parameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CertData != nil {
-		l = len(m.CertData)
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.KeyData != nil {
-		l = len(m.KeyData)
-		n += 1 + l + sovMetapb(uint64(l))
-	}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Source))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Index))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Condition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Parameter.Size()
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Cmp))
-	l = len(m.Expect)
-	n += 1 + l + sovMetapb(uint64(l))
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RuleType))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expression)))
+	i += copy(dAtA[i:], m.Expression)
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Routing) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Validation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Validation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	n += 1 + sovMetapb(uint64(m.ClusterID))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
+	n3, err3 := m.Parameter.MarshalTo(dAtA[i:])
+	if err3 != nil {
+		return 0, err3
+	}
+	i += n3
+	dAtA[i] = 0x10
+	i++
+	if m.Required {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovMetapb(uint64(l))
+		for _, msg := range m.Conditions {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	n += 1 + sovMetapb(uint64(m.Strategy))
-	n += 1 + sovMetapb(uint64(m.TrafficRate))
-	n += 1 + sovMetapb(uint64(m.Status))
-	n += 1 + sovMetapb(uint64(m.API))
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *WebSocketOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Origin)
-	n += 1 + l + sovMetapb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *System) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Count.Size()
-	n += 1 + l + sovMetapb(uint64(l))
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Interval))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxTimes))
+	if len(m.Codes) > 0 {
+		for _, num := range m.Codes {
+			dAtA[i] = 0x18
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CountMetric) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DispatchNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DispatchNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.Cluster))
-	n += 1 + sovMetapb(uint64(m.Server))
-	n += 1 + sovMetapb(uint64(m.API))
-	n += 1 + sovMetapb(uint64(m.Routing))
-	n += 1 + sovMetapb(uint64(m.Plugin))
-	n += 1 + sovMetapb(uint64(m.AppliedPlugin))
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLRewrite)))
+	i += copy(dAtA[i:], m.URLRewrite)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AttrName)))
+	i += copy(dAtA[i:], m.AttrName)
+	if len(m.Validations) > 0 {
+		for _, msg := range m.Validations {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Cache != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Cache.Size()))
+		n4, err4 := m.Cache.MarshalTo(dAtA[i:])
+		if err4 != nil {
+			return 0, err4
+		}
+		i += n4
+	}
+	if m.DefaultValue != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
+		n5, err5 := m.DefaultValue.MarshalTo(dAtA[i:])
+		if err5 != nil {
+			return 0, err5
+		}
+		i += n5
+	}
+	dAtA[i] = 0x38
+	i++
+	if m.UseDefault {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.BatchIndex))
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n6, err6 := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err6 != nil {
+			return 0, err6
+		}
+		i += n6
+	}
+	dAtA[i] = 0x50
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.WriteTimeout))
+	dAtA[i] = 0x58
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ReadTimeout))
+	dAtA[i] = 0x60
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.HostType))
+	dAtA[i] = 0x6a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.CustemHost)))
+	i += copy(dAtA[i:], m.CustemHost)
+	if len(m.Transformations) > 0 {
+		for _, msg := range m.Transformations {
+			dAtA[i] = 0x72
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x7a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.BodyTemplate)))
+	i += copy(dAtA[i:], m.BodyTemplate)
+	dAtA[i] = 0x82
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x8a
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ContentType)))
+	i += copy(dAtA[i:], m.ContentType)
+	dAtA[i] = 0x90
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.FailurePolicy))
+	if m.ForEach != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ForEach.Size()))
+		n7, err7 := m.ForEach.MarshalTo(dAtA[i:])
+		if err7 != nil {
+			return 0, err7
+		}
+		i += n7
+	}
+	dAtA[i] = 0xa0
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Decoder))
+	if m.Protobuf != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Protobuf.Size()))
+		n8, err8 := m.Protobuf.MarshalTo(dAtA[i:])
+		if err8 != nil {
+			return 0, err8
+		}
+		i += n8
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Plugin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProtobufDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtobufDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	l = len(m.Name)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Author)
-	n += 1 + l + sovMetapb(uint64(l))
-	l = len(m.Email)
-	n += 1 + l + sovMetapb(uint64(l))
-	n += 1 + sovMetapb(uint64(m.Status))
-	n += 1 + sovMetapb(uint64(m.UpdateAt))
-	n += 1 + sovMetapb(uint64(m.Version))
-	n += 1 + sovMetapb(uint64(m.Type))
-	if m.Content != nil {
-		l = len(m.Content)
-		n += 1 + l + sovMetapb(uint64(l))
-	}
-	if m.Cfg != nil {
-		l = len(m.Cfg)
-		n += 1 + l + sovMetapb(uint64(l))
+	if m.DescriptorSet != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.DescriptorSet)))
+		i += copy(dAtA[i:], m.DescriptorSet)
 	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.MessageType)))
+	i += copy(dAtA[i:], m.MessageType)
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AppliedPlugins) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ForEach) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ForEach) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovMetapb(uint64(m.ID))
-	if len(m.AppliedIDs) > 0 {
-		for _, e := range m.AppliedIDs {
-			n += 1 + sovMetapb(uint64(e))
-		}
-	}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Concurrency))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxElements))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func sovMetapb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMetapb(x uint64) (n int) {
-	return sovMetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *Transformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *Proxy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *Transformation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Target))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Action))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
+	i += copy(dAtA[i:], m.Value)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Cache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cache) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, msg := range m.Keys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			i += n
+		}
+	}
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Deadline))
+	if len(m.Conditions) > 0 {
+		for _, msg := range m.Conditions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
+			i += n
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proxy: wiretype end group for non-group")
+	}
+	dAtA[i] = 0x20
+	i++
+	if m.HTTPSemantics {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if len(m.StatusCodes) > 0 {
+		for _, num := range m.StatusCodes {
+			dAtA[i] = 0x28
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proxy: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.StaleWhileRevalidate))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.StaleIfError))
+	dAtA[i] = 0x40
+	i++
+	if m.Coalesce {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x48
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CoalesceTimeout))
+	dAtA[i] = 0x50
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ErrorTTL))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RenderTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderTemplate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		switch fieldNum {
-		case 1:
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RenderObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderObject) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if len(m.Attrs) > 0 {
+		for _, msg := range m.Attrs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	if m.FlatAttrs {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RenderAttr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderAttr) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.ExtractExp)))
+	i += copy(dAtA[i:], m.ExtractExp)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expression)))
+	i += copy(dAtA[i:], m.Expression)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *API) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *API) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URLPattern)))
+	i += copy(dAtA[i:], m.URLPattern)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Domain)))
+	i += copy(dAtA[i:], m.Domain)
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	if m.IPAccessControl != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.IPAccessControl.Size()))
+		n9, err9 := m.IPAccessControl.MarshalTo(dAtA[i:])
+		if err9 != nil {
+			return 0, err9
+		}
+		i += n9
+	}
+	if m.DefaultValue != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.DefaultValue.Size()))
+		n10, err10 := m.DefaultValue.MarshalTo(dAtA[i:])
+		if err10 != nil {
+			return 0, err10
+		}
+		i += n10
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Perms) > 0 {
+		for _, s := range m.Perms {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.AuthFilter)))
+	i += copy(dAtA[i:], m.AuthFilter)
+	if m.RenderTemplate != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.RenderTemplate.Size()))
+		n11, err11 := m.RenderTemplate.MarshalTo(dAtA[i:])
+		if err11 != nil {
+			return 0, err11
+		}
+		i += n11
+	}
+	dAtA[i] = 0x68
+	i++
+	if m.UseDefault {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x70
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MatchRule))
+	dAtA[i] = 0x78
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Position))
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.WebSocketOptions != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.WebSocketOptions.Size()))
+		n12, err12 := m.WebSocketOptions.MarshalTo(dAtA[i:])
+		if err12 != nil {
+			return 0, err12
+		}
+		i += n12
+	}
+	dAtA[i] = 0x90
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxQPS))
+	if m.CircuitBreaker != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CircuitBreaker.Size()))
+		n13, err13 := m.CircuitBreaker.MarshalTo(dAtA[i:])
+		if err13 != nil {
+			return 0, err13
+		}
+		i += n13
+	}
+	dAtA[i] = 0xa0
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RateLimitOption))
+	dAtA[i] = 0xa8
+	i++
+	dAtA[i] = 0x1
+	i++
+	if m.UseTLS {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.TlsEmbedCert != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.TlsEmbedCert.Size()))
+		n14, err14 := m.TlsEmbedCert.MarshalTo(dAtA[i:])
+		if err14 != nil {
+			return 0, err14
+		}
+		i += n14
+	}
+	dAtA[i] = 0xb8
+	i++
+	dAtA[i] = 0x1
+	i++
+	if m.Streaming {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.SSEOptions != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.SSEOptions.Size()))
+		n15, err15 := m.SSEOptions.MarshalTo(dAtA[i:])
+		if err15 != nil {
+			return 0, err15
+		}
+		i += n15
+	}
+	if len(m.Transformations) > 0 {
+		for _, msg := range m.Transformations {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0xd0
+	i++
+	dAtA[i] = 0x1
+	i++
+	if m.PartialErrors {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0xda
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.GraphQLField)))
+	i += copy(dAtA[i:], m.GraphQLField)
+	if m.CORS != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CORS.Size()))
+		n16, err16 := m.CORS.MarshalTo(dAtA[i:])
+		if err16 != nil {
+			return 0, err16
+		}
+		i += n16
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CORSPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CORSPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowOrigins) > 0 {
+		for _, s := range m.AllowOrigins {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AllowMethods) > 0 {
+		for _, s := range m.AllowMethods {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AllowHeaders) > 0 {
+		for _, s := range m.AllowHeaders {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExposeHeaders) > 0 {
+		for _, s := range m.ExposeHeaders {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x28
+	i++
+	if m.AllowCredentials {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxAge))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TLSEmbedCert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSEmbedCert) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CertData != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.CertData)))
+		i += copy(dAtA[i:], m.CertData)
+	}
+	if m.KeyData != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.KeyData)))
+		i += copy(dAtA[i:], m.KeyData)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Condition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Parameter.Size()))
+	n17, err17 := m.Parameter.MarshalTo(dAtA[i:])
+	if err17 != nil {
+		return 0, err17
+	}
+	i += n17
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Cmp))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Expect)))
+	i += copy(dAtA[i:], m.Expect)
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Logic))
+	if len(m.Children) > 0 {
+		for _, msg := range m.Children {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Routing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Routing) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ClusterID))
+	if len(m.Conditions) > 0 {
+		for _, msg := range m.Conditions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Strategy))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.TrafficRate))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	if m.Rollout != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Rollout.Size()))
+		n18, err18 := m.Rollout.MarshalTo(dAtA[i:])
+		if err18 != nil {
+			return 0, err18
+		}
+		i += n18
+	}
+	if m.StickyKey != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.StickyKey.Size()))
+		n19, err19 := m.StickyKey.MarshalTo(dAtA[i:])
+		if err19 != nil {
+			return 0, err19
+		}
+		i += n19
+	}
+	if m.Compare != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Compare.Size()))
+		n20, err20 := m.Compare.MarshalTo(dAtA[i:])
+		if err20 != nil {
+			return 0, err20
+		}
+		i += n20
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ShadowCompare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShadowCompare) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, s := range m.Headers {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.IgnoredPaths) > 0 {
+		for _, s := range m.IgnoredPaths {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.SampleRate))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxSamples))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ShadowDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShadowDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.RoutingID))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.At))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Method)))
+	i += copy(dAtA[i:], m.Method)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.URI)))
+	i += copy(dAtA[i:], m.URI)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	if len(m.Diffs) > 0 {
+		for _, s := range m.Diffs {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Rollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rollout) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, num := range m.Steps {
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.StepInterval))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.BaselineClusterID))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.CheckPeriod))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MinRequests))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxFailureRateIncrease))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxLatencyIncrease))
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status.Size()))
+	n21, err21 := m.Status.MarshalTo(dAtA[i:])
+	if err21 != nil {
+		return 0, err21
+	}
+	i += n21
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RolloutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.State))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Step))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.StepStartAt))
+	if len(m.History) > 0 {
+		for _, msg := range m.History {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RolloutEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.At))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Step))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.TrafficRate))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.State))
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Reason)))
+	i += copy(dAtA[i:], m.Reason)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *WebSocketOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebSocketOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Origin)))
+	i += copy(dAtA[i:], m.Origin)
+	dAtA[i] = 0x10
+	i++
+	if m.Secure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.IdleTimeout))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.PingInterval))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.PongTimeout))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxConnections))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.MaxMessageSize))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SSEOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSEOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.IdleTimeout))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *System) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *System) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Count.Size()))
+	n22, err22 := m.Count.MarshalTo(dAtA[i:])
+	if err22 != nil {
+		return 0, err22
+	}
+	i += n22
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CountMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountMetric) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Cluster))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Server))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Routing))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Plugin))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.AppliedPlugin))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Plugin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Plugin) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Author)))
+	i += copy(dAtA[i:], m.Author)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(len(m.Email)))
+	i += copy(dAtA[i:], m.Email)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Status))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.UpdateAt))
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Version))
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.Type))
+	if m.Content != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Content)))
+		i += copy(dAtA[i:], m.Content)
+	}
+	if m.Cfg != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Cfg)))
+		i += copy(dAtA[i:], m.Cfg)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AppliedPlugins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppliedPlugins) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	if len(m.AppliedIDs) > 0 {
+		for _, num := range m.AppliedIDs {
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(num))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintMetapb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Proxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.AddrRPC)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.CacheStorage)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Cluster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.LoadBalance))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeathCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.CheckInterval))
+	n += 1 + sovMetapb(uint64(m.Timeout))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.CloseTimeout))
+	n += 1 + sovMetapb(uint64(m.HalfTrafficRate))
+	n += 1 + sovMetapb(uint64(m.RateCheckPeriod))
+	n += 1 + sovMetapb(uint64(m.FailureRateToClose))
+	n += 1 + sovMetapb(uint64(m.SucceedRateToOpen))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Server) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Addr)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Protocol))
+	n += 1 + sovMetapb(uint64(m.MaxQPS))
+	if m.HeathCheck != nil {
+		l = m.HeathCheck.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 1 + sovMetapb(uint64(m.Weight))
+	n += 1 + sovMetapb(uint64(m.RateLimitOption))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Bind) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	n += 1 + sovMetapb(uint64(m.ServerID))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PairValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IPAccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Whitelist) > 0 {
+		for _, s := range m.Whitelist {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for _, s := range m.Blacklist {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HTTPResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		l = len(m.Body)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Cookies) > 0 {
+		for _, e := range m.Cookies {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Code))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Parameter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Source))
+	n += 1 + sovMetapb(uint64(m.Index))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.RuleType))
+	l = len(m.Expression)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Validation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parameter.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Interval))
+	n += 1 + sovMetapb(uint64(m.MaxTimes))
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DispatchNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	l = len(m.URLRewrite)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.AttrName)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Validations) > 0 {
+		for _, e := range m.Validations {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.Cache != nil {
+		l = m.Cache.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.DefaultValue != nil {
+		l = m.DefaultValue.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 2
+	n += 1 + sovMetapb(uint64(m.BatchIndex))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 1 + sovMetapb(uint64(m.WriteTimeout))
+	n += 1 + sovMetapb(uint64(m.ReadTimeout))
+	n += 1 + sovMetapb(uint64(m.HostType))
+	l = len(m.CustemHost)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Transformations) > 0 {
+		for _, e := range m.Transformations {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.BodyTemplate)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Method)
+	n += 2 + l + sovMetapb(uint64(l))
+	l = len(m.ContentType)
+	n += 2 + l + sovMetapb(uint64(l))
+	n += 2 + sovMetapb(uint64(m.FailurePolicy))
+	if m.ForEach != nil {
+		l = m.ForEach.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.Decoder))
+	if m.Protobuf != nil {
+		l = m.Protobuf.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtobufDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DescriptorSet != nil {
+		l = len(m.DescriptorSet)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	l = len(m.MessageType)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForEach) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Concurrency))
+	n += 1 + sovMetapb(uint64(m.MaxElements))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Transformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Target))
+	n += 1 + sovMetapb(uint64(m.Action))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Cache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Deadline))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 2
+	if len(m.StatusCodes) > 0 {
+		for _, e := range m.StatusCodes {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.StaleWhileRevalidate))
+	n += 1 + sovMetapb(uint64(m.StaleIfError))
+	n += 2
+	n += 1 + sovMetapb(uint64(m.CoalesceTimeout))
+	n += 1 + sovMetapb(uint64(m.ErrorTTL))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Attrs) > 0 {
+		for _, e := range m.Attrs {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderAttr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.ExtractExp)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *API) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.URLPattern)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Domain)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Status))
+	if m.IPAccessControl != nil {
+		l = m.IPAccessControl.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.DefaultValue != nil {
+		l = m.DefaultValue.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.Perms) > 0 {
+		for _, s := range m.Perms {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	l = len(m.AuthFilter)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.RenderTemplate != nil {
+		l = m.RenderTemplate.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	n += 2
+	n += 1 + sovMetapb(uint64(m.MatchRule))
+	n += 1 + sovMetapb(uint64(m.Position))
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 2 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.WebSocketOptions != nil {
+		l = m.WebSocketOptions.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.MaxQPS))
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 2 + sovMetapb(uint64(m.RateLimitOption))
+	n += 3
+	if m.TlsEmbedCert != nil {
+		l = m.TlsEmbedCert.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	n += 3
+	if m.SSEOptions != nil {
+		l = m.SSEOptions.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if len(m.Transformations) > 0 {
+		for _, e := range m.Transformations {
+			l = e.Size()
+			n += 2 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 3
+	l = len(m.GraphQLField)
+	n += 2 + l + sovMetapb(uint64(l))
+	if m.CORS != nil {
+		l = m.CORS.Size()
+		n += 2 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CORSPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowOrigins) > 0 {
+		for _, s := range m.AllowOrigins {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.AllowMethods) > 0 {
+		for _, s := range m.AllowMethods {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.AllowHeaders) > 0 {
+		for _, s := range m.AllowHeaders {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.ExposeHeaders) > 0 {
+		for _, s := range m.ExposeHeaders {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 2
+	n += 1 + sovMetapb(uint64(m.MaxAge))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TLSEmbedCert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CertData != nil {
+		l = len(m.CertData)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.KeyData != nil {
+		l = len(m.KeyData)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Condition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parameter.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Cmp))
+	l = len(m.Expect)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Logic))
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Routing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	n += 1 + sovMetapb(uint64(m.ClusterID))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.Strategy))
+	n += 1 + sovMetapb(uint64(m.TrafficRate))
+	n += 1 + sovMetapb(uint64(m.Status))
+	n += 1 + sovMetapb(uint64(m.API))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.Rollout != nil {
+		l = m.Rollout.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.StickyKey != nil {
+		l = m.StickyKey.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.Compare != nil {
+		l = m.Compare.Size()
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShadowCompare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, s := range m.Headers {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if len(m.IgnoredPaths) > 0 {
+		for _, s := range m.IgnoredPaths {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.SampleRate))
+	n += 1 + sovMetapb(uint64(m.MaxSamples))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShadowDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.RoutingID))
+	n += 1 + sovMetapb(uint64(m.At))
+	l = len(m.Method)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.URI)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Proxy)
+	n += 1 + l + sovMetapb(uint64(l))
+	if len(m.Diffs) > 0 {
+		for _, s := range m.Diffs {
+			l = len(s)
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Rollout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	n += 1 + sovMetapb(uint64(m.StepInterval))
+	n += 1 + sovMetapb(uint64(m.BaselineClusterID))
+	n += 1 + sovMetapb(uint64(m.CheckPeriod))
+	n += 1 + sovMetapb(uint64(m.MinRequests))
+	n += 1 + sovMetapb(uint64(m.MaxFailureRateIncrease))
+	n += 1 + sovMetapb(uint64(m.MaxLatencyIncrease))
+	l = m.Status.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RolloutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.State))
+	n += 1 + sovMetapb(uint64(m.Step))
+	n += 1 + sovMetapb(uint64(m.StepStartAt))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RolloutEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.At))
+	n += 1 + sovMetapb(uint64(m.Step))
+	n += 1 + sovMetapb(uint64(m.TrafficRate))
+	n += 1 + sovMetapb(uint64(m.State))
+	l = len(m.Reason)
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WebSocketOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Origin)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 2
+	n += 1 + sovMetapb(uint64(m.IdleTimeout))
+	n += 1 + sovMetapb(uint64(m.PingInterval))
+	n += 1 + sovMetapb(uint64(m.PongTimeout))
+	n += 1 + sovMetapb(uint64(m.MaxConnections))
+	n += 1 + sovMetapb(uint64(m.MaxMessageSize))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSEOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.IdleTimeout))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *System) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Count.Size()
+	n += 1 + l + sovMetapb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.Cluster))
+	n += 1 + sovMetapb(uint64(m.Server))
+	n += 1 + sovMetapb(uint64(m.API))
+	n += 1 + sovMetapb(uint64(m.Routing))
+	n += 1 + sovMetapb(uint64(m.Plugin))
+	n += 1 + sovMetapb(uint64(m.AppliedPlugin))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Plugin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	l = len(m.Name)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovMetapb(uint64(l))
+	l = len(m.Email)
+	n += 1 + l + sovMetapb(uint64(l))
+	n += 1 + sovMetapb(uint64(m.Status))
+	n += 1 + sovMetapb(uint64(m.UpdateAt))
+	n += 1 + sovMetapb(uint64(m.Version))
+	n += 1 + sovMetapb(uint64(m.Type))
+	if m.Content != nil {
+		l = len(m.Content)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.Cfg != nil {
+		l = len(m.Cfg)
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AppliedPlugins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMetapb(uint64(m.ID))
+	if len(m.AppliedIDs) > 0 {
+		for _, e := range m.AppliedIDs {
+			n += 1 + sovMetapb(uint64(e))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMetapb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMetapb(x uint64) (n int) {
+	return sovMetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Proxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddrRPC", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddrRPC = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheStorage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheStorage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalance", wireType)
+			}
+			m.LoadBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoadBalance |= LoadBalance(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeathCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeathCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeathCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+			}
+			m.CheckInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTimeout", wireType)
+			}
+			m.CloseTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfTrafficRate", wireType)
+			}
+			m.HalfTrafficRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalfTrafficRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateCheckPeriod", wireType)
+			}
+			m.RateCheckPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateCheckPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureRateToClose", wireType)
+			}
+			m.FailureRateToClose = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureRateToClose |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SucceedRateToOpen", wireType)
+			}
+			m.SucceedRateToOpen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SucceedRateToOpen |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Server) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Server: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Server: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= Protocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+			}
+			m.MaxQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQPS |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeathCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeathCheck == nil {
+				m.HeathCheck = &HeathCheck{}
+			}
+			if err := m.HeathCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitOption", wireType)
+			}
+			m.RateLimitOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitOption |= RateLimitOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bind) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bind: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bind: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			m.ServerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPAccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklist = append(m.Blacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &PairValue{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cookies = append(m.Cookies, &PairValue{})
+			if err := m.Cookies[len(m.Cookies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Parameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= Source(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleType", wireType)
+			}
+			m.RuleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RuleType |= RuleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, ValidationRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimes", wireType)
+			}
+			m.MaxTimes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Codes = append(m.Codes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Codes) == 0 {
+					m.Codes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Codes = append(m.Codes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DispatchNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DispatchNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DispatchNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLRewrite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLRewrite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttrName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validations = append(m.Validations, &Validation{})
+			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cache == nil {
+				m.Cache = &Cache{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultValue == nil {
+				m.DefaultValue = &HTTPResult{}
+			}
+			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseDefault = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			}
+			m.BatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+			}
+			m.WriteTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+			}
+			m.ReadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostType", wireType)
+			}
+			m.HostType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostType |= HostType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustemHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustemHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transformations = append(m.Transformations, &Transformation{})
+			if err := m.Transformations[len(m.Transformations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4700,11 +9499,11 @@ func (m *Proxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddrRPC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4732,7 +9531,117 @@ func (m *Proxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddrRPC = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			m.FailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailurePolicy |= FailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForEach", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForEach == nil {
+				m.ForEach = &ForEach{}
+			}
+			if err := m.ForEach.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			m.Decoder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decoder |= ResponseDecoder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protobuf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protobuf == nil {
+				m.Protobuf = &ProtobufDescriptor{}
+			}
+			if err := m.Protobuf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4759,7 +9668,7 @@ func (m *Proxy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *ProtobufDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4782,17 +9691,17 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtobufDescriptor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtobufDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptorSet", wireType)
 			}
-			m.ID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4802,14 +9711,29 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptorSet = append(m.DescriptorSet[:0], dAtA[iNdEx:postIndex]...)
+			if m.DescriptorSet == nil {
+				m.DescriptorSet = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4837,27 +9761,8 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MessageType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalance", wireType)
-			}
-			m.LoadBalance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LoadBalance |= LoadBalance(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4883,7 +9788,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HeathCheck) Unmarshal(dAtA []byte) error {
+func (m *ForEach) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4906,10 +9811,10 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HeathCheck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForEach: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeathCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForEach: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4945,42 +9850,10 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Body = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
-			m.CheckInterval = 0
+			m.Concurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -4990,16 +9863,16 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CheckInterval |= int64(b&0x7F) << shift
+				m.Concurrency |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElements", wireType)
 			}
-			m.Timeout = 0
+			m.MaxElements = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5009,7 +9882,7 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
+				m.MaxElements |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5039,7 +9912,7 @@ func (m *HeathCheck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+func (m *Transformation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5062,17 +9935,17 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: Transformation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Transformation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			m.CloseTimeout = 0
+			m.Target = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5082,16 +9955,16 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CloseTimeout |= int64(b&0x7F) << shift
+				m.Target |= TransformTarget(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HalfTrafficRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.HalfTrafficRate = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5101,16 +9974,16 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HalfTrafficRate |= int32(b&0x7F) << shift
+				m.Action |= TransformAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateCheckPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.RateCheckPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5120,16 +9993,29 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RateCheckPeriod |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureRateToClose", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.FailureRateToClose = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5139,30 +10025,24 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailureRateToClose |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SucceedRateToOpen", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.SucceedRateToOpen = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SucceedRateToOpen |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -5188,7 +10068,7 @@ func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Server) Unmarshal(dAtA []byte) error {
+func (m *Cache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5211,17 +10091,17 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Server: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Server: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5231,16 +10111,50 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, Parameter{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5250,29 +10164,31 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPSemantics", wireType)
 			}
-			m.Protocol = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5282,16 +10198,93 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Protocol |= Protocol(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.HTTPSemantics = bool(v != 0)
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StatusCodes = append(m.StatusCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.StatusCodes) == 0 {
+					m.StatusCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StatusCodes = append(m.StatusCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCodes", wireType)
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleWhileRevalidate", wireType)
 			}
-			m.MaxQPS = 0
+			m.StaleWhileRevalidate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5301,16 +10294,16 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQPS |= int64(b&0x7F) << shift
+				m.StaleWhileRevalidate |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeathCheck", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleIfError", wireType)
 			}
-			var msglen int
+			m.StaleIfError = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5320,31 +10313,126 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StaleIfError |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coalesce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Coalesce = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoalesceTimeout", wireType)
+			}
+			m.CoalesceTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoalesceTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorTTL", wireType)
+			}
+			m.ErrorTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorTTL |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeathCheck == nil {
-				m.HeathCheck = &HeathCheck{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
 			}
-			if err := m.HeathCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5371,51 +10459,11 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CircuitBreaker == nil {
-				m.CircuitBreaker = &CircuitBreaker{}
-			}
-			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, &RenderObject{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			m.Weight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Weight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitOption", wireType)
-			}
-			m.RateLimitOption = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RateLimitOption |= RateLimitOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -5441,7 +10489,7 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bind) Unmarshal(dAtA []byte) error {
+func (m *RenderObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5464,17 +10512,17 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bind: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenderObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bind: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenderObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ClusterID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5484,16 +10532,63 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attrs = append(m.Attrs, &RenderAttr{})
+			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatAttrs", wireType)
 			}
-			m.ServerID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5503,11 +10598,12 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServerID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.FlatAttrs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -5533,7 +10629,7 @@ func (m *Bind) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PairValue) Unmarshal(dAtA []byte) error {
+func (m *RenderAttr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5556,10 +10652,10 @@ func (m *PairValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PairValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenderAttr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenderAttr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5596,7 +10692,7 @@ func (m *PairValue) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractExp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5624,7 +10720,39 @@ func (m *PairValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ExtractExp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5651,7 +10779,7 @@ func (m *PairValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
+func (m *API) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5674,15 +10802,34 @@ func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPAccessControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: API: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: API: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5710,11 +10857,75 @@ func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5742,67 +10953,13 @@ func (m *IPAccessControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blacklist = append(m.Blacklist, string(dAtA[iNdEx:postIndex]))
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5812,29 +10969,14 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
-			if m.Body == nil {
-				m.Body = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IPAccessControl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5861,14 +11003,16 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, &PairValue{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.IPAccessControl == nil {
+				m.IPAccessControl = &IPAccessControl{}
+			}
+			if err := m.IPAccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cookies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5895,16 +11039,18 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cookies = append(m.Cookies, &PairValue{})
-			if err := m.Cookies[len(m.Cookies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DefaultValue == nil {
+				m.DefaultValue = &HTTPResult{}
+			}
+			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			m.Code = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -5914,68 +11060,29 @@ func (m *HTTPResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Parameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Nodes = append(m.Nodes, &DispatchNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Perms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6003,13 +11110,13 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Perms = append(m.Perms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthFilter", wireType)
 			}
-			m.Source = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6019,16 +11126,29 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= Source(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.Index = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderTemplate", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6038,70 +11158,72 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidationRule) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+			if m.RenderTemplate == nil {
+				m.RenderTemplate = &RenderTemplate{}
+			}
+			if err := m.RenderTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.UseDefault = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchRule", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MatchRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchRule |= MatchRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidationRule: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidationRule: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			m.RuleType = 0
+			m.Position = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6111,16 +11233,16 @@ func (m *ValidationRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RuleType |= RuleType(b&0x7F) << shift
+				m.Position |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6130,81 +11252,29 @@ func (m *ValidationRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
+			m.Tags = append(m.Tags, &PairValue{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Validation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebSocketOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6231,15 +11301,18 @@ func (m *Validation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WebSocketOptions == nil {
+				m.WebSocketOptions = &WebSocketOptions{}
+			}
+			if err := m.WebSocketOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
 			}
-			var v int
+			m.MaxQPS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6249,15 +11322,14 @@ func (m *Validation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxQPS |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Required = bool(v != 0)
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6284,70 +11356,93 @@ func (m *Validation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rules = append(m.Rules, ValidationRule{})
-			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitOption", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
+			m.RateLimitOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitOption |= RateLimitOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMetapb
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+			m.UseTLS = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsEmbedCert", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TlsEmbedCert == nil {
+				m.TlsEmbedCert = &TLSEmbedCert{}
+			}
+			if err := m.TlsEmbedCert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
 			}
-			m.Interval = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6357,16 +11452,17 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimes", wireType)
+			m.Streaming = bool(v != 0)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSEOptions", wireType)
 			}
-			m.MaxTimes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6376,146 +11472,33 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTimes |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMetapb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Codes = append(m.Codes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMetapb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMetapb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMetapb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Codes) == 0 {
-					m.Codes = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMetapb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Codes = append(m.Codes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DispatchNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SSEOptions == nil {
+				m.SSEOptions = &SSEOptions{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SSEOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DispatchNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DispatchNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformations", wireType)
 			}
-			m.ClusterID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6525,16 +11508,31 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLRewrite", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transformations = append(m.Transformations, &Transformation{})
+			if err := m.Transformations[len(m.Transformations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialErrors", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6544,27 +11542,15 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URLRewrite = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.PartialErrors = bool(v != 0)
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttrName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GraphQLField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6592,11 +11578,11 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttrName = string(dAtA[iNdEx:postIndex])
+			m.GraphQLField = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CORS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6623,16 +11609,72 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validations = append(m.Validations, &Validation{})
-			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CORS == nil {
+				m.CORS = &CORSPolicy{}
+			}
+			if err := m.CORS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CORSPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CORSPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CORSPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOrigins", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6642,33 +11684,29 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cache == nil {
-				m.Cache = &Cache{}
-			}
-			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowOrigins = append(m.AllowOrigins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMethods", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6678,33 +11716,29 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultValue == nil {
-				m.DefaultValue = &HTTPResult{}
-			}
-			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowMethods = append(m.AllowMethods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowHeaders", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6714,36 +11748,29 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseDefault = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.BatchIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchIndex |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
 			}
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowHeaders = append(m.AllowHeaders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExposeHeaders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6753,33 +11780,29 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExposeHeaders = append(m.ExposeHeaders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowCredentials", wireType)
 			}
-			m.WriteTimeout = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6789,16 +11812,17 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WriteTimeout |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.AllowCredentials = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			m.ReadTimeout = 0
+			m.MaxAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6808,16 +11832,70 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTimeout |= int64(b&0x7F) << shift
+				m.MaxAge |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.HostType = 0
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSEmbedCert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLSEmbedCert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLSEmbedCert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6827,16 +11905,31 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HostType |= HostType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if byteLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustemHost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6846,23 +11939,25 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustemHost = string(dAtA[iNdEx:postIndex])
+			m.KeyData = append(m.KeyData[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyData == nil {
+				m.KeyData = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6889,7 +11984,7 @@ func (m *DispatchNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6912,15 +12007,15 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6940,23 +12035,73 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
+			}
+			m.Cmp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cmp |= CMP(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expect", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, Parameter{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expect = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logic", wireType)
 			}
-			m.Deadline = 0
+			m.Logic = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -6966,14 +12111,14 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deadline |= uint64(b&0x7F) << shift
+				m.Logic |= Logic(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7000,8 +12145,8 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Children = append(m.Children, Condition{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7030,7 +12175,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
+func (m *Routing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7053,15 +12198,53 @@ func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RenderTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Routing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenderTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Routing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7088,70 +12271,54 @@ func (m *RenderTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &RenderObject{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RenderObject) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= RoutingStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRate", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TrafficRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrafficRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RenderObject: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenderObject: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7161,29 +12328,35 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.API = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.API |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7193,31 +12366,29 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attrs = append(m.Attrs, &RenderAttr{})
-			if err := m.Attrs[len(m.Attrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatAttrs", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7226,72 +12397,34 @@ func (m *RenderObject) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FlatAttrs = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RenderAttr) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetapb
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Rollout == nil {
+				m.Rollout = &Rollout{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RenderAttr: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenderAttr: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Rollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7301,29 +12434,33 @@ func (m *RenderAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.StickyKey == nil {
+				m.StickyKey = &Parameter{}
+			}
+			if err := m.StickyKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtractExp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compare", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7333,23 +12470,27 @@ func (m *RenderAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtractExp = string(dAtA[iNdEx:postIndex])
+			if m.Compare == nil {
+				m.Compare = &ShadowCompare{}
+			}
+			if err := m.Compare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7376,7 +12517,7 @@ func (m *RenderAttr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *API) Unmarshal(dAtA []byte) error {
+func (m *ShadowCompare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7399,34 +12540,15 @@ func (m *API) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: API: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShadowCompare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: API: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShadowCompare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7454,11 +12576,11 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLPattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoredPaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7486,13 +12608,13 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URLPattern = string(dAtA[iNdEx:postIndex])
+			m.IgnoredPaths = append(m.IgnoredPaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleRate", wireType)
 			}
-			var stringLen uint64
+			m.SampleRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7502,29 +12624,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SampleRate |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamples", wireType)
 			}
-			var stringLen uint64
+			m.MaxSamples = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7534,48 +12643,70 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxSamples |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShadowDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPAccessControl", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShadowDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShadowDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingID", wireType)
+			}
+			m.RoutingID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7585,33 +12716,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RoutingID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.IPAccessControl == nil {
-				m.IPAccessControl = &IPAccessControl{}
-			}
-			if err := m.IPAccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
 			}
-			var msglen int
+			m.At = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7621,33 +12735,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.At |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DefaultValue == nil {
-				m.DefaultValue = &HTTPResult{}
-			}
-			if err := m.DefaultValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7657,29 +12754,27 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &DispatchNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7707,11 +12802,11 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Perms = append(m.Perms, string(dAtA[iNdEx:postIndex]))
+			m.URI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7739,13 +12834,13 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthFilter = string(dAtA[iNdEx:postIndex])
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenderTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7755,91 +12850,159 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RenderTemplate == nil {
-				m.RenderTemplate = &RenderTemplate{}
-			}
-			if err := m.RenderTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Diffs = append(m.Diffs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDefault", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
 			}
-			m.UseDefault = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchRule", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MatchRule = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Steps = append(m.Steps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MatchRule |= MatchRule(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
 				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
-			}
-			m.Position = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetapb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Position |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Steps) == 0 {
+					m.Steps = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Steps = append(m.Steps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
 			}
-			var msglen int
+			m.StepInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7849,31 +13012,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StepInterval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tags = append(m.Tags, &PairValue{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebSocketOptions", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaselineClusterID", wireType)
 			}
-			var msglen int
+			m.BaselineClusterID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7883,33 +13031,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BaselineClusterID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WebSocketOptions == nil {
-				m.WebSocketOptions = &WebSocketOptions{}
-			}
-			if err := m.WebSocketOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 18:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckPeriod", wireType)
 			}
-			m.MaxQPS = 0
+			m.CheckPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7919,16 +13050,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQPS |= int64(b&0x7F) << shift
+				m.CheckPeriod |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRequests", wireType)
 			}
-			var msglen int
+			m.MinRequests = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7938,33 +13069,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinRequests |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CircuitBreaker == nil {
-				m.CircuitBreaker = &CircuitBreaker{}
-			}
-			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailureRateIncrease", wireType)
 			}
-			m.RateLimitOption = 0
+			m.MaxFailureRateIncrease = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7974,16 +13088,16 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RateLimitOption |= RateLimitOption(b&0x7F) << shift
+				m.MaxFailureRateIncrease |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatencyIncrease", wireType)
 			}
-			var v int
+			m.MaxLatencyIncrease = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -7993,15 +13107,14 @@ func (m *API) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxLatencyIncrease |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseTLS = bool(v != 0)
-		case 22:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TlsEmbedCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8028,10 +13141,7 @@ func (m *API) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TlsEmbedCert == nil {
-				m.TlsEmbedCert = &TLSEmbedCert{}
-			}
-			if err := m.TlsEmbedCert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8060,7 +13170,7 @@ func (m *API) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLSEmbedCert) Unmarshal(dAtA []byte) error {
+func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8083,17 +13193,17 @@ func (m *TLSEmbedCert) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TLSEmbedCert: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TLSEmbedCert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var byteLen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8103,31 +13213,54 @@ func (m *TLSEmbedCert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.State |= RolloutState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMetapb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepStartAt", wireType)
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
+			m.StepStartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepStartAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8137,24 +13270,24 @@ func (m *TLSEmbedCert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyData = append(m.KeyData[:0], dAtA[iNdEx:postIndex]...)
-			if m.KeyData == nil {
-				m.KeyData = []byte{}
+			m.History = append(m.History, RolloutEvent{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -8182,7 +13315,7 @@ func (m *TLSEmbedCert) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *RolloutEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8205,17 +13338,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
 			}
-			var msglen int
+			m.At = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8225,30 +13358,54 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.At |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRate", wireType)
 			}
-			if err := m.Parameter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TrafficRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrafficRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Cmp = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8258,14 +13415,14 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cmp |= CMP(b&0x7F) << shift
+				m.State |= RolloutState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expect", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8293,7 +13450,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expect = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8320,7 +13477,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Routing) Unmarshal(dAtA []byte) error {
+func (m *WebSocketOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8343,17 +13500,17 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Routing: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebSocketOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Routing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebSocketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8363,16 +13520,29 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secure", wireType)
 			}
-			m.ClusterID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8382,16 +13552,17 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Secure = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
 			}
-			var msglen int
+			m.IdleTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8401,31 +13572,16 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IdleTimeout |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingInterval", wireType)
 			}
-			m.Strategy = 0
+			m.PingInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8435,16 +13591,16 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Strategy |= RoutingStrategy(b&0x7F) << shift
+				m.PingInterval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PongTimeout", wireType)
 			}
-			m.TrafficRate = 0
+			m.PongTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8454,16 +13610,16 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TrafficRate |= int32(b&0x7F) << shift
+				m.PongTimeout |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConnections", wireType)
 			}
-			m.Status = 0
+			m.MaxConnections = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8473,35 +13629,16 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				m.MaxConnections |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
-			}
-			m.API = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.API |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessageSize", wireType)
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
+			m.MaxMessageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8511,24 +13648,11 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxMessageSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -8554,7 +13678,7 @@ func (m *Routing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebSocketOptions) Unmarshal(dAtA []byte) error {
+func (m *SSEOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8577,17 +13701,17 @@ func (m *WebSocketOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebSocketOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: SSEOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebSocketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SSEOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
 			}
-			var stringLen uint64
+			m.IdleTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetapb
@@ -8597,24 +13721,11 @@ func (m *WebSocketOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IdleTimeout |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Origin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
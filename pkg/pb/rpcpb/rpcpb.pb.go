@@ -2558,6 +2558,210 @@ func (m *GetAppliedPluginsRsp) GetApplied() *metapb.AppliedPlugins {
 	return nil
 }
 
+type PutIPAccessControlReq struct {
+	Header               RpcHeader              `protobuf:"bytes,1,opt,name=header" json:"header"`
+	Value                metapb.IPAccessControl `protobuf:"bytes,2,opt,name=value" json:"value"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PutIPAccessControlReq) Reset()         { *m = PutIPAccessControlReq{} }
+func (m *PutIPAccessControlReq) String() string { return proto.CompactTextString(m) }
+func (*PutIPAccessControlReq) ProtoMessage()    {}
+func (*PutIPAccessControlReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{48}
+}
+func (m *PutIPAccessControlReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutIPAccessControlReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutIPAccessControlReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PutIPAccessControlReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutIPAccessControlReq.Merge(m, src)
+}
+func (m *PutIPAccessControlReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutIPAccessControlReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutIPAccessControlReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutIPAccessControlReq proto.InternalMessageInfo
+
+func (m *PutIPAccessControlReq) GetHeader() RpcHeader {
+	if m != nil {
+		return m.Header
+	}
+	return RpcHeader{}
+}
+
+func (m *PutIPAccessControlReq) GetValue() metapb.IPAccessControl {
+	if m != nil {
+		return m.Value
+	}
+	return metapb.IPAccessControl{}
+}
+
+type PutIPAccessControlRsp struct {
+	Header               RpcHeader `protobuf:"bytes,1,opt,name=header" json:"header"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PutIPAccessControlRsp) Reset()         { *m = PutIPAccessControlRsp{} }
+func (m *PutIPAccessControlRsp) String() string { return proto.CompactTextString(m) }
+func (*PutIPAccessControlRsp) ProtoMessage()    {}
+func (*PutIPAccessControlRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{49}
+}
+func (m *PutIPAccessControlRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutIPAccessControlRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutIPAccessControlRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PutIPAccessControlRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutIPAccessControlRsp.Merge(m, src)
+}
+func (m *PutIPAccessControlRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutIPAccessControlRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutIPAccessControlRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutIPAccessControlRsp proto.InternalMessageInfo
+
+func (m *PutIPAccessControlRsp) GetHeader() RpcHeader {
+	if m != nil {
+		return m.Header
+	}
+	return RpcHeader{}
+}
+
+type GetIPAccessControlReq struct {
+	Header               RpcHeader `protobuf:"bytes,1,opt,name=header" json:"header"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetIPAccessControlReq) Reset()         { *m = GetIPAccessControlReq{} }
+func (m *GetIPAccessControlReq) String() string { return proto.CompactTextString(m) }
+func (*GetIPAccessControlReq) ProtoMessage()    {}
+func (*GetIPAccessControlReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{50}
+}
+func (m *GetIPAccessControlReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetIPAccessControlReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetIPAccessControlReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetIPAccessControlReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIPAccessControlReq.Merge(m, src)
+}
+func (m *GetIPAccessControlReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetIPAccessControlReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIPAccessControlReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIPAccessControlReq proto.InternalMessageInfo
+
+func (m *GetIPAccessControlReq) GetHeader() RpcHeader {
+	if m != nil {
+		return m.Header
+	}
+	return RpcHeader{}
+}
+
+type GetIPAccessControlRsp struct {
+	Header               RpcHeader               `protobuf:"bytes,1,opt,name=header" json:"header"`
+	Value                *metapb.IPAccessControl `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetIPAccessControlRsp) Reset()         { *m = GetIPAccessControlRsp{} }
+func (m *GetIPAccessControlRsp) String() string { return proto.CompactTextString(m) }
+func (*GetIPAccessControlRsp) ProtoMessage()    {}
+func (*GetIPAccessControlRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{51}
+}
+func (m *GetIPAccessControlRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetIPAccessControlRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetIPAccessControlRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetIPAccessControlRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIPAccessControlRsp.Merge(m, src)
+}
+func (m *GetIPAccessControlRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetIPAccessControlRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIPAccessControlRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIPAccessControlRsp proto.InternalMessageInfo
+
+func (m *GetIPAccessControlRsp) GetHeader() RpcHeader {
+	if m != nil {
+		return m.Header
+	}
+	return RpcHeader{}
+}
+
+func (m *GetIPAccessControlRsp) GetValue() *metapb.IPAccessControl {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type CleanReq struct {
 	Header               RpcHeader `protobuf:"bytes,1,opt,name=header" json:"header"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -2569,7 +2773,7 @@ func (m *CleanReq) Reset()         { *m = CleanReq{} }
 func (m *CleanReq) String() string { return proto.CompactTextString(m) }
 func (*CleanReq) ProtoMessage()    {}
 func (*CleanReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{48}
+	return fileDescriptor_25e491924c678914, []int{52}
 }
 func (m *CleanReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2616,7 +2820,7 @@ func (m *CleanRsp) Reset()         { *m = CleanRsp{} }
 func (m *CleanRsp) String() string { return proto.CompactTextString(m) }
 func (*CleanRsp) ProtoMessage()    {}
 func (*CleanRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{49}
+	return fileDescriptor_25e491924c678914, []int{53}
 }
 func (m *CleanRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2664,7 +2868,7 @@ func (m *SetIDReq) Reset()         { *m = SetIDReq{} }
 func (m *SetIDReq) String() string { return proto.CompactTextString(m) }
 func (*SetIDReq) ProtoMessage()    {}
 func (*SetIDReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{50}
+	return fileDescriptor_25e491924c678914, []int{54}
 }
 func (m *SetIDReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2718,7 +2922,7 @@ func (m *SetIDRsp) Reset()         { *m = SetIDRsp{} }
 func (m *SetIDRsp) String() string { return proto.CompactTextString(m) }
 func (*SetIDRsp) ProtoMessage()    {}
 func (*SetIDRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{51}
+	return fileDescriptor_25e491924c678914, []int{55}
 }
 func (m *SetIDRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2778,7 +2982,7 @@ func (m *BatchReq) Reset()         { *m = BatchReq{} }
 func (m *BatchReq) String() string { return proto.CompactTextString(m) }
 func (*BatchReq) ProtoMessage()    {}
 func (*BatchReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{52}
+	return fileDescriptor_25e491924c678914, []int{56}
 }
 func (m *BatchReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2929,7 +3133,7 @@ func (m *BatchRsp) Reset()         { *m = BatchRsp{} }
 func (m *BatchRsp) String() string { return proto.CompactTextString(m) }
 func (*BatchRsp) ProtoMessage()    {}
 func (*BatchRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{53}
+	return fileDescriptor_25e491924c678914, []int{57}
 }
 func (m *BatchRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3056,183 +3260,404 @@ func (m *BatchRsp) GetApplyPlugins() *ApplyPluginsRsp {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*RpcHeader)(nil), "rpcpb.RpcHeader")
-	proto.RegisterType((*PutClusterReq)(nil), "rpcpb.PutClusterReq")
-	proto.RegisterType((*PutClusterRsp)(nil), "rpcpb.PutClusterRsp")
-	proto.RegisterType((*RemoveClusterReq)(nil), "rpcpb.RemoveClusterReq")
-	proto.RegisterType((*RemoveClusterRsp)(nil), "rpcpb.RemoveClusterRsp")
-	proto.RegisterType((*GetClusterReq)(nil), "rpcpb.GetClusterReq")
-	proto.RegisterType((*GetClusterRsp)(nil), "rpcpb.GetClusterRsp")
-	proto.RegisterType((*GetClusterListReq)(nil), "rpcpb.GetClusterListReq")
-	proto.RegisterType((*PutServerReq)(nil), "rpcpb.PutServerReq")
-	proto.RegisterType((*PutServerRsp)(nil), "rpcpb.PutServerRsp")
-	proto.RegisterType((*RemoveServerReq)(nil), "rpcpb.RemoveServerReq")
-	proto.RegisterType((*RemoveServerRsp)(nil), "rpcpb.RemoveServerRsp")
-	proto.RegisterType((*GetServerReq)(nil), "rpcpb.GetServerReq")
-	proto.RegisterType((*GetServerRsp)(nil), "rpcpb.GetServerRsp")
-	proto.RegisterType((*GetServerListReq)(nil), "rpcpb.GetServerListReq")
-	proto.RegisterType((*PutAPIReq)(nil), "rpcpb.PutAPIReq")
-	proto.RegisterType((*PutAPIRsp)(nil), "rpcpb.PutAPIRsp")
-	proto.RegisterType((*RemoveAPIReq)(nil), "rpcpb.RemoveAPIReq")
-	proto.RegisterType((*RemoveAPIRsp)(nil), "rpcpb.RemoveAPIRsp")
-	proto.RegisterType((*GetAPIReq)(nil), "rpcpb.GetAPIReq")
-	proto.RegisterType((*GetAPIRsp)(nil), "rpcpb.GetAPIRsp")
-	proto.RegisterType((*GetAPIListReq)(nil), "rpcpb.GetAPIListReq")
-	proto.RegisterType((*PutRoutingReq)(nil), "rpcpb.PutRoutingReq")
-	proto.RegisterType((*PutRoutingRsp)(nil), "rpcpb.PutRoutingRsp")
-	proto.RegisterType((*RemoveRoutingReq)(nil), "rpcpb.RemoveRoutingReq")
-	proto.RegisterType((*RemoveRoutingRsp)(nil), "rpcpb.RemoveRoutingRsp")
-	proto.RegisterType((*GetRoutingReq)(nil), "rpcpb.GetRoutingReq")
-	proto.RegisterType((*GetRoutingRsp)(nil), "rpcpb.GetRoutingRsp")
-	proto.RegisterType((*GetRoutingListReq)(nil), "rpcpb.GetRoutingListReq")
-	proto.RegisterType((*AddBindReq)(nil), "rpcpb.AddBindReq")
-	proto.RegisterType((*AddBindRsp)(nil), "rpcpb.AddBindRsp")
-	proto.RegisterType((*RemoveBindReq)(nil), "rpcpb.RemoveBindReq")
-	proto.RegisterType((*RemoveBindRsp)(nil), "rpcpb.RemoveBindRsp")
-	proto.RegisterType((*RemoveClusterBindReq)(nil), "rpcpb.RemoveClusterBindReq")
-	proto.RegisterType((*RemoveClusterBindRsp)(nil), "rpcpb.RemoveClusterBindRsp")
-	proto.RegisterType((*GetBindServersReq)(nil), "rpcpb.GetBindServersReq")
-	proto.RegisterType((*GetBindServersRsp)(nil), "rpcpb.GetBindServersRsp")
-	proto.RegisterType((*PutPluginReq)(nil), "rpcpb.PutPluginReq")
-	proto.RegisterType((*PutPluginRsp)(nil), "rpcpb.PutPluginRsp")
-	proto.RegisterType((*RemovePluginReq)(nil), "rpcpb.RemovePluginReq")
-	proto.RegisterType((*RemovePluginRsp)(nil), "rpcpb.RemovePluginRsp")
-	proto.RegisterType((*GetPluginReq)(nil), "rpcpb.GetPluginReq")
-	proto.RegisterType((*GetPluginRsp)(nil), "rpcpb.GetPluginRsp")
-	proto.RegisterType((*GetPluginListReq)(nil), "rpcpb.GetPluginListReq")
-	proto.RegisterType((*ApplyPluginsReq)(nil), "rpcpb.ApplyPluginsReq")
-	proto.RegisterType((*ApplyPluginsRsp)(nil), "rpcpb.ApplyPluginsRsp")
-	proto.RegisterType((*GetAppliedPluginsReq)(nil), "rpcpb.GetAppliedPluginsReq")
-	proto.RegisterType((*GetAppliedPluginsRsp)(nil), "rpcpb.GetAppliedPluginsRsp")
-	proto.RegisterType((*CleanReq)(nil), "rpcpb.CleanReq")
-	proto.RegisterType((*CleanRsp)(nil), "rpcpb.CleanRsp")
-	proto.RegisterType((*SetIDReq)(nil), "rpcpb.SetIDReq")
-	proto.RegisterType((*SetIDRsp)(nil), "rpcpb.SetIDRsp")
-	proto.RegisterType((*BatchReq)(nil), "rpcpb.BatchReq")
-	proto.RegisterType((*BatchRsp)(nil), "rpcpb.BatchRsp")
+// PurgeCacheReq purge the cached responses of all the proxies by one of the api,
+// the caching key, the tag or the prefix of the caching keys
+type PurgeCacheReq struct {
+	Header               RpcHeader `protobuf:"bytes,1,opt,name=header" json:"header"`
+	API                  uint64    `protobuf:"varint,2,opt,name=api" json:"api"`
+	Key                  string    `protobuf:"bytes,3,opt,name=key" json:"key"`
+	Tag                  string    `protobuf:"bytes,4,opt,name=tag" json:"tag"`
+	Prefix               string    `protobuf:"bytes,5,opt,name=prefix" json:"prefix"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func init() { proto.RegisterFile("rpcpb.proto", fileDescriptor_25e491924c678914) }
+func (m *PurgeCacheReq) Reset()         { *m = PurgeCacheReq{} }
+func (m *PurgeCacheReq) String() string { return proto.CompactTextString(m) }
+func (*PurgeCacheReq) ProtoMessage()    {}
+func (*PurgeCacheReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{58}
+}
+func (m *PurgeCacheReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeCacheReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeCacheReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeCacheReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeCacheReq.Merge(m, src)
+}
+func (m *PurgeCacheReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeCacheReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeCacheReq.DiscardUnknown(m)
+}
 
-var fileDescriptor_25e491924c678914 = []byte{
-	// 1410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xfd, 0x6a, 0x1b, 0x47,
-	0x10, 0xd7, 0xd9, 0x8e, 0x65, 0x8f, 0xe4, 0x8f, 0x6c, 0x4c, 0x7a, 0x6c, 0x8b, 0x12, 0x0e, 0x0a,
-	0x25, 0x6d, 0xed, 0xd4, 0x81, 0x16, 0x8c, 0xa9, 0x23, 0x39, 0xd8, 0x35, 0xb4, 0x54, 0x5c, 0xa0,
-	0x01, 0x97, 0x16, 0x14, 0xe9, 0xaa, 0x08, 0x14, 0x6b, 0xab, 0x3d, 0x85, 0xa6, 0xd0, 0xd7, 0x28,
-	0x7d, 0xa4, 0xfc, 0x99, 0x17, 0x68, 0x68, 0xdd, 0x17, 0x29, 0xb7, 0xdf, 0x7b, 0xba, 0x73, 0xa5,
-	0xb1, 0x45, 0xff, 0xb2, 0xbc, 0x37, 0x5f, 0x3b, 0x33, 0xbf, 0x99, 0xd9, 0x81, 0xda, 0x98, 0x75,
-	0xd9, 0xf3, 0x5d, 0x36, 0x1e, 0xa5, 0x23, 0x72, 0x4b, 0xfc, 0x43, 0xef, 0xbc, 0x4c, 0xd2, 0x0e,
-	0x7b, 0xbe, 0x27, 0xff, 0xc8, 0x6f, 0x74, 0xa7, 0x3f, 0xea, 0x8f, 0xc4, 0xcf, 0xbd, 0xec, 0x97,
-	0x3c, 0x8d, 0x3e, 0x84, 0xf5, 0x98, 0x75, 0xbf, 0x4a, 0x3a, 0xbd, 0x64, 0x4c, 0x42, 0x58, 0x99,
-	0x4c, 0x06, 0xbd, 0x30, 0xb8, 0x1f, 0x7c, 0xb4, 0xde, 0x5a, 0x79, 0xf3, 0xee, 0x5e, 0x25, 0x16,
-	0x27, 0x11, 0x83, 0x8d, 0xf6, 0x24, 0x3d, 0x1e, 0x4e, 0x78, 0x9a, 0x8c, 0xe3, 0xe4, 0x67, 0xb2,
-	0x0b, 0xab, 0x2f, 0x04, 0x93, 0x20, 0xae, 0xed, 0x6f, 0xef, 0x4a, 0x3b, 0x8c, 0x30, 0xc5, 0xae,
-	0xa8, 0xc8, 0x1e, 0x54, 0xbb, 0x92, 0x3b, 0x5c, 0x12, 0x0c, 0x5b, 0xbb, 0xca, 0x3a, 0x25, 0x54,
-	0xd1, 0x6b, 0xaa, 0xe8, 0x7b, 0x4f, 0x23, 0x67, 0x73, 0x6b, 0xa4, 0xb0, 0x34, 0xe8, 0x09, 0x65,
-	0x2b, 0x2d, 0xc8, 0xbe, 0x5c, 0xbe, 0xbb, 0xb7, 0x74, 0xf6, 0x24, 0x5e, 0x1a, 0xf4, 0xa2, 0x1f,
-	0x61, 0x3b, 0x4e, 0x5e, 0x8e, 0x5e, 0x25, 0xd7, 0xb8, 0xd1, 0x55, 0xf2, 0x5b, 0x79, 0xf9, 0xf3,
-	0xdb, 0x9f, 0x39, 0xe0, 0x34, 0x49, 0x17, 0x64, 0x20, 0xf3, 0x84, 0x23, 0xbc, 0x3b, 0x5b, 0x3c,
-	0x03, 0x1b, 0xcf, 0x63, 0xb8, 0x6d, 0x35, 0x7e, 0x3d, 0xe0, 0x29, 0xe2, 0x4a, 0xd1, 0x10, 0xea,
-	0xed, 0x49, 0xfa, 0x34, 0x19, 0xbf, 0xc2, 0xb9, 0xe4, 0x13, 0x58, 0xe5, 0x82, 0x59, 0x19, 0xbd,
-	0xa9, 0x8d, 0x96, 0x22, 0x35, 0xb5, 0xa4, 0x89, 0xce, 0x5d, 0x6d, 0x37, 0x9c, 0x81, 0x3f, 0xc0,
-	0x96, 0xcc, 0x10, 0xfc, 0x65, 0xae, 0x12, 0xdf, 0xcc, 0x89, 0x47, 0xe4, 0xdf, 0x39, 0xd4, 0x4f,
-	0x93, 0x74, 0x31, 0xe6, 0x0d, 0x5d, 0xd9, 0x08, 0xcf, 0xce, 0x12, 0xc7, 0xc0, 0xc4, 0xb1, 0x05,
-	0xdb, 0x46, 0x1b, 0x36, 0xf3, 0xfa, 0xb0, 0xde, 0x9e, 0xa4, 0xcd, 0xf6, 0x19, 0xc6, 0x15, 0x0f,
-	0x60, 0xb9, 0xc3, 0x06, 0xca, 0xd6, 0x9a, 0xb6, 0xb5, 0xd9, 0x3e, 0x6b, 0xd5, 0x94, 0x63, 0x96,
-	0x33, 0xc9, 0x19, 0x51, 0xf4, 0xcc, 0x28, 0xba, 0xe1, 0x8c, 0x3b, 0x87, 0xba, 0x4c, 0x09, 0xe4,
-	0x25, 0xae, 0x92, 0xfd, 0xa5, 0x2b, 0x1b, 0x91, 0x6b, 0xcf, 0x60, 0xfd, 0x34, 0x49, 0x17, 0x60,
-	0x58, 0xdf, 0x08, 0x46, 0x78, 0xf3, 0xea, 0xb0, 0x05, 0x5e, 0xd8, 0x8e, 0x44, 0x41, 0x6d, 0xb6,
-	0xcf, 0xb0, 0x09, 0x26, 0x3b, 0x6c, 0x3c, 0x9a, 0xa4, 0x83, 0x8b, 0x3e, 0xb2, 0xc3, 0x8e, 0x25,
-	0x77, 0xbe, 0x22, 0x2b, 0xa1, 0xba, 0xc3, 0x2a, 0x2a, 0xd5, 0x61, 0xb5, 0xc6, 0x45, 0x75, 0xd8,
-	0x6b, 0xdc, 0x68, 0xa6, 0x0e, 0x8b, 0xb7, 0x5f, 0x75, 0xd8, 0x05, 0x19, 0xc8, 0x3c, 0xe1, 0xb8,
-	0x0e, 0x3b, 0x43, 0x3c, 0x03, 0x1b, 0x4f, 0xd9, 0x61, 0xd5, 0x47, 0x6c, 0x1a, 0xfe, 0x0a, 0xd0,
-	0xec, 0xf5, 0x5a, 0x83, 0x8b, 0x1e, 0xc6, 0x21, 0x0d, 0x7f, 0x2a, 0x58, 0xc9, 0x0d, 0x75, 0xe4,
-	0x03, 0x53, 0xb7, 0x97, 0x9d, 0xcf, 0xba, 0x4e, 0x1f, 0x5a, 0xdd, 0x88, 0x68, 0xfe, 0x06, 0x1b,
-	0x32, 0x23, 0xfe, 0x1f, 0xe3, 0x8f, 0x3c, 0xf5, 0x08, 0xfb, 0x7f, 0x82, 0x1d, 0x6f, 0x66, 0x5c,
-	0xd0, 0x35, 0xa2, 0x93, 0x22, 0x3d, 0x08, 0x7b, 0xbb, 0x22, 0xdd, 0x32, 0x6e, 0xd9, 0x59, 0xf9,
-	0x22, 0x8c, 0x9d, 0x56, 0x82, 0x40, 0x52, 0x03, 0xaa, 0x32, 0x48, 0x3c, 0x5c, 0xba, 0xbf, 0xac,
-	0x94, 0x04, 0xb1, 0x3e, 0x54, 0x53, 0x65, 0x7b, 0x38, 0xe9, 0x0f, 0x2e, 0x90, 0x53, 0x25, 0x13,
-	0xcc, 0xf9, 0x69, 0x44, 0x8a, 0xd4, 0xd4, 0x92, 0x46, 0x4d, 0x95, 0x4a, 0xdb, 0xa2, 0xa6, 0x4a,
-	0xfc, 0x65, 0x66, 0x9a, 0x2a, 0xd1, 0xd6, 0xab, 0xa9, 0x72, 0x31, 0xe6, 0x0d, 0x5d, 0xd9, 0xb8,
-	0xa9, 0xf2, 0x3f, 0xe3, 0x18, 0x98, 0x38, 0xca, 0xa9, 0x52, 0x7e, 0xc2, 0x56, 0xdb, 0xd7, 0xb0,
-	0xd5, 0x64, 0x6c, 0xf8, 0x5a, 0x4a, 0x41, 0x21, 0xe8, 0x73, 0xa8, 0x76, 0x18, 0x1b, 0x0e, 0x92,
-	0x9e, 0xb2, 0xfa, 0xae, 0x19, 0x54, 0xe4, 0xb1, 0x92, 0xad, 0x91, 0xa5, 0x88, 0xb3, 0x58, 0x7a,
-	0xaa, 0x11, 0xb1, 0x3c, 0x81, 0x9d, 0x6c, 0xe6, 0xf1, 0xd4, 0x60, 0xbc, 0xf0, 0x4b, 0x91, 0x1c,
-	0x44, 0xfc, 0x1e, 0xce, 0xe8, 0x0a, 0xeb, 0x84, 0x03, 0x58, 0x3b, 0x1e, 0x26, 0x1d, 0x4c, 0x26,
-	0x5a, 0x5e, 0x84, 0xe7, 0xbe, 0x83, 0xb5, 0xa7, 0x49, 0x7a, 0xf6, 0xe4, 0xa6, 0x11, 0x70, 0xa0,
-	0xe5, 0x22, 0x6c, 0xfa, 0x7d, 0x15, 0xd6, 0x5a, 0x9d, 0xb4, 0xfb, 0x02, 0x97, 0x85, 0x35, 0x66,
-	0xb6, 0x35, 0xb2, 0xcc, 0xd6, 0xf6, 0x77, 0x14, 0x93, 0xb7, 0x39, 0x8a, 0x5d, 0x42, 0x72, 0x04,
-	0x9b, 0x63, 0xb7, 0x19, 0xf1, 0x70, 0x59, 0xb0, 0xbe, 0xa7, 0xf5, 0xe5, 0xb6, 0x34, 0x71, 0x8e,
-	0x9c, 0x3c, 0x02, 0x60, 0xfa, 0x8d, 0xce, 0xc3, 0x15, 0xc1, 0x7c, 0xc7, 0xea, 0x35, 0xcf, 0xd7,
-	0xd8, 0x21, 0x23, 0x87, 0xb0, 0x31, 0x76, 0x5e, 0xc7, 0x3c, 0xbc, 0x25, 0xf8, 0xee, 0x7a, 0x4a,
-	0x2d, 0xab, 0x4f, 0x4c, 0x1e, 0x40, 0x95, 0x89, 0x17, 0x1a, 0x0f, 0x57, 0x05, 0xdf, 0xb6, 0xd5,
-	0x27, 0x9f, 0x30, 0xb1, 0x26, 0xc8, 0xcc, 0x1b, 0xeb, 0x87, 0x11, 0x0f, 0xab, 0x9e, 0x79, 0xee,
-	0x6b, 0x2c, 0x76, 0xc8, 0x94, 0x33, 0xd5, 0x20, 0xc7, 0xc3, 0xb5, 0xbc, 0x33, 0xed, 0xc4, 0x1a,
-	0xbb, 0x84, 0xd6, 0x99, 0x86, 0x75, 0xbd, 0xc0, 0x99, 0x0e, 0x77, 0x8e, 0x9c, 0x7c, 0x0a, 0x6b,
-	0x1d, 0x39, 0x80, 0xf1, 0x10, 0x04, 0xeb, 0x6d, 0xc5, 0x6a, 0x67, 0xc2, 0xd8, 0x90, 0x64, 0x76,
-	0x8e, 0xcd, 0xc8, 0xc3, 0xc3, 0x9a, 0x67, 0xa7, 0x37, 0x8b, 0xc5, 0x2e, 0xa1, 0x8a, 0x99, 0x02,
-	0x63, 0x58, 0xcf, 0xc7, 0xcc, 0x34, 0x87, 0xd8, 0x21, 0xb3, 0x31, 0xd3, 0x7c, 0x1b, 0x05, 0x31,
-	0xb3, 0xac, 0x3e, 0x31, 0x39, 0x80, 0x7a, 0xc7, 0xa9, 0x76, 0xe1, 0xa6, 0xaa, 0x0f, 0xea, 0x76,
-	0x7e, 0x0d, 0x8e, 0x3d, 0x5a, 0x07, 0x18, 0x88, 0x9a, 0x34, 0x2b, 0x30, 0x38, 0xbb, 0x0e, 0x30,
-	0x38, 0x43, 0x02, 0x83, 0x33, 0x34, 0x30, 0x38, 0x9b, 0x17, 0x18, 0x9c, 0xcd, 0x0b, 0x8c, 0xcc,
-	0x3c, 0x04, 0x30, 0x94, 0x33, 0x91, 0xc0, 0xb0, 0xce, 0x9c, 0x03, 0x18, 0x9c, 0x21, 0x80, 0x91,
-	0xd9, 0x39, 0x3f, 0x30, 0x54, 0xcc, 0x30, 0xc0, 0xb0, 0x31, 0x43, 0x00, 0x83, 0x33, 0x1f, 0x18,
-	0xfb, 0x7f, 0x6e, 0x40, 0xed, 0x9b, 0x24, 0xed, 0x64, 0xf1, 0x1f, 0x74, 0x13, 0x72, 0x00, 0x60,
-	0x33, 0x9a, 0x14, 0x56, 0x7f, 0x5a, 0x98, 0xfa, 0x51, 0x85, 0x1c, 0xeb, 0xe7, 0x93, 0x66, 0x2f,
-	0xeb, 0x00, 0xb4, 0x0c, 0x01, 0x51, 0x25, 0x33, 0xc0, 0xee, 0x98, 0x8d, 0x01, 0xde, 0x16, 0x9d,
-	0x16, 0x9c, 0x0a, 0xde, 0x23, 0xd8, 0xf4, 0xf7, 0xd3, 0x24, 0x9c, 0xa2, 0x54, 0x63, 0x1e, 0xcd,
-	0xef, 0xba, 0xa3, 0xca, 0xc3, 0x80, 0x7c, 0x21, 0x16, 0x77, 0x12, 0x0b, 0xa4, 0xa8, 0x05, 0xd1,
-	0x22, 0xf8, 0x45, 0x15, 0xf2, 0x58, 0x2f, 0xcf, 0x14, 0x6f, 0x49, 0x1b, 0xa2, 0x25, 0x28, 0x8c,
-	0x2a, 0x99, 0x6a, 0xb3, 0xe0, 0x34, 0xaa, 0xdd, 0xe5, 0x2d, 0x9d, 0x3e, 0x14, 0x8c, 0x87, 0x62,
-	0x49, 0x61, 0x37, 0xa3, 0xc6, 0xeb, 0xf9, 0x7d, 0x29, 0xcd, 0x6d, 0x58, 0xc5, 0x8d, 0x77, 0x61,
-	0x55, 0x22, 0x9b, 0x4c, 0x75, 0x40, 0x3a, 0x05, 0x7d, 0x69, 0xa6, 0x81, 0x36, 0x29, 0xea, 0x82,
-	0xb4, 0xa8, 0x02, 0x44, 0x95, 0x4c, 0x91, 0x5c, 0xae, 0x19, 0x45, 0x66, 0x5b, 0x48, 0x73, 0x27,
-	0x82, 0xfe, 0x91, 0xc8, 0x03, 0xb5, 0x8c, 0x73, 0xf3, 0xc0, 0xee, 0xe7, 0xa8, 0xbb, 0xcf, 0x13,
-	0xb7, 0x91, 0xd9, 0xab, 0x20, 0x4f, 0x0a, 0xdb, 0x2d, 0x2d, 0xac, 0x35, 0x6e, 0xf6, 0x6a, 0xf6,
-	0xb2, 0x96, 0x4b, 0xcb, 0x4a, 0x8e, 0xc9, 0xde, 0xbc, 0x01, 0xde, 0x86, 0x8a, 0x16, 0x9c, 0x3a,
-	0xd9, 0xeb, 0xec, 0x7e, 0xdc, 0xec, 0xf5, 0x57, 0x42, 0x34, 0xbf, 0x47, 0x12, 0xb7, 0xff, 0x0c,
-	0xaa, 0xaa, 0x94, 0x91, 0xe9, 0x9e, 0x4f, 0xa7, 0xab, 0x9d, 0xb4, 0xd7, 0xd6, 0x32, 0x52, 0xd8,
-	0xf7, 0x69, 0x61, 0xd1, 0x8b, 0x2a, 0xe4, 0x5b, 0xb8, 0x3d, 0xb5, 0x84, 0x20, 0xef, 0x17, 0x21,
-	0x5b, 0x4b, 0x2a, 0xff, 0x28, 0x04, 0x9e, 0x08, 0x07, 0x38, 0x8b, 0x02, 0xd7, 0x01, 0xfe, 0x92,
-	0x82, 0x96, 0x7c, 0xd1, 0x39, 0x6a, 0x2a, 0x2d, 0x29, 0x1a, 0x4a, 0x68, 0x51, 0x41, 0x76, 0x51,
-	0xac, 0x78, 0x4b, 0x06, 0x13, 0x5a, 0x52, 0x97, 0x0d, 0x8a, 0x73, 0xaa, 0xdd, 0xc7, 0x32, 0x9d,
-	0x3e, 0x74, 0x50, 0x6c, 0x5f, 0xa2, 0x2e, 0x8a, 0xbd, 0xf7, 0x29, 0xcd, 0xbd, 0x68, 0x45, 0xe4,
-	0x1f, 0x43, 0xdd, 0x2d, 0xf3, 0xa4, 0x64, 0x28, 0xa2, 0x25, 0x3d, 0x41, 0x06, 0x73, 0xea, 0xfd,
-	0x66, 0x82, 0x59, 0xf4, 0x42, 0xa4, 0xe5, 0x1f, 0x85, 0xc0, 0x8f, 0xe1, 0x96, 0x78, 0x5a, 0x91,
-	0x2d, 0x45, 0xa7, 0x1f, 0x69, 0xd4, 0x3f, 0xd0, 0xc4, 0xe2, 0xcd, 0x63, 0x88, 0xf5, 0xcb, 0x8a,
-	0xfa, 0x07, 0x9a, 0x58, 0x8c, 0x72, 0x86, 0x58, 0xbf, 0x78, 0xa8, 0x7f, 0x90, 0x11, 0xb7, 0x76,
-	0xde, 0xfe, 0xdd, 0xa8, 0xbc, 0xb9, 0x6c, 0x04, 0x6f, 0x2f, 0x1b, 0xc1, 0x5f, 0x97, 0x8d, 0xe0,
-	0x8f, 0x7f, 0x1a, 0x95, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x05, 0x97, 0xa5, 0x1b, 0x71, 0x1f,
-	0x00, 0x00,
+var xxx_messageInfo_PurgeCacheReq proto.InternalMessageInfo
+
+func (m *PurgeCacheReq) GetHeader() RpcHeader {
+	if m != nil {
+		return m.Header
+	}
+	return RpcHeader{}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *PurgeCacheReq) GetAPI() uint64 {
+	if m != nil {
+		return m.API
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *PurgeCacheReq) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
 
-// MetaServiceClient is the client API for MetaService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MetaServiceClient interface {
-	PutCluster(ctx context.Context, in *PutClusterReq, opts ...grpc.CallOption) (*PutClusterRsp, error)
-	RemoveCluster(ctx context.Context, in *RemoveClusterReq, opts ...grpc.CallOption) (*RemoveClusterRsp, error)
-	GetCluster(ctx context.Context, in *GetClusterReq, opts ...grpc.CallOption) (*GetClusterRsp, error)
-	GetClusterList(ctx context.Context, in *GetClusterListReq, opts ...grpc.CallOption) (MetaService_GetClusterListClient, error)
-	PutServer(ctx context.Context, in *PutServerReq, opts ...grpc.CallOption) (*PutServerRsp, error)
-	RemoveServer(ctx context.Context, in *RemoveServerReq, opts ...grpc.CallOption) (*RemoveServerRsp, error)
-	GetServer(ctx context.Context, in *GetServerReq, opts ...grpc.CallOption) (*GetServerRsp, error)
-	GetServerList(ctx context.Context, in *GetServerListReq, opts ...grpc.CallOption) (MetaService_GetServerListClient, error)
-	PutAPI(ctx context.Context, in *PutAPIReq, opts ...grpc.CallOption) (*PutAPIRsp, error)
-	RemoveAPI(ctx context.Context, in *RemoveAPIReq, opts ...grpc.CallOption) (*RemoveAPIRsp, error)
-	GetAPI(ctx context.Context, in *GetAPIReq, opts ...grpc.CallOption) (*GetAPIRsp, error)
-	GetAPIList(ctx context.Context, in *GetAPIListReq, opts ...grpc.CallOption) (MetaService_GetAPIListClient, error)
-	PutRouting(ctx context.Context, in *PutRoutingReq, opts ...grpc.CallOption) (*PutRoutingRsp, error)
+func (m *PurgeCacheReq) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *PurgeCacheReq) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type PurgeCacheRsp struct {
+	Header               RpcHeader          `protobuf:"bytes,1,opt,name=header" json:"header"`
+	Results              []PurgeCacheResult `protobuf:"bytes,2,rep,name=results" json:"results"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PurgeCacheRsp) Reset()         { *m = PurgeCacheRsp{} }
+func (m *PurgeCacheRsp) String() string { return proto.CompactTextString(m) }
+func (*PurgeCacheRsp) ProtoMessage()    {}
+func (*PurgeCacheRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{59}
+}
+func (m *PurgeCacheRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeCacheRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeCacheRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeCacheRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeCacheRsp.Merge(m, src)
+}
+func (m *PurgeCacheRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeCacheRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeCacheRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeCacheRsp proto.InternalMessageInfo
+
+func (m *PurgeCacheRsp) GetHeader() RpcHeader {
+	if m != nil {
+		return m.Header
+	}
+	return RpcHeader{}
+}
+
+func (m *PurgeCacheRsp) GetResults() []PurgeCacheResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// PurgeCacheResult the purge result of a proxy
+type PurgeCacheResult struct {
+	Proxy                string   `protobuf:"bytes,1,opt,name=proxy" json:"proxy"`
+	Removed              int64    `protobuf:"varint,2,opt,name=removed" json:"removed"`
+	Error                string   `protobuf:"bytes,3,opt,name=error" json:"error"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeCacheResult) Reset()         { *m = PurgeCacheResult{} }
+func (m *PurgeCacheResult) String() string { return proto.CompactTextString(m) }
+func (*PurgeCacheResult) ProtoMessage()    {}
+func (*PurgeCacheResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{60}
+}
+func (m *PurgeCacheResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeCacheResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeCacheResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeCacheResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeCacheResult.Merge(m, src)
+}
+func (m *PurgeCacheResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeCacheResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeCacheResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeCacheResult proto.InternalMessageInfo
+
+func (m *PurgeCacheResult) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *PurgeCacheResult) GetRemoved() int64 {
+	if m != nil {
+		return m.Removed
+	}
+	return 0
+}
+
+func (m *PurgeCacheResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RpcHeader)(nil), "rpcpb.RpcHeader")
+	proto.RegisterType((*PutClusterReq)(nil), "rpcpb.PutClusterReq")
+	proto.RegisterType((*PutClusterRsp)(nil), "rpcpb.PutClusterRsp")
+	proto.RegisterType((*RemoveClusterReq)(nil), "rpcpb.RemoveClusterReq")
+	proto.RegisterType((*RemoveClusterRsp)(nil), "rpcpb.RemoveClusterRsp")
+	proto.RegisterType((*GetClusterReq)(nil), "rpcpb.GetClusterReq")
+	proto.RegisterType((*GetClusterRsp)(nil), "rpcpb.GetClusterRsp")
+	proto.RegisterType((*GetClusterListReq)(nil), "rpcpb.GetClusterListReq")
+	proto.RegisterType((*PutServerReq)(nil), "rpcpb.PutServerReq")
+	proto.RegisterType((*PutServerRsp)(nil), "rpcpb.PutServerRsp")
+	proto.RegisterType((*RemoveServerReq)(nil), "rpcpb.RemoveServerReq")
+	proto.RegisterType((*RemoveServerRsp)(nil), "rpcpb.RemoveServerRsp")
+	proto.RegisterType((*GetServerReq)(nil), "rpcpb.GetServerReq")
+	proto.RegisterType((*GetServerRsp)(nil), "rpcpb.GetServerRsp")
+	proto.RegisterType((*GetServerListReq)(nil), "rpcpb.GetServerListReq")
+	proto.RegisterType((*PutAPIReq)(nil), "rpcpb.PutAPIReq")
+	proto.RegisterType((*PutAPIRsp)(nil), "rpcpb.PutAPIRsp")
+	proto.RegisterType((*RemoveAPIReq)(nil), "rpcpb.RemoveAPIReq")
+	proto.RegisterType((*RemoveAPIRsp)(nil), "rpcpb.RemoveAPIRsp")
+	proto.RegisterType((*GetAPIReq)(nil), "rpcpb.GetAPIReq")
+	proto.RegisterType((*GetAPIRsp)(nil), "rpcpb.GetAPIRsp")
+	proto.RegisterType((*GetAPIListReq)(nil), "rpcpb.GetAPIListReq")
+	proto.RegisterType((*PutRoutingReq)(nil), "rpcpb.PutRoutingReq")
+	proto.RegisterType((*PutRoutingRsp)(nil), "rpcpb.PutRoutingRsp")
+	proto.RegisterType((*RemoveRoutingReq)(nil), "rpcpb.RemoveRoutingReq")
+	proto.RegisterType((*RemoveRoutingRsp)(nil), "rpcpb.RemoveRoutingRsp")
+	proto.RegisterType((*GetRoutingReq)(nil), "rpcpb.GetRoutingReq")
+	proto.RegisterType((*GetRoutingRsp)(nil), "rpcpb.GetRoutingRsp")
+	proto.RegisterType((*GetRoutingListReq)(nil), "rpcpb.GetRoutingListReq")
+	proto.RegisterType((*AddBindReq)(nil), "rpcpb.AddBindReq")
+	proto.RegisterType((*AddBindRsp)(nil), "rpcpb.AddBindRsp")
+	proto.RegisterType((*RemoveBindReq)(nil), "rpcpb.RemoveBindReq")
+	proto.RegisterType((*RemoveBindRsp)(nil), "rpcpb.RemoveBindRsp")
+	proto.RegisterType((*RemoveClusterBindReq)(nil), "rpcpb.RemoveClusterBindReq")
+	proto.RegisterType((*RemoveClusterBindRsp)(nil), "rpcpb.RemoveClusterBindRsp")
+	proto.RegisterType((*GetBindServersReq)(nil), "rpcpb.GetBindServersReq")
+	proto.RegisterType((*GetBindServersRsp)(nil), "rpcpb.GetBindServersRsp")
+	proto.RegisterType((*PutPluginReq)(nil), "rpcpb.PutPluginReq")
+	proto.RegisterType((*PutPluginRsp)(nil), "rpcpb.PutPluginRsp")
+	proto.RegisterType((*RemovePluginReq)(nil), "rpcpb.RemovePluginReq")
+	proto.RegisterType((*RemovePluginRsp)(nil), "rpcpb.RemovePluginRsp")
+	proto.RegisterType((*GetPluginReq)(nil), "rpcpb.GetPluginReq")
+	proto.RegisterType((*GetPluginRsp)(nil), "rpcpb.GetPluginRsp")
+	proto.RegisterType((*GetPluginListReq)(nil), "rpcpb.GetPluginListReq")
+	proto.RegisterType((*ApplyPluginsReq)(nil), "rpcpb.ApplyPluginsReq")
+	proto.RegisterType((*ApplyPluginsRsp)(nil), "rpcpb.ApplyPluginsRsp")
+	proto.RegisterType((*GetAppliedPluginsReq)(nil), "rpcpb.GetAppliedPluginsReq")
+	proto.RegisterType((*GetAppliedPluginsRsp)(nil), "rpcpb.GetAppliedPluginsRsp")
+	proto.RegisterType((*PutIPAccessControlReq)(nil), "rpcpb.PutIPAccessControlReq")
+	proto.RegisterType((*PutIPAccessControlRsp)(nil), "rpcpb.PutIPAccessControlRsp")
+	proto.RegisterType((*GetIPAccessControlReq)(nil), "rpcpb.GetIPAccessControlReq")
+	proto.RegisterType((*GetIPAccessControlRsp)(nil), "rpcpb.GetIPAccessControlRsp")
+	proto.RegisterType((*CleanReq)(nil), "rpcpb.CleanReq")
+	proto.RegisterType((*CleanRsp)(nil), "rpcpb.CleanRsp")
+	proto.RegisterType((*SetIDReq)(nil), "rpcpb.SetIDReq")
+	proto.RegisterType((*SetIDRsp)(nil), "rpcpb.SetIDRsp")
+	proto.RegisterType((*BatchReq)(nil), "rpcpb.BatchReq")
+	proto.RegisterType((*BatchRsp)(nil), "rpcpb.BatchRsp")
+	proto.RegisterType((*PurgeCacheReq)(nil), "rpcpb.PurgeCacheReq")
+	proto.RegisterType((*PurgeCacheRsp)(nil), "rpcpb.PurgeCacheRsp")
+	proto.RegisterType((*PurgeCacheResult)(nil), "rpcpb.PurgeCacheResult")
+}
+
+func init() { proto.RegisterFile("rpcpb.proto", fileDescriptor_25e491924c678914) }
+
+var fileDescriptor_25e491924c678914 = []byte{
+	// 1635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0xeb, 0x6e, 0x1b, 0x45,
+	0xd7, 0x1b, 0xe7, 0x7a, 0x9c, 0x34, 0xe9, 0x34, 0x6d, 0x56, 0xf3, 0xf5, 0x73, 0xa3, 0x95, 0x90,
+	0x50, 0xa1, 0x69, 0x69, 0x25, 0x2a, 0x45, 0x15, 0xad, 0x9d, 0xaa, 0x4b, 0x24, 0x10, 0xd6, 0x56,
+	0xa2, 0x52, 0x11, 0x48, 0xae, 0x3d, 0x75, 0x0d, 0x6e, 0x3c, 0xec, 0xac, 0xa3, 0x04, 0xc1, 0x6b,
+	0x20, 0x1e, 0x82, 0x97, 0xe0, 0x5f, 0x7f, 0xf6, 0x09, 0x2a, 0x08, 0x2f, 0x82, 0x76, 0xee, 0xb3,
+	0xde, 0x0d, 0xc9, 0x34, 0x16, 0xbf, 0xe2, 0x9c, 0x39, 0xb7, 0x39, 0xf7, 0x39, 0x0b, 0x8d, 0x94,
+	0xf6, 0xe8, 0x8b, 0x1d, 0x9a, 0x8e, 0xb3, 0x31, 0x5a, 0xe0, 0xff, 0xe0, 0x2b, 0xaf, 0x49, 0xd6,
+	0xa5, 0x2f, 0x6e, 0x8b, 0x3f, 0xe2, 0x0c, 0x6f, 0x0e, 0xc6, 0x83, 0x31, 0xff, 0x79, 0x3b, 0xff,
+	0x25, 0xa0, 0xd1, 0x07, 0xb0, 0x92, 0xd0, 0xde, 0xe7, 0xa4, 0xdb, 0x27, 0x29, 0x0a, 0x61, 0x7e,
+	0x32, 0x19, 0xf6, 0xc3, 0x60, 0x3b, 0xf8, 0x70, 0xa5, 0x3d, 0xff, 0xe6, 0xdd, 0x8d, 0x5a, 0xc2,
+	0x21, 0x11, 0x85, 0xb5, 0xce, 0x24, 0xdb, 0x1b, 0x4d, 0x58, 0x46, 0xd2, 0x84, 0xfc, 0x88, 0x76,
+	0x60, 0xf1, 0x15, 0x27, 0xe2, 0xc8, 0x8d, 0xbb, 0x1b, 0x3b, 0x42, 0x0f, 0xcd, 0x4c, 0x92, 0x4b,
+	0x2c, 0x74, 0x1b, 0x96, 0x7a, 0x82, 0x3a, 0x9c, 0xe3, 0x04, 0xeb, 0x3b, 0x52, 0x3b, 0xc9, 0x54,
+	0xe2, 0x2b, 0xac, 0xe8, 0x1b, 0x47, 0x22, 0xa3, 0xe7, 0x96, 0x88, 0x61, 0x6e, 0xd8, 0xe7, 0xc2,
+	0xe6, 0xdb, 0x90, 0x9f, 0x9c, 0xbc, 0xbb, 0x31, 0xb7, 0xff, 0x38, 0x99, 0x1b, 0xf6, 0xa3, 0xef,
+	0x60, 0x23, 0x21, 0xaf, 0xc7, 0x87, 0xe4, 0x3d, 0x6e, 0x74, 0x1a, 0xff, 0x76, 0x91, 0xff, 0xf9,
+	0xf5, 0xcf, 0x0d, 0x10, 0x93, 0x6c, 0x46, 0x0a, 0x52, 0x87, 0x39, 0xa3, 0x33, 0xf2, 0x67, 0x60,
+	0xfc, 0xb9, 0x07, 0x97, 0x8d, 0xc4, 0x2f, 0x86, 0x2c, 0xf3, 0xb8, 0x52, 0x34, 0x82, 0xd5, 0xce,
+	0x24, 0x7b, 0x4a, 0xd2, 0x43, 0x3f, 0x93, 0x7c, 0x0c, 0x8b, 0x8c, 0x13, 0x4b, 0xa5, 0x2f, 0x29,
+	0xa5, 0x05, 0x4b, 0x85, 0x2d, 0x70, 0xa2, 0xe7, 0xb6, 0xb4, 0x0b, 0x8e, 0xc0, 0x6f, 0x61, 0x5d,
+	0x44, 0x88, 0xff, 0x65, 0x4e, 0x63, 0xdf, 0x2a, 0xb0, 0xf7, 0x88, 0xbf, 0xe7, 0xb0, 0x1a, 0x93,
+	0x6c, 0x36, 0xea, 0x8d, 0x6c, 0xde, 0x8c, 0xce, 0xc4, 0x8f, 0x81, 0xf6, 0x63, 0x1b, 0x36, 0xb4,
+	0x34, 0xdf, 0xc8, 0x1b, 0xc0, 0x4a, 0x67, 0x92, 0xb5, 0x3a, 0xfb, 0x3e, 0xa6, 0xb8, 0x09, 0xf5,
+	0x2e, 0x1d, 0x4a, 0x5d, 0x1b, 0x4a, 0xd7, 0x56, 0x67, 0xbf, 0xdd, 0x90, 0x86, 0xa9, 0xe7, 0x9c,
+	0x73, 0xa4, 0xe8, 0x99, 0x16, 0x74, 0xc1, 0x11, 0xf7, 0x1c, 0x56, 0x45, 0x48, 0x78, 0x5e, 0xe2,
+	0x34, 0xde, 0x9f, 0xd9, 0xbc, 0x3d, 0x62, 0xed, 0x19, 0xac, 0xc4, 0x24, 0x9b, 0x81, 0x62, 0x03,
+	0xcd, 0x98, 0xd1, 0x0b, 0x76, 0x5b, 0xe0, 0xb8, 0xed, 0x21, 0x2f, 0xa8, 0xad, 0xce, 0xbe, 0x6f,
+	0x80, 0x89, 0x0e, 0x9b, 0x8c, 0x27, 0xd9, 0xf0, 0x60, 0xe0, 0xd9, 0x61, 0x53, 0x41, 0x5d, 0xac,
+	0xc8, 0x92, 0xa9, 0xea, 0xb0, 0x12, 0x4b, 0x76, 0x58, 0x25, 0x71, 0x56, 0x1d, 0xf6, 0x3d, 0x6e,
+	0x74, 0xa6, 0x0e, 0xeb, 0xaf, 0xbf, 0xec, 0xb0, 0x33, 0x52, 0x90, 0x3a, 0xcc, 0xfd, 0x3a, 0xec,
+	0x19, 0xfc, 0x19, 0x18, 0x7f, 0x8a, 0x0e, 0x2b, 0x0f, 0x7d, 0xc3, 0xf0, 0x27, 0x80, 0x56, 0xbf,
+	0xdf, 0x1e, 0x1e, 0xf4, 0x7d, 0x0c, 0xd2, 0x74, 0xa7, 0x82, 0xf9, 0xc2, 0x50, 0x87, 0xae, 0xeb,
+	0xba, 0x5d, 0xb7, 0x8e, 0x55, 0x9d, 0x7e, 0x60, 0x64, 0x7b, 0x78, 0xf3, 0x17, 0x58, 0x13, 0x11,
+	0xf1, 0xdf, 0x28, 0xff, 0xd0, 0x11, 0xef, 0xa1, 0xff, 0x4b, 0xd8, 0x74, 0x66, 0xc6, 0x19, 0x5d,
+	0x23, 0x7a, 0x52, 0x26, 0xc7, 0x43, 0xdf, 0x1e, 0x0f, 0xb7, 0x9c, 0x5a, 0x74, 0x56, 0x36, 0x0b,
+	0x65, 0xa7, 0x85, 0x78, 0x64, 0x52, 0x13, 0x96, 0x84, 0x93, 0x58, 0x38, 0xb7, 0x5d, 0x97, 0x42,
+	0x82, 0x44, 0x01, 0xe5, 0x54, 0xd9, 0x19, 0x4d, 0x06, 0xc3, 0x03, 0xcf, 0xa9, 0x92, 0x72, 0xe2,
+	0xe2, 0x34, 0x22, 0x58, 0x2a, 0x6c, 0x81, 0x23, 0xa7, 0x4a, 0x29, 0x6d, 0x56, 0x53, 0xa5, 0xff,
+	0x65, 0xce, 0x34, 0x55, 0x7a, 0x6b, 0x2f, 0xa7, 0xca, 0xd9, 0xa8, 0x37, 0xb2, 0x79, 0xfb, 0x4d,
+	0x95, 0xff, 0xea, 0xc7, 0x40, 0xfb, 0x51, 0x4c, 0x95, 0xe2, 0xc8, 0xb7, 0xda, 0x1e, 0xc3, 0x7a,
+	0x8b, 0xd2, 0xd1, 0xb1, 0xe0, 0xe2, 0x95, 0x41, 0x9f, 0xc2, 0x52, 0x97, 0xd2, 0xd1, 0x90, 0xf4,
+	0xa5, 0xd6, 0xd7, 0xf4, 0xa0, 0x22, 0xc0, 0x92, 0xb7, 0xca, 0x2c, 0x89, 0x9c, 0xfb, 0xd2, 0x11,
+	0xed, 0xe1, 0xcb, 0x27, 0xb0, 0x99, 0xcf, 0x3c, 0x8e, 0x18, 0x1f, 0x2b, 0x1c, 0x95, 0xf1, 0xf1,
+	0xf0, 0xdf, 0x9d, 0x33, 0x9a, 0xc2, 0x18, 0xe1, 0x67, 0xb8, 0xda, 0x99, 0x64, 0xfb, 0x9d, 0x56,
+	0xaf, 0x47, 0x18, 0xdb, 0x1b, 0x1f, 0x64, 0xe9, 0x78, 0xe4, 0xe3, 0x85, 0x7b, 0xb0, 0x70, 0xd8,
+	0x1d, 0x4d, 0x88, 0x14, 0xbc, 0xa5, 0x04, 0x17, 0x58, 0x4b, 0x2a, 0x81, 0x1b, 0xc5, 0xa5, 0xd2,
+	0x3d, 0x1c, 0x11, 0xc3, 0xd5, 0x98, 0x5c, 0xc0, 0x35, 0xa2, 0xc3, 0x52, 0x46, 0x1e, 0xae, 0xb8,
+	0x75, 0x36, 0x7b, 0x28, 0x4b, 0xec, 0xc2, 0xf2, 0xde, 0x88, 0x74, 0x7d, 0x2a, 0x82, 0xa1, 0xf5,
+	0x30, 0xdc, 0xd7, 0xb0, 0xfc, 0x94, 0x64, 0xfb, 0x8f, 0x2f, 0xba, 0x12, 0xed, 0x2a, 0xbe, 0x1e,
+	0x3a, 0xfd, 0xba, 0x08, 0xcb, 0xed, 0x6e, 0xd6, 0x7b, 0xe5, 0x57, 0x0d, 0x1a, 0x54, 0x6f, 0xcd,
+	0x44, 0xbb, 0x6b, 0xdc, 0xdd, 0x94, 0x44, 0xce, 0x06, 0x2f, 0xb1, 0x11, 0xd1, 0x43, 0xb8, 0x94,
+	0xda, 0x43, 0x01, 0x0b, 0xeb, 0x9c, 0x74, 0x4b, 0xc9, 0x2b, 0x6c, 0xcb, 0x92, 0x02, 0x3a, 0xba,
+	0x07, 0x40, 0xd5, 0xae, 0x84, 0x85, 0xf3, 0x9c, 0xf8, 0x8a, 0x91, 0xab, 0xd7, 0x08, 0x89, 0x85,
+	0x86, 0x1e, 0xc0, 0x5a, 0x6a, 0x6d, 0x29, 0x58, 0xb8, 0xc0, 0xe9, 0xae, 0x39, 0x42, 0x0d, 0xa9,
+	0x8b, 0x8c, 0x6e, 0xc2, 0x12, 0xe5, 0x2f, 0x65, 0x16, 0x2e, 0x6e, 0xd7, 0x2d, 0xe3, 0xe8, 0x87,
+	0x7a, 0xa2, 0x10, 0x72, 0xf5, 0x52, 0xf5, 0x40, 0x65, 0xe1, 0x92, 0xa3, 0x9e, 0xfd, 0x2a, 0x4e,
+	0x2c, 0x34, 0x69, 0x4c, 0x39, 0x50, 0xb3, 0x70, 0xb9, 0x68, 0x4c, 0xf3, 0x72, 0x48, 0x6c, 0x44,
+	0x63, 0x4c, 0x4d, 0xba, 0x52, 0x62, 0x4c, 0x8b, 0xba, 0x80, 0x8e, 0x6e, 0xc1, 0x72, 0x57, 0x0c,
+	0xc2, 0x2c, 0x04, 0x4e, 0x7a, 0x59, 0x92, 0x9a, 0xd9, 0x3c, 0xd1, 0x28, 0xb9, 0x9e, 0xa9, 0x1e,
+	0x3d, 0x59, 0xd8, 0x70, 0xf4, 0x74, 0x66, 0xe2, 0xc4, 0x46, 0x94, 0x3e, 0x93, 0x45, 0x31, 0x5c,
+	0x2d, 0xfa, 0x4c, 0x37, 0xe9, 0xc4, 0x42, 0x33, 0x3e, 0x53, 0x74, 0x6b, 0x25, 0x3e, 0x33, 0xa4,
+	0x2e, 0x32, 0xda, 0x85, 0xd5, 0xae, 0xd5, 0x75, 0xc2, 0x4b, 0xdb, 0x81, 0x45, 0x5c, 0xe8, 0x85,
+	0x89, 0x83, 0x6b, 0x25, 0x86, 0x47, 0x41, 0x3a, 0x6b, 0x62, 0x30, 0xfa, 0x3e, 0x89, 0xc1, 0xa8,
+	0x67, 0x62, 0x30, 0xea, 0x9d, 0x18, 0x8c, 0x9e, 0x37, 0x31, 0x18, 0x3d, 0x6f, 0x62, 0xe4, 0xea,
+	0x79, 0x24, 0x86, 0x34, 0xa6, 0x67, 0x62, 0x18, 0x63, 0x9e, 0x23, 0x31, 0x18, 0xf5, 0x48, 0x8c,
+	0x5c, 0xcf, 0xf3, 0x27, 0x86, 0xf4, 0x99, 0x4f, 0x62, 0x18, 0x9f, 0x79, 0x24, 0x06, 0xa3, 0x85,
+	0xc4, 0xf8, 0x3d, 0xc8, 0x37, 0x39, 0xe9, 0x80, 0xec, 0x75, 0x7b, 0xaf, 0x88, 0x4f, 0xdb, 0xf8,
+	0xbf, 0xd9, 0x74, 0xcd, 0x4f, 0xef, 0x24, 0xd1, 0x35, 0xa8, 0xff, 0x40, 0x8e, 0xc3, 0xba, 0xf5,
+	0x59, 0x28, 0x07, 0xe4, 0xf0, 0xac, 0x3b, 0x08, 0xe7, 0x6d, 0x78, 0xd6, 0x1d, 0xe4, 0x2f, 0x65,
+	0x9a, 0x92, 0x97, 0xc3, 0xa3, 0x70, 0xc1, 0x3a, 0x92, 0xb0, 0xe8, 0xc8, 0xd1, 0xd6, 0x23, 0x97,
+	0xef, 0xc3, 0x52, 0x4a, 0xd8, 0x64, 0x94, 0xa9, 0x3c, 0xde, 0xd2, 0xbe, 0x31, 0x46, 0xc8, 0xcf,
+	0xf5, 0xc6, 0x4b, 0x60, 0x47, 0xdf, 0xc3, 0x46, 0x11, 0x05, 0x61, 0x58, 0xa0, 0xe9, 0xf8, 0xe8,
+	0xd8, 0xf9, 0xe8, 0x25, 0x40, 0xf9, 0xc3, 0x51, 0x78, 0x49, 0xf4, 0xf9, 0xba, 0xe1, 0xc7, 0x81,
+	0x39, 0x2d, 0x49, 0xd3, 0x71, 0xea, 0x58, 0x46, 0x80, 0xee, 0xfe, 0xb1, 0x0e, 0x8d, 0x2f, 0x49,
+	0xd6, 0xcd, 0x93, 0x72, 0xd8, 0x23, 0x68, 0x17, 0xc0, 0x94, 0x19, 0x54, 0xda, 0x92, 0x71, 0x69,
+	0x3d, 0x8a, 0x6a, 0x68, 0x4f, 0xed, 0x16, 0x14, 0x79, 0x55, 0x5b, 0xc6, 0x55, 0x65, 0x29, 0xaa,
+	0xe5, 0x0a, 0xc4, 0x64, 0x4a, 0x81, 0x98, 0x94, 0x29, 0xe0, 0x7c, 0x1b, 0x8a, 0x6a, 0x79, 0xe2,
+	0xba, 0x1f, 0x6f, 0x50, 0x38, 0x85, 0x29, 0xdf, 0x40, 0xb8, 0xf8, 0x21, 0x28, 0xaa, 0xdd, 0x09,
+	0xd0, 0x7d, 0xbe, 0xd5, 0x16, 0x05, 0x0a, 0x95, 0xcd, 0x05, 0xb8, 0xac, 0x26, 0x46, 0x35, 0xf4,
+	0x48, 0x6d, 0x96, 0x25, 0x6d, 0xc5, 0x6c, 0x80, 0x2b, 0x4a, 0x63, 0x54, 0xcb, 0x45, 0xc7, 0xa4,
+	0x28, 0x3a, 0x26, 0x25, 0xa2, 0x0d, 0x90, 0x13, 0x3e, 0x80, 0x35, 0x0d, 0xe1, 0x77, 0xde, 0x2a,
+	0xe2, 0xa9, 0x2b, 0x17, 0x3e, 0x3f, 0xf0, 0x1b, 0xef, 0xc0, 0xa2, 0x28, 0xb7, 0x68, 0x6a, 0x2c,
+	0xc1, 0x53, 0xf5, 0x58, 0xa8, 0xa9, 0xeb, 0x2d, 0x2a, 0x1b, 0x4d, 0x70, 0x59, 0x59, 0x8e, 0x6a,
+	0xb9, 0xa0, 0x98, 0x38, 0x82, 0x62, 0x52, 0x14, 0xa4, 0x77, 0xe0, 0x51, 0x2d, 0x2f, 0x6e, 0x66,
+	0x53, 0x6d, 0xc7, 0x81, 0x59, 0x5e, 0x63, 0x7b, 0xd9, 0xcd, 0x6f, 0x23, 0xa2, 0x57, 0xd6, 0x61,
+	0x54, 0x3a, 0x03, 0xe1, 0xd2, 0x06, 0x60, 0x47, 0xaf, 0x22, 0xaf, 0x9a, 0x83, 0x70, 0x55, 0x1f,
+	0xd0, 0xd1, 0x5b, 0x54, 0x20, 0x26, 0x65, 0x0a, 0x38, 0x7b, 0x57, 0x1d, 0xbd, 0xd6, 0x62, 0xd4,
+	0x8e, 0x5e, 0x77, 0x5f, 0x8a, 0x8b, 0x4b, 0x56, 0x7e, 0xfb, 0x4f, 0x60, 0x49, 0xf6, 0x17, 0x34,
+	0x3d, 0x88, 0xe1, 0xe9, 0x16, 0x24, 0xf4, 0x35, 0x0d, 0x06, 0x95, 0x0e, 0x63, 0xb8, 0xb4, 0x13,
+	0x45, 0x35, 0xf4, 0x15, 0x5c, 0x9e, 0xda, 0xd0, 0xa1, 0xff, 0x95, 0x65, 0xb6, 0xe2, 0x54, 0x7d,
+	0xc8, 0x19, 0x3e, 0xe1, 0x06, 0xb0, 0xb6, 0x68, 0xb6, 0x01, 0xdc, 0x0d, 0x1e, 0xae, 0x38, 0x51,
+	0x31, 0xaa, 0xdb, 0x1f, 0x2a, 0x9b, 0x14, 0x71, 0x59, 0x97, 0xb4, 0xb3, 0x58, 0xd2, 0x56, 0x4c,
+	0x8b, 0xb8, 0xa2, 0x59, 0xea, 0x2c, 0x2e, 0x88, 0x8e, 0x49, 0x89, 0x68, 0x03, 0xb4, 0xb2, 0xd8,
+	0xac, 0x69, 0xec, 0x2c, 0x76, 0x96, 0x37, 0xb8, 0xb0, 0xee, 0xe1, 0x9e, 0x7f, 0x04, 0xab, 0x76,
+	0xef, 0x45, 0x15, 0x93, 0x2a, 0xae, 0x68, 0xd4, 0xc2, 0x99, 0x53, 0xcb, 0x0d, 0xed, 0xcc, 0xb2,
+	0xf5, 0x09, 0xae, 0x3e, 0xe4, 0x0c, 0x13, 0x40, 0xd3, 0x5b, 0x03, 0x74, 0xdd, 0x18, 0x7e, 0x7a,
+	0x0f, 0x80, 0x4f, 0x39, 0x55, 0x3c, 0x63, 0x52, 0xc9, 0x33, 0x26, 0xa7, 0xf1, 0x2c, 0x5d, 0x18,
+	0x44, 0x35, 0xf4, 0x11, 0x2c, 0xf0, 0x77, 0x39, 0x5a, 0x97, 0x88, 0xea, 0x85, 0x8f, 0x5d, 0x80,
+	0x42, 0xe6, 0x0f, 0x66, 0x8d, 0xac, 0x9e, 0xe5, 0xd8, 0x05, 0x28, 0x64, 0xfe, 0x0e, 0xd0, 0xc8,
+	0xea, 0xb9, 0x8c, 0x5d, 0x80, 0x4a, 0x44, 0xd3, 0xf3, 0xad, 0xca, 0x65, 0x8d, 0x4b, 0xb8, 0x04,
+	0x9a, 0xd3, 0xb6, 0x37, 0xdf, 0xfe, 0xd5, 0xac, 0xbd, 0x39, 0x69, 0x06, 0x6f, 0x4f, 0x9a, 0xc1,
+	0x9f, 0x27, 0xcd, 0xe0, 0xb7, 0xbf, 0x9b, 0xb5, 0x7f, 0x06, 0x00, 0x88, 0x89, 0x6a, 0xdb, 0x72,
+	0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MetaServiceClient is the client API for MetaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MetaServiceClient interface {
+	PutCluster(ctx context.Context, in *PutClusterReq, opts ...grpc.CallOption) (*PutClusterRsp, error)
+	RemoveCluster(ctx context.Context, in *RemoveClusterReq, opts ...grpc.CallOption) (*RemoveClusterRsp, error)
+	GetCluster(ctx context.Context, in *GetClusterReq, opts ...grpc.CallOption) (*GetClusterRsp, error)
+	GetClusterList(ctx context.Context, in *GetClusterListReq, opts ...grpc.CallOption) (MetaService_GetClusterListClient, error)
+	PutServer(ctx context.Context, in *PutServerReq, opts ...grpc.CallOption) (*PutServerRsp, error)
+	RemoveServer(ctx context.Context, in *RemoveServerReq, opts ...grpc.CallOption) (*RemoveServerRsp, error)
+	GetServer(ctx context.Context, in *GetServerReq, opts ...grpc.CallOption) (*GetServerRsp, error)
+	GetServerList(ctx context.Context, in *GetServerListReq, opts ...grpc.CallOption) (MetaService_GetServerListClient, error)
+	PutAPI(ctx context.Context, in *PutAPIReq, opts ...grpc.CallOption) (*PutAPIRsp, error)
+	RemoveAPI(ctx context.Context, in *RemoveAPIReq, opts ...grpc.CallOption) (*RemoveAPIRsp, error)
+	GetAPI(ctx context.Context, in *GetAPIReq, opts ...grpc.CallOption) (*GetAPIRsp, error)
+	GetAPIList(ctx context.Context, in *GetAPIListReq, opts ...grpc.CallOption) (MetaService_GetAPIListClient, error)
+	PutRouting(ctx context.Context, in *PutRoutingReq, opts ...grpc.CallOption) (*PutRoutingRsp, error)
 	RemoveRouting(ctx context.Context, in *RemoveRoutingReq, opts ...grpc.CallOption) (*RemoveRoutingRsp, error)
 	GetRouting(ctx context.Context, in *GetRoutingReq, opts ...grpc.CallOption) (*GetRoutingRsp, error)
 	GetRoutingList(ctx context.Context, in *GetRoutingListReq, opts ...grpc.CallOption) (MetaService_GetRoutingListClient, error)
@@ -3246,9 +3671,12 @@ type MetaServiceClient interface {
 	GetPluginList(ctx context.Context, in *GetPluginListReq, opts ...grpc.CallOption) (MetaService_GetPluginListClient, error)
 	ApplyPlugins(ctx context.Context, in *ApplyPluginsReq, opts ...grpc.CallOption) (*ApplyPluginsRsp, error)
 	GetAppliedPlugins(ctx context.Context, in *GetAppliedPluginsReq, opts ...grpc.CallOption) (*GetAppliedPluginsRsp, error)
+	PutIPAccessControl(ctx context.Context, in *PutIPAccessControlReq, opts ...grpc.CallOption) (*PutIPAccessControlRsp, error)
+	GetIPAccessControl(ctx context.Context, in *GetIPAccessControlReq, opts ...grpc.CallOption) (*GetIPAccessControlRsp, error)
 	Clean(ctx context.Context, in *CleanReq, opts ...grpc.CallOption) (*CleanRsp, error)
 	SetID(ctx context.Context, in *SetIDReq, opts ...grpc.CallOption) (*SetIDRsp, error)
 	Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchRsp, error)
+	PurgeCache(ctx context.Context, in *PurgeCacheReq, opts ...grpc.CallOption) (*PurgeCacheRsp, error)
 }
 
 type metaServiceClient struct {
@@ -3608,6 +4036,24 @@ func (c *metaServiceClient) GetAppliedPlugins(ctx context.Context, in *GetApplie
 	return out, nil
 }
 
+func (c *metaServiceClient) PutIPAccessControl(ctx context.Context, in *PutIPAccessControlReq, opts ...grpc.CallOption) (*PutIPAccessControlRsp, error) {
+	out := new(PutIPAccessControlRsp)
+	err := c.cc.Invoke(ctx, "/rpcpb.MetaService/PutIPAccessControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaServiceClient) GetIPAccessControl(ctx context.Context, in *GetIPAccessControlReq, opts ...grpc.CallOption) (*GetIPAccessControlRsp, error) {
+	out := new(GetIPAccessControlRsp)
+	err := c.cc.Invoke(ctx, "/rpcpb.MetaService/GetIPAccessControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metaServiceClient) Clean(ctx context.Context, in *CleanReq, opts ...grpc.CallOption) (*CleanRsp, error) {
 	out := new(CleanRsp)
 	err := c.cc.Invoke(ctx, "/rpcpb.MetaService/Clean", in, out, opts...)
@@ -3635,6 +4081,15 @@ func (c *metaServiceClient) Batch(ctx context.Context, in *BatchReq, opts ...grp
 	return out, nil
 }
 
+func (c *metaServiceClient) PurgeCache(ctx context.Context, in *PurgeCacheReq, opts ...grpc.CallOption) (*PurgeCacheRsp, error) {
+	out := new(PurgeCacheRsp)
+	err := c.cc.Invoke(ctx, "/rpcpb.MetaService/PurgeCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetaServiceServer is the server API for MetaService service.
 type MetaServiceServer interface {
 	PutCluster(context.Context, *PutClusterReq) (*PutClusterRsp, error)
@@ -3663,9 +4118,12 @@ type MetaServiceServer interface {
 	GetPluginList(*GetPluginListReq, MetaService_GetPluginListServer) error
 	ApplyPlugins(context.Context, *ApplyPluginsReq) (*ApplyPluginsRsp, error)
 	GetAppliedPlugins(context.Context, *GetAppliedPluginsReq) (*GetAppliedPluginsRsp, error)
+	PutIPAccessControl(context.Context, *PutIPAccessControlReq) (*PutIPAccessControlRsp, error)
+	GetIPAccessControl(context.Context, *GetIPAccessControlReq) (*GetIPAccessControlRsp, error)
 	Clean(context.Context, *CleanReq) (*CleanRsp, error)
 	SetID(context.Context, *SetIDReq) (*SetIDRsp, error)
 	Batch(context.Context, *BatchReq) (*BatchRsp, error)
+	PurgeCache(context.Context, *PurgeCacheReq) (*PurgeCacheRsp, error)
 }
 
 // UnimplementedMetaServiceServer can be embedded to have forward compatible implementations.
@@ -3750,6 +4208,12 @@ func (*UnimplementedMetaServiceServer) ApplyPlugins(ctx context.Context, req *Ap
 func (*UnimplementedMetaServiceServer) GetAppliedPlugins(ctx context.Context, req *GetAppliedPluginsReq) (*GetAppliedPluginsRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppliedPlugins not implemented")
 }
+func (*UnimplementedMetaServiceServer) PutIPAccessControl(ctx context.Context, req *PutIPAccessControlReq) (*PutIPAccessControlRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutIPAccessControl not implemented")
+}
+func (*UnimplementedMetaServiceServer) GetIPAccessControl(ctx context.Context, req *GetIPAccessControlReq) (*GetIPAccessControlRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIPAccessControl not implemented")
+}
 func (*UnimplementedMetaServiceServer) Clean(ctx context.Context, req *CleanReq) (*CleanRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clean not implemented")
 }
@@ -3759,6 +4223,9 @@ func (*UnimplementedMetaServiceServer) SetID(ctx context.Context, req *SetIDReq)
 func (*UnimplementedMetaServiceServer) Batch(ctx context.Context, req *BatchReq) (*BatchRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
 }
+func (*UnimplementedMetaServiceServer) PurgeCache(ctx context.Context, req *PurgeCacheReq) (*PurgeCacheRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCache not implemented")
+}
 
 func RegisterMetaServiceServer(s *grpc.Server, srv MetaServiceServer) {
 	s.RegisterService(&_MetaService_serviceDesc, srv)
@@ -4247,6 +4714,42 @@ func _MetaService_GetAppliedPlugins_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetaService_PutIPAccessControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutIPAccessControlReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaServiceServer).PutIPAccessControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.MetaService/PutIPAccessControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaServiceServer).PutIPAccessControl(ctx, req.(*PutIPAccessControlReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaService_GetIPAccessControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIPAccessControlReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaServiceServer).GetIPAccessControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.MetaService/GetIPAccessControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaServiceServer).GetIPAccessControl(ctx, req.(*GetIPAccessControlReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MetaService_Clean_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CleanReq)
 	if err := dec(in); err != nil {
@@ -4301,6 +4804,24 @@ func _MetaService_Batch_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetaService_PurgeCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaServiceServer).PurgeCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.MetaService/PurgeCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaServiceServer).PurgeCache(ctx, req.(*PurgeCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MetaService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.MetaService",
 	HandlerType: (*MetaServiceServer)(nil),
@@ -4389,6 +4910,14 @@ var _MetaService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppliedPlugins",
 			Handler:    _MetaService_GetAppliedPlugins_Handler,
 		},
+		{
+			MethodName: "PutIPAccessControl",
+			Handler:    _MetaService_PutIPAccessControl_Handler,
+		},
+		{
+			MethodName: "GetIPAccessControl",
+			Handler:    _MetaService_GetIPAccessControl_Handler,
+		},
 		{
 			MethodName: "Clean",
 			Handler:    _MetaService_Clean_Handler,
@@ -4401,6 +4930,10 @@ var _MetaService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Batch",
 			Handler:    _MetaService_Batch_Handler,
 		},
+		{
+			MethodName: "PurgeCache",
+			Handler:    _MetaService_PurgeCache_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -5998,7 +6531,7 @@ func (m *GetAppliedPluginsRsp) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CleanReq) Marshal() (dAtA []byte, err error) {
+func (m *PutIPAccessControlReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6008,7 +6541,7 @@ func (m *CleanReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CleanReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutIPAccessControlReq) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6021,13 +6554,21 @@ func (m *CleanReq) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err60
 	}
 	i += n60
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Value.Size()))
+	n61, err61 := m.Value.MarshalTo(dAtA[i:])
+	if err61 != nil {
+		return 0, err61
+	}
+	i += n61
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *CleanRsp) Marshal() (dAtA []byte, err error) {
+func (m *PutIPAccessControlRsp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6037,7 +6578,7 @@ func (m *CleanRsp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CleanRsp) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutIPAccessControlRsp) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6045,18 +6586,18 @@ func (m *CleanRsp) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
-	n61, err61 := m.Header.MarshalTo(dAtA[i:])
-	if err61 != nil {
-		return 0, err61
+	n62, err62 := m.Header.MarshalTo(dAtA[i:])
+	if err62 != nil {
+		return 0, err62
 	}
-	i += n61
+	i += n62
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *SetIDReq) Marshal() (dAtA []byte, err error) {
+func (m *GetIPAccessControlReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6066,7 +6607,7 @@ func (m *SetIDReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetIDReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetIPAccessControlReq) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6074,11 +6615,137 @@ func (m *SetIDReq) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
-	n62, err62 := m.Header.MarshalTo(dAtA[i:])
-	if err62 != nil {
-		return 0, err62
+	n63, err63 := m.Header.MarshalTo(dAtA[i:])
+	if err63 != nil {
+		return 0, err63
 	}
-	i += n62
+	i += n63
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetIPAccessControlRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetIPAccessControlRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
+	n64, err64 := m.Header.MarshalTo(dAtA[i:])
+	if err64 != nil {
+		return 0, err64
+	}
+	i += n64
+	if m.Value != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Value.Size()))
+		n65, err65 := m.Value.MarshalTo(dAtA[i:])
+		if err65 != nil {
+			return 0, err65
+		}
+		i += n65
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CleanReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
+	n66, err66 := m.Header.MarshalTo(dAtA[i:])
+	if err66 != nil {
+		return 0, err66
+	}
+	i += n66
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CleanRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
+	n67, err67 := m.Header.MarshalTo(dAtA[i:])
+	if err67 != nil {
+		return 0, err67
+	}
+	i += n67
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetIDReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetIDReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
+	n68, err68 := m.Header.MarshalTo(dAtA[i:])
+	if err68 != nil {
+		return 0, err68
+	}
+	i += n68
 	dAtA[i] = 0x10
 	i++
 	i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
@@ -6106,11 +6773,11 @@ func (m *SetIDRsp) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
-	n63, err63 := m.Header.MarshalTo(dAtA[i:])
-	if err63 != nil {
-		return 0, err63
+	n69, err69 := m.Header.MarshalTo(dAtA[i:])
+	if err69 != nil {
+		return 0, err69
 	}
-	i += n63
+	i += n69
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -6135,11 +6802,11 @@ func (m *BatchReq) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
-	n64, err64 := m.Header.MarshalTo(dAtA[i:])
-	if err64 != nil {
-		return 0, err64
+	n70, err70 := m.Header.MarshalTo(dAtA[i:])
+	if err70 != nil {
+		return 0, err70
 	}
-	i += n64
+	i += n70
 	if len(m.PutClusters) > 0 {
 		for _, msg := range m.PutClusters {
 			dAtA[i] = 0x12
@@ -6288,11 +6955,11 @@ func (m *BatchReq) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintRpcpb(dAtA, i, uint64(m.ApplyPlugins.Size()))
-		n65, err65 := m.ApplyPlugins.MarshalTo(dAtA[i:])
-		if err65 != nil {
-			return 0, err65
+		n71, err71 := m.ApplyPlugins.MarshalTo(dAtA[i:])
+		if err71 != nil {
+			return 0, err71
 		}
-		i += n65
+		i += n71
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6318,11 +6985,11 @@ func (m *BatchRsp) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
-	n66, err66 := m.Header.MarshalTo(dAtA[i:])
-	if err66 != nil {
-		return 0, err66
+	n72, err72 := m.Header.MarshalTo(dAtA[i:])
+	if err72 != nil {
+		return 0, err72
 	}
-	i += n66
+	i += n72
 	if len(m.PutClusters) > 0 {
 		for _, msg := range m.PutClusters {
 			dAtA[i] = 0x12
@@ -6471,11 +7138,96 @@ func (m *BatchRsp) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintRpcpb(dAtA, i, uint64(m.ApplyPlugins.Size()))
-		n67, err67 := m.ApplyPlugins.MarshalTo(dAtA[i:])
-		if err67 != nil {
-			return 0, err67
+		n73, err73 := m.ApplyPlugins.MarshalTo(dAtA[i:])
+		if err73 != nil {
+			return 0, err73
+		}
+		i += n73
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PurgeCacheReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeCacheReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
+	n74, err74 := m.Header.MarshalTo(dAtA[i:])
+	if err74 != nil {
+		return 0, err74
+	}
+	i += n74
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.API))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Key)))
+	i += copy(dAtA[i:], m.Key)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Tag)))
+	i += copy(dAtA[i:], m.Tag)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Prefix)))
+	i += copy(dAtA[i:], m.Prefix)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PurgeCacheRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeCacheRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Header.Size()))
+	n75, err75 := m.Header.MarshalTo(dAtA[i:])
+	if err75 != nil {
+		return 0, err75
+	}
+	i += n75
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n67
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6483,6 +7235,38 @@ func (m *BatchRsp) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *PurgeCacheResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeCacheResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Proxy)))
+	i += copy(dAtA[i:], m.Proxy)
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Removed))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Error)))
+	i += copy(dAtA[i:], m.Error)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintRpcpb(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -7226,7 +8010,7 @@ func (m *GetAppliedPluginsRsp) Size() (n int) {
 	return n
 }
 
-func (m *CleanReq) Size() (n int) {
+func (m *PutIPAccessControlReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7234,13 +8018,15 @@ func (m *CleanReq) Size() (n int) {
 	_ = l
 	l = m.Header.Size()
 	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *CleanRsp) Size() (n int) {
+func (m *PutIPAccessControlRsp) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7254,7 +8040,7 @@ func (m *CleanRsp) Size() (n int) {
 	return n
 }
 
-func (m *SetIDReq) Size() (n int) {
+func (m *GetIPAccessControlReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7262,14 +8048,13 @@ func (m *SetIDReq) Size() (n int) {
 	_ = l
 	l = m.Header.Size()
 	n += 1 + l + sovRpcpb(uint64(l))
-	n += 1 + sovRpcpb(uint64(m.ID))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SetIDRsp) Size() (n int) {
+func (m *GetIPAccessControlRsp) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7277,13 +8062,17 @@ func (m *SetIDRsp) Size() (n int) {
 	_ = l
 	l = m.Header.Size()
 	n += 1 + l + sovRpcpb(uint64(l))
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *BatchReq) Size() (n int) {
+func (m *CleanReq) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7291,28 +8080,85 @@ func (m *BatchReq) Size() (n int) {
 	_ = l
 	l = m.Header.Size()
 	n += 1 + l + sovRpcpb(uint64(l))
-	if len(m.PutClusters) > 0 {
-		for _, e := range m.PutClusters {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
-	if len(m.RemoveClusters) > 0 {
-		for _, e := range m.RemoveClusters {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if len(m.PutServers) > 0 {
-		for _, e := range m.PutServers {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	return n
+}
+
+func (m *CleanRsp) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.RemoveServers) > 0 {
-		for _, e := range m.RemoveServers {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIDReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	n += 1 + sovRpcpb(uint64(m.ID))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIDRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.PutClusters) > 0 {
+		for _, e := range m.PutClusters {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.RemoveClusters) > 0 {
+		for _, e := range m.RemoveClusters {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.PutServers) > 0 {
+		for _, e := range m.PutServers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.RemoveServers) > 0 {
+		for _, e := range m.RemoveServers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
 		}
 	}
 	if len(m.PutAPIs) > 0 {
@@ -7463,6 +8309,64 @@ func (m *BatchRsp) Size() (n int) {
 	return n
 }
 
+func (m *PurgeCacheReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	n += 1 + sovRpcpb(uint64(m.API))
+	l = len(m.Key)
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = len(m.Prefix)
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeCacheRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeCacheResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proxy)
+	n += 1 + l + sovRpcpb(uint64(l))
+	n += 1 + sovRpcpb(uint64(m.Removed))
+	l = len(m.Error)
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRpcpb(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -12533,7 +13437,7 @@ func (m *GetAppliedPluginsRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CleanReq) Unmarshal(dAtA []byte) error {
+func (m *PutIPAccessControlReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12556,10 +13460,10 @@ func (m *CleanReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CleanReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutIPAccessControlReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CleanReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutIPAccessControlReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12595,6 +13499,39 @@ func (m *CleanReq) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -12620,7 +13557,7 @@ func (m *CleanReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CleanRsp) Unmarshal(dAtA []byte) error {
+func (m *PutIPAccessControlRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12643,10 +13580,10 @@ func (m *CleanRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CleanRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutIPAccessControlRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CleanRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutIPAccessControlRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12707,7 +13644,7 @@ func (m *CleanRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetIDReq) Unmarshal(dAtA []byte) error {
+func (m *GetIPAccessControlReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12730,10 +13667,10 @@ func (m *SetIDReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetIDReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetIPAccessControlReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetIDReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetIPAccessControlReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12769,25 +13706,6 @@ func (m *SetIDReq) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -12813,7 +13731,7 @@ func (m *SetIDReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetIDRsp) Unmarshal(dAtA []byte) error {
+func (m *GetIPAccessControlRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12836,10 +13754,10 @@ func (m *SetIDRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetIDRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetIPAccessControlRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetIDRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetIPAccessControlRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12875,6 +13793,42 @@ func (m *SetIDRsp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &metapb.IPAccessControl{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -12900,7 +13854,7 @@ func (m *SetIDRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchReq) Unmarshal(dAtA []byte) error {
+func (m *CleanReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12923,10 +13877,10 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12962,46 +13916,877 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutClusters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PutClusters = append(m.PutClusters, &PutClusterReq{})
-			if err := m.PutClusters[len(m.PutClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveClusters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CleanRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CleanRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CleanRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIDReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIDReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIDReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIDRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIDRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIDRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutClusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PutClusters = append(m.PutClusters, &PutClusterReq{})
+			if err := m.PutClusters[len(m.PutClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveClusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveClusters = append(m.RemoveClusters, &RemoveClusterReq{})
+			if err := m.RemoveClusters[len(m.RemoveClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutServers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PutServers = append(m.PutServers, &PutServerReq{})
+			if err := m.PutServers[len(m.PutServers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveServers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveServers = append(m.RemoveServers, &RemoveServerReq{})
+			if err := m.RemoveServers[len(m.RemoveServers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutAPIs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PutAPIs = append(m.PutAPIs, &PutAPIReq{})
+			if err := m.PutAPIs[len(m.PutAPIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAPIs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAPIs = append(m.RemoveAPIs, &RemoveAPIReq{})
+			if err := m.RemoveAPIs[len(m.RemoveAPIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutRoutings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PutRoutings = append(m.PutRoutings, &PutRoutingReq{})
+			if err := m.PutRoutings[len(m.PutRoutings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRoutings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveRoutings = append(m.RemoveRoutings, &RemoveRoutingReq{})
+			if err := m.RemoveRoutings[len(m.RemoveRoutings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddBinds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddBinds = append(m.AddBinds, &AddBindReq{})
+			if err := m.AddBinds[len(m.AddBinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveBinds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveBinds = append(m.RemoveBinds, &RemoveBindReq{})
+			if err := m.RemoveBinds[len(m.RemoveBinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutPlugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PutPlugins = append(m.PutPlugins, &PutPluginReq{})
+			if err := m.PutPlugins[len(m.PutPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovePlugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovePlugins = append(m.RemovePlugins, &RemovePluginReq{})
+			if err := m.RemovePlugins[len(m.RemovePlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyPlugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplyPlugins == nil {
+				m.ApplyPlugins = &ApplyPluginsReq{}
+			}
+			if err := m.ApplyPlugins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
 				}
@@ -13025,7 +14810,74 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveClusters = append(m.RemoveClusters, &RemoveClusterReq{})
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutClusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PutClusters = append(m.PutClusters, &PutClusterRsp{})
+			if err := m.PutClusters[len(m.PutClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveClusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveClusters = append(m.RemoveClusters, &RemoveClusterRsp{})
 			if err := m.RemoveClusters[len(m.RemoveClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13059,7 +14911,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PutServers = append(m.PutServers, &PutServerReq{})
+			m.PutServers = append(m.PutServers, &PutServerRsp{})
 			if err := m.PutServers[len(m.PutServers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13093,7 +14945,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveServers = append(m.RemoveServers, &RemoveServerReq{})
+			m.RemoveServers = append(m.RemoveServers, &RemoveServerRsp{})
 			if err := m.RemoveServers[len(m.RemoveServers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13127,7 +14979,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PutAPIs = append(m.PutAPIs, &PutAPIReq{})
+			m.PutAPIs = append(m.PutAPIs, &PutAPIRsp{})
 			if err := m.PutAPIs[len(m.PutAPIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13161,7 +15013,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAPIs = append(m.RemoveAPIs, &RemoveAPIReq{})
+			m.RemoveAPIs = append(m.RemoveAPIs, &RemoveAPIRsp{})
 			if err := m.RemoveAPIs[len(m.RemoveAPIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13195,7 +15047,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PutRoutings = append(m.PutRoutings, &PutRoutingReq{})
+			m.PutRoutings = append(m.PutRoutings, &PutRoutingRsp{})
 			if err := m.PutRoutings[len(m.PutRoutings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13229,7 +15081,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveRoutings = append(m.RemoveRoutings, &RemoveRoutingReq{})
+			m.RemoveRoutings = append(m.RemoveRoutings, &RemoveRoutingRsp{})
 			if err := m.RemoveRoutings[len(m.RemoveRoutings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13263,7 +15115,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddBinds = append(m.AddBinds, &AddBindReq{})
+			m.AddBinds = append(m.AddBinds, &AddBindRsp{})
 			if err := m.AddBinds[len(m.AddBinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13297,7 +15149,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveBinds = append(m.RemoveBinds, &RemoveBindReq{})
+			m.RemoveBinds = append(m.RemoveBinds, &RemoveBindRsp{})
 			if err := m.RemoveBinds[len(m.RemoveBinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13331,7 +15183,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PutPlugins = append(m.PutPlugins, &PutPluginReq{})
+			m.PutPlugins = append(m.PutPlugins, &PutPluginRsp{})
 			if err := m.PutPlugins[len(m.PutPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13365,7 +15217,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovePlugins = append(m.RemovePlugins, &RemovePluginReq{})
+			m.RemovePlugins = append(m.RemovePlugins, &RemovePluginRsp{})
 			if err := m.RemovePlugins[len(m.RemovePlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13400,7 +15252,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ApplyPlugins == nil {
-				m.ApplyPlugins = &ApplyPluginsReq{}
+				m.ApplyPlugins = &ApplyPluginsRsp{}
 			}
 			if err := m.ApplyPlugins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13431,7 +15283,7 @@ func (m *BatchReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchRsp) Unmarshal(dAtA []byte) error {
+func (m *PurgeCacheReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13448,156 +15300,21 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchRsp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchRsp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutClusters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PutClusters = append(m.PutClusters, &PutClusterRsp{})
-			if err := m.PutClusters[len(m.PutClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveClusters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoveClusters = append(m.RemoveClusters, &RemoveClusterRsp{})
-			if err := m.RemoveClusters[len(m.RemoveClusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutServers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PutServers = append(m.PutServers, &PutServerRsp{})
-			if err := m.PutServers[len(m.PutServers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeCacheReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeCacheReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveServers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13624,16 +15341,15 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveServers = append(m.RemoveServers, &RemoveServerRsp{})
-			if err := m.RemoveServers[len(m.RemoveServers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutAPIs", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			m.API = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -13643,31 +15359,16 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.API |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PutAPIs = append(m.PutAPIs, &PutAPIRsp{})
-			if err := m.PutAPIs[len(m.PutAPIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAPIs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -13677,31 +15378,29 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveAPIs = append(m.RemoveAPIs, &RemoveAPIRsp{})
-			if err := m.RemoveAPIs[len(m.RemoveAPIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutRoutings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -13711,31 +15410,29 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PutRoutings = append(m.PutRoutings, &PutRoutingRsp{})
-			if err := m.PutRoutings[len(m.PutRoutings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveRoutings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -13745,29 +15442,81 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveRoutings = append(m.RemoveRoutings, &RemoveRoutingRsp{})
-			if err := m.RemoveRoutings[len(m.RemoveRoutings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeCacheRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeCacheRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeCacheRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddBinds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13794,14 +15543,13 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddBinds = append(m.AddBinds, &AddBindRsp{})
-			if err := m.AddBinds[len(m.AddBinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveBinds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13828,16 +15576,70 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoveBinds = append(m.RemoveBinds, &RemoveBindRsp{})
-			if err := m.RemoveBinds[len(m.RemoveBinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, PurgeCacheResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeCacheResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeCacheResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeCacheResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutPlugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -13847,31 +15649,29 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PutPlugins = append(m.PutPlugins, &PutPluginRsp{})
-			if err := m.PutPlugins[len(m.PutPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovePlugins", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var msglen int
+			m.Removed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -13881,31 +15681,16 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Removed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemovePlugins = append(m.RemovePlugins, &RemovePluginRsp{})
-			if err := m.RemovePlugins[len(m.RemovePlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyPlugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -13915,27 +15700,23 @@ func (m *BatchRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplyPlugins == nil {
-				m.ApplyPlugins = &ApplyPluginsRsp{}
-			}
-			if err := m.ApplyPlugins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,4 +3,6 @@ package rpcpb
 const (
 	// ServiceMeta meta service name
 	ServiceMeta = "gateway-service-meta"
+	// PurgeCachePath the http path of purging the cache at the rpc address of the proxy
+	PurgeCachePath = "/v1/cache/purge"
 )
@@ -3,10 +3,19 @@ package pb
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/fagongzi/gateway/pkg/expr"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
 	"github.com/fagongzi/gateway/pkg/plugin"
+	"github.com/fagongzi/gateway/pkg/util"
+)
+
+const (
+	maxForEachConcurrency = 64
+	maxForEachElements    = 1000
 )
 
 // ValidateRouting validate routing
@@ -27,6 +36,78 @@ func ValidateRouting(value *metapb.Routing) error {
 		return fmt.Errorf("error traffic rate: %d", value.TrafficRate)
 	}
 
+	err := validateConditions(value.Conditions)
+	if err != nil {
+		return err
+	}
+
+	if value.Compare != nil {
+		err := validateShadowCompare(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	if value.Rollout != nil {
+		return validateRollout(value)
+	}
+
+	return nil
+}
+
+func validateShadowCompare(value *metapb.Routing) error {
+	if value.Strategy != metapb.Copy {
+		return fmt.Errorf("compare only support copy routing")
+	}
+
+	if value.Compare.SampleRate < 0 || value.Compare.SampleRate > 100 {
+		return fmt.Errorf("error compare sample rate: %d", value.Compare.SampleRate)
+	}
+
+	if value.Compare.MaxSamples < 0 {
+		return fmt.Errorf("error compare max samples: %d", value.Compare.MaxSamples)
+	}
+
+	for _, path := range value.Compare.IgnoredPaths {
+		if path == "" {
+			return fmt.Errorf("empty compare ignored path")
+		}
+	}
+
+	return nil
+}
+
+func validateRollout(value *metapb.Routing) error {
+	if value.Strategy != metapb.Split {
+		return fmt.Errorf("rollout only support split routing")
+	}
+
+	if len(value.Rollout.Steps) == 0 {
+		return fmt.Errorf("missing rollout steps")
+	}
+
+	prev := int32(0)
+	for _, step := range value.Rollout.Steps {
+		if step <= prev || step > 100 {
+			return fmt.Errorf("error rollout steps: %v", value.Rollout.Steps)
+		}
+		prev = step
+	}
+
+	if value.Rollout.StepInterval <= 0 {
+		return fmt.Errorf("missing rollout step interval")
+	}
+
+	// the analysis counts the qps by seconds
+	if value.Rollout.CheckPeriod < int64(time.Second) {
+		return fmt.Errorf("error rollout check period: %d, at least one second", value.Rollout.CheckPeriod)
+	}
+
+	if value.Rollout.BaselineClusterID == 0 ||
+		value.Rollout.BaselineClusterID == value.ClusterID {
+		return fmt.Errorf("error rollout baseline cluster: %d", value.Rollout.BaselineClusterID)
+	}
+
 	return nil
 }
 
@@ -62,7 +143,72 @@ func ValidateAPI(value *metapb.API) error {
 		return fmt.Errorf("missing URLPattern")
 	}
 
+	if value.Streaming || value.SSEOptions != nil {
+		if len(value.Nodes) != 1 {
+			return fmt.Errorf("streaming api must have only one dispatch node")
+		}
+
+		if value.RenderTemplate != nil {
+			return fmt.Errorf("streaming api can not use render template")
+		}
+
+		if value.WebSocketOptions != nil {
+			return fmt.Errorf("streaming api can not be a websocket api")
+		}
+
+		if value.Nodes[0].Cache != nil {
+			return fmt.Errorf("streaming api can not use cache")
+		}
+	}
+
+	if value.GraphQLField != "" {
+		if !isGraphQLName(value.GraphQLField) {
+			return fmt.Errorf("invalid graphql field name: %s", value.GraphQLField)
+		}
+
+		if value.Streaming || value.SSEOptions != nil || value.WebSocketOptions != nil {
+			return fmt.Errorf("graphql field can not be a streaming or websocket api")
+		}
+	}
+
+	if value.CORS != nil {
+		err := validateCORS(value.CORS)
+		if err != nil {
+			return err
+		}
+	}
+
+	if value.IPAccessControl != nil {
+		err := ValidateIPAccessControl(value.IPAccessControl)
+		if err != nil {
+			return err
+		}
+	}
+
+	if value.RenderTemplate != nil {
+		for _, obj := range value.RenderTemplate.Objects {
+			for _, attr := range obj.Attrs {
+				if attr.Expression != "" {
+					_, err := expr.ParseTemplate(attr.Expression)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	err := validateTransformations(value.Transformations)
+	if err != nil {
+		return err
+	}
+
 	for _, n := range value.Nodes {
+		err := validateTransformations(n.Transformations)
+		if err != nil {
+			return err
+		}
+
 		if n.URLRewrite != "" {
 			_, err := expr.Parse([]byte(n.URLRewrite))
 			if err != nil {
@@ -70,7 +216,64 @@ func ValidateAPI(value *metapb.API) error {
 			}
 		}
 
+		if n.BodyTemplate != "" {
+			_, err := expr.Parse([]byte(n.BodyTemplate))
+			if err != nil {
+				return err
+			}
+		}
+
+		if n.Protobuf != nil {
+			_, err := util.NewProtobufDecoder(n.Protobuf.DescriptorSet, n.Protobuf.MessageType)
+			if err != nil {
+				return err
+			}
+		} else if n.Decoder == metapb.DecodeProtobuf {
+			return fmt.Errorf("missing protobuf descriptor")
+		}
+
+		if n.ForEach != nil {
+			if n.ForEach.Path == "" {
+				return fmt.Errorf("missing for each path")
+			}
+
+			if n.ForEach.Concurrency < 0 || n.ForEach.Concurrency > maxForEachConcurrency {
+				return fmt.Errorf("for each concurrency must be in [0, %d]", maxForEachConcurrency)
+			}
+
+			if n.ForEach.MaxElements < 0 || n.ForEach.MaxElements > maxForEachElements {
+				return fmt.Errorf("for each max elements must be in [0, %d]", maxForEachElements)
+			}
+
+			if n.Cache != nil {
+				return fmt.Errorf("for each dispatch node can not use cache")
+			}
+		}
+
+		if n.Cache != nil {
+			err := validateConditions(n.Cache.Conditions)
+			if err != nil {
+				return err
+			}
+
+			for _, code := range n.Cache.StatusCodes {
+				if code < 100 || code > 599 {
+					return fmt.Errorf("invalid cache status code: %d", code)
+				}
+			}
+
+			// the waiting requests block the shared dispatch workers of the aggregated api
+			if n.Cache.Coalesce && len(value.Nodes) != 1 {
+				return fmt.Errorf("cache coalesce only support the api with one dispatch node")
+			}
+		}
+
 		for _, v := range n.Validations {
+			err := validateConditions(v.Conditions)
+			if err != nil {
+				return err
+			}
+
 			for _, r := range v.Rules {
 				if r.RuleType == metapb.RuleRegexp {
 					_, err := regexp.Compile(r.Expression)
@@ -82,6 +285,189 @@ func ValidateAPI(value *metapb.API) error {
 		}
 	}
 
+	return validateDepends(value.Nodes)
+}
+
+// validateDepends rejects the depend exprs referenced unknown attrs and the
+// cycles of the dispatch nodes, a node with a larger batch index depends on all
+// nodes with a smaller batch index
+func validateDepends(nodes []*metapb.DispatchNode) error {
+	depends := make([][]int, len(nodes))
+	for i, n := range nodes {
+		var exprs []expr.Expr
+		for _, value := range []string{n.URLRewrite, n.BodyTemplate} {
+			if value != "" {
+				parsed, err := expr.Parse([]byte(value))
+				if err != nil {
+					return err
+				}
+				exprs = append(exprs, parsed...)
+			}
+		}
+
+		attrs := expr.Depends(exprs...)
+		if n.ForEach != nil {
+			attrs = append(attrs, strings.Split(n.ForEach.Path, ".")[0])
+		}
+
+		for _, attr := range attrs {
+			found := false
+			for j, dep := range nodes {
+				if dep.AttrName == attr {
+					depends[i] = append(depends[i], j)
+					found = true
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("dispatch node %d depends on unknown attr %s", i, attr)
+			}
+		}
+
+		for j, dep := range nodes {
+			if dep.BatchIndex < n.BatchIndex {
+				depends[i] = append(depends[i], j)
+			}
+		}
+	}
+
+	// 0: not visited, 1: visiting, 2: visited
+	states := make([]int, len(nodes))
+	var visit func(int) error
+	visit = func(i int) error {
+		switch states[i] {
+		case 1:
+			return fmt.Errorf("dispatch node %d has cyclic depends", i)
+		case 2:
+			return nil
+		}
+
+		states[i] = 1
+		for _, j := range depends[i] {
+			if err := visit(j); err != nil {
+				return err
+			}
+		}
+		states[i] = 2
+		return nil
+	}
+
+	for i := range nodes {
+		if err := visit(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCORS(value *metapb.CORSPolicy) error {
+	if len(value.AllowOrigins) == 0 {
+		return fmt.Errorf("missing cors allow origins")
+	}
+
+	for _, origin := range value.AllowOrigins {
+		err := util.ValidateOriginPattern(origin)
+		if err != nil {
+			return err
+		}
+
+		// the browsers reject the credentialed response with any origin
+		if origin == "*" && value.AllowCredentials {
+			return fmt.Errorf("cors can not allow any origin with credentials")
+		}
+	}
+
+	for _, values := range [][]string{value.AllowMethods, value.AllowHeaders, value.ExposeHeaders} {
+		for _, v := range values {
+			if v == "" || strings.ContainsAny(v, ", ") {
+				return fmt.Errorf("invalid cors method or header: %q", v)
+			}
+		}
+	}
+
+	if value.MaxAge < 0 {
+		return fmt.Errorf("cors max age must be >= 0")
+	}
+
+	return nil
+}
+
+func validateTransformations(values []*metapb.Transformation) error {
+	for _, value := range values {
+		if value.Name == "" {
+			return fmt.Errorf("missing transformation name")
+		}
+
+		if _, ok := metapb.TransformTarget_name[int32(value.Target)]; !ok {
+			return fmt.Errorf("invalid transformation target: %d", value.Target)
+		}
+
+		if _, ok := metapb.TransformAction_name[int32(value.Action)]; !ok {
+			return fmt.Errorf("invalid transformation action: %d", value.Action)
+		}
+
+		switch value.Action {
+		case metapb.TransformSet, metapb.TransformAppend:
+			_, err := expr.Parse([]byte(value.Value))
+			if err != nil {
+				return err
+			}
+		case metapb.TransformRename:
+			if value.Value == "" {
+				return fmt.Errorf("missing new name of transformation %s", value.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateConditions(values []metapb.Condition) error {
+	for _, value := range values {
+		err := validateCondition(&value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCondition(value *metapb.Condition) error {
+	if len(value.Children) > 0 {
+		return validateConditions(value.Children)
+	}
+
+	if value.Logic != metapb.LogicAnd {
+		return fmt.Errorf("missing children of %s condition", value.Logic.String())
+	}
+
+	var err error
+	switch value.Cmp {
+	case metapb.CMPMatch:
+		_, err = regexp.Compile(value.Expect)
+	case metapb.CMPCIDR:
+		_, err = util.ParseCIDRs(value.Expect)
+	case metapb.CMPVersionEQ, metapb.CMPVersionLT, metapb.CMPVersionLE,
+		metapb.CMPVersionGT, metapb.CMPVersionGE:
+		_, err = util.ParseSemVer(value.Expect)
+	}
+
+	return err
+}
+
+// ValidateIPAccessControl validate ip access control
+func ValidateIPAccessControl(value *metapb.IPAccessControl) error {
+	for _, values := range [][]string{value.Whitelist, value.Blacklist} {
+		for _, ip := range values {
+			_, err := util.ParseIPValue(ip)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -106,3 +492,37 @@ func ValidatePlugin(value *metapb.Plugin) error {
 
 	return nil
 }
+
+// ValidatePurgeCache validate purge cache request
+func ValidatePurgeCache(value *rpcpb.PurgeCacheReq) error {
+	n := 0
+	for _, ok := range []bool{value.API > 0, value.Key != "", value.Tag != "", value.Prefix != ""} {
+		if ok {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return fmt.Errorf("purge cache requires exactly one of api, key, tag and prefix")
+	}
+
+	return nil
+}
+
+// isGraphQLName returns true if the value matches the GraphQL name syntax /[_A-Za-z][_0-9A-Za-z]*/
+func isGraphQLName(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for i, c := range value {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && c >= '0' && c <= '9':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,169 @@
+package pb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+func newRolloutRouting() *metapb.Routing {
+	return &metapb.Routing{
+		ID:          1,
+		API:         1,
+		ClusterID:   2,
+		Name:        "canary",
+		Strategy:    metapb.Split,
+		TrafficRate: 10,
+		Status:      metapb.Up,
+		Rollout: &metapb.Rollout{
+			Steps:             []int32{10, 50, 100},
+			StepInterval:      int64(time.Minute),
+			CheckPeriod:       int64(time.Second * 10),
+			BaselineClusterID: 1,
+		},
+	}
+}
+
+func TestValidateRollout(t *testing.T) {
+	if err := ValidateRouting(newRolloutRouting()); err != nil {
+		t.Errorf("expect valid rollout, but %+v", err)
+	}
+
+	cases := []struct {
+		name   string
+		adjust func(*metapb.Routing)
+	}{
+		{"copy strategy", func(value *metapb.Routing) { value.Strategy = metapb.Copy }},
+		{"missing steps", func(value *metapb.Routing) { value.Rollout.Steps = nil }},
+		{"zero step", func(value *metapb.Routing) { value.Rollout.Steps = []int32{0, 100} }},
+		{"decreasing steps", func(value *metapb.Routing) { value.Rollout.Steps = []int32{50, 10} }},
+		{"repeated steps", func(value *metapb.Routing) { value.Rollout.Steps = []int32{10, 10} }},
+		{"step over 100", func(value *metapb.Routing) { value.Rollout.Steps = []int32{10, 101} }},
+		{"missing step interval", func(value *metapb.Routing) { value.Rollout.StepInterval = 0 }},
+		{"missing check period", func(value *metapb.Routing) { value.Rollout.CheckPeriod = 0 }},
+		{"check period less than one second", func(value *metapb.Routing) { value.Rollout.CheckPeriod = int64(time.Millisecond * 100) }},
+		{"missing baseline", func(value *metapb.Routing) { value.Rollout.BaselineClusterID = 0 }},
+		{"baseline is canary", func(value *metapb.Routing) { value.Rollout.BaselineClusterID = value.ClusterID }},
+	}
+
+	for _, c := range cases {
+		value := newRolloutRouting()
+		c.adjust(value)
+		if err := ValidateRouting(value); err == nil {
+			t.Errorf("%s: expect invalid rollout", c.name)
+		}
+	}
+}
+
+func TestValidateDepends(t *testing.T) {
+	cases := []struct {
+		name  string
+		nodes []*metapb.DispatchNode
+		valid bool
+	}{
+		{"no depends", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user"},
+			{ClusterID: 1, AttrName: "account"},
+		}, true},
+		{"depend chain", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user"},
+			{ClusterID: 1, AttrName: "account", URLRewrite: "/accounts/$(depend.user.id)"},
+			{ClusterID: 1, AttrName: "order", BodyTemplate: `{"account":$(depend.account)}`},
+		}, true},
+		{"for each", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "orders"},
+			{ClusterID: 1, AttrName: "items", URLRewrite: "/items/$(item.id)", ForEach: &metapb.ForEach{Path: "orders.items"}},
+		}, true},
+		{"unknown attr", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user"},
+			{ClusterID: 1, AttrName: "account", URLRewrite: "/accounts/$(depend.missing.id)"},
+		}, false},
+		{"unknown for each attr", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "items", URLRewrite: "/items/$(item.id)", ForEach: &metapb.ForEach{Path: "orders.items"}},
+		}, false},
+		{"self depend", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user", URLRewrite: "/users/$(depend.user.id)"},
+		}, false},
+		{"cyclic depends", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user", URLRewrite: "/users/$(depend.account.id)"},
+			{ClusterID: 1, AttrName: "account", URLRewrite: "/accounts/$(depend.user.id)"},
+		}, false},
+		{"cyclic depends with batch index", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user", URLRewrite: "/users/$(depend.account.id)"},
+			{ClusterID: 1, AttrName: "account", BatchIndex: 1},
+		}, false},
+		{"invalid expr", []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user", URLRewrite: "/users/$(depend."},
+		}, false},
+	}
+
+	for _, c := range cases {
+		err := validateDepends(c.nodes)
+		if c.valid && err != nil {
+			t.Errorf("%s: expect valid, but %+v", c.name, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("%s: expect invalid", c.name)
+		}
+	}
+}
+
+func TestValidateTransformations(t *testing.T) {
+	cases := []struct {
+		name  string
+		value metapb.Transformation
+		valid bool
+	}{
+		{"set", metapb.Transformation{Target: metapb.RequestHeader, Action: metapb.TransformSet, Name: "X-A", Value: "$(origin.cookie.uid)"}, true},
+		{"remove", metapb.Transformation{Target: metapb.ResponseHeader, Action: metapb.TransformRemove, Name: "X-A"}, true},
+		{"rename", metapb.Transformation{Target: metapb.RequestCookie, Action: metapb.TransformRename, Name: "a", Value: "b"}, true},
+		{"missing name", metapb.Transformation{Target: metapb.RequestHeader, Action: metapb.TransformSet, Value: "v"}, false},
+		{"missing new name", metapb.Transformation{Target: metapb.RequestQuery, Action: metapb.TransformRename, Name: "a"}, false},
+		{"invalid expr", metapb.Transformation{Target: metapb.RequestQuery, Action: metapb.TransformAppend, Name: "a", Value: "$(origin."}, false},
+		{"unknown target", metapb.Transformation{Target: metapb.TransformTarget(100), Action: metapb.TransformRemove, Name: "a"}, false},
+		{"unknown action", metapb.Transformation{Target: metapb.RequestHeader, Action: metapb.TransformAction(100), Name: "a"}, false},
+		{"negative action", metapb.Transformation{Target: metapb.RequestHeader, Action: metapb.TransformAction(-1), Name: "a"}, false},
+	}
+
+	for _, c := range cases {
+		err := validateTransformations([]*metapb.Transformation{&c.value})
+		if c.valid && err != nil {
+			t.Errorf("%s: expect valid, but %+v", c.name, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("%s: expect invalid", c.name)
+		}
+	}
+}
+
+func TestValidateForEach(t *testing.T) {
+	cases := []struct {
+		name    string
+		forEach metapb.ForEach
+		valid   bool
+	}{
+		{"default", metapb.ForEach{Path: "orders.items"}, true},
+		{"max", metapb.ForEach{Path: "orders.items", Concurrency: maxForEachConcurrency, MaxElements: maxForEachElements}, true},
+		{"missing path", metapb.ForEach{}, false},
+		{"negative concurrency", metapb.ForEach{Path: "orders.items", Concurrency: -1}, false},
+		{"negative max elements", metapb.ForEach{Path: "orders.items", MaxElements: -1}, false},
+		{"concurrency over the limit", metapb.ForEach{Path: "orders.items", Concurrency: maxForEachConcurrency + 1}, false},
+		{"max elements over the limit", metapb.ForEach{Path: "orders.items", MaxElements: maxForEachElements + 1}, false},
+	}
+
+	for _, c := range cases {
+		forEach := c.forEach
+		err := ValidateAPI(&metapb.API{
+			Name:       "a",
+			URLPattern: "/a",
+			Nodes: []*metapb.DispatchNode{
+				{ClusterID: 1, AttrName: "orders"},
+				{ClusterID: 1, AttrName: "items", URLRewrite: "/items/$(item.id)", ForEach: &forEach},
+			},
+		})
+		if c.valid && err != nil {
+			t.Errorf("%s: expect valid, but %+v", c.name, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("%s: expect invalid", c.name)
+		}
+	}
+}
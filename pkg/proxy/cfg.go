@@ -19,16 +19,32 @@ type Option struct {
 	LimitDurationConnIdle      time.Duration
 	LimitTimeoutWrite          time.Duration
 	LimitTimeoutRead           time.Duration
+	LimitTimeoutSSEIdle        time.Duration
+	LimitTimeoutGracefulStop   time.Duration
+	LimitTimeoutUpgrade        time.Duration
+	LimitTimeoutProxyProtocol  time.Duration
 	LimitBufferRead            int
 	LimitBufferWrite           int
 	LimitBytesBody             int
 	LimitBytesCaching          uint64
+	LimitGraphQLDepth          int
+	LimitGraphQLComplexity     int
+	LimitBytesCompressMin      int
 
 	JWTCfgFile   string
 	CrossCfgFile string
+	GraphQLPath  string
+
+	CompressContentTypes []string
+	TrustedProxies       []string
 
 	EnableWebSocket              bool
+	EnableGRPC                   bool
 	EnableJSPlugin               bool
+	EnableCompression            bool
+	EnableRequestDecompression   bool
+	EnableProxyProtocol          bool
+	EnableRequestStreaming       bool
 	DisableHeaderNameNormalizing bool
 }
 
@@ -43,6 +59,7 @@ type Cfg struct {
 	AddrStoreUserName string
 	AddrStorePwd      string
 	AddrPPROF         string
+	AddrCache         string
 	Namespace         string
 	TTLProxy          int64
 	Filers            []*FilterSpec
@@ -0,0 +1,128 @@
+package proxy
+
+import (
+	"bytes"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	contentEncodingHeader = "Content-Encoding"
+	acceptEncodingHeader  = "Accept-Encoding"
+	varyHeader            = "Vary"
+)
+
+var (
+	// the encodings in order of preference
+	compressEncodings = []string{util.EncodingBrotli, util.EncodingGzip, util.EncodingDeflate}
+)
+
+// needInspectResponse returns true if the response body is read by the gateway,
+// the body is merged, rendered, decoded or cached
+func (dn *dispatchNode) needInspectResponse() bool {
+	return dn.node.meta.Cache != nil || !dn.rawResponse()
+}
+
+// rawResponse returns true if the response body of the backend server is sent
+// to the client as is, the body is not merged, rendered or decoded
+func (dn *dispatchNode) rawResponse() bool {
+	return dn.multiCtx == nil &&
+		!dn.element &&
+		!dn.api.hasRenderTemplate() &&
+		(dn.node.meta.Decoder == metapb.DecodeAuto || dn.node.meta.Decoder == metapb.DecodeNone)
+}
+
+// decompressResponse decompress the response body of the backend server before it
+// is inspected, the compressed body is sent to the client as is if not inspected
+func (dn *dispatchNode) decompressResponse(limit int) error {
+	if dn.res == nil || dn.stream != nil || !dn.needInspectResponse() {
+		return nil
+	}
+
+	encoding := dn.res.Header.Peek(contentEncodingHeader)
+	if len(encoding) == 0 {
+		return nil
+	}
+
+	value, err := util.Decompress(hack.SliceToString(encoding), dn.res.Body(), limit)
+	if err != nil {
+		return err
+	}
+
+	dn.res.Header.Del(contentEncodingHeader)
+	dn.res.SetBody(value)
+	return nil
+}
+
+// decompressRequest decompress the request body of the client before it is
+// matched, validated and forwarded
+func (p *Proxy) decompressRequest(ctx *fasthttp.RequestCtx) (int, error) {
+	encoding := ctx.Request.Header.Peek(contentEncodingHeader)
+	if len(encoding) == 0 {
+		return fasthttp.StatusOK, nil
+	}
+
+	value, err := util.Decompress(hack.SliceToString(encoding), ctx.Request.Body(), p.cfg.Option.LimitBytesBody)
+	if err == util.ErrBodyTooLarge {
+		return fasthttp.StatusRequestEntityTooLarge, err
+	} else if err != nil {
+		return fasthttp.StatusBadRequest, err
+	}
+
+	ctx.Request.Header.Del(contentEncodingHeader)
+	ctx.Request.SetBody(value)
+	return fasthttp.StatusOK, nil
+}
+
+// compressResponse compress the final response with the encoding accepted by the client,
+// the streams, the small bodies and the bodies not matched the content types are skipped
+func (p *Proxy) compressResponse(ctx *fasthttp.RequestCtx, requestTag string) {
+	res := &ctx.Response
+	if ctx.IsHead() ||
+		res.IsBodyStream() ||
+		res.StatusCode() < fasthttp.StatusOK ||
+		res.StatusCode() == fasthttp.StatusNoContent ||
+		res.StatusCode() == fasthttp.StatusNotModified ||
+		len(res.Header.Peek(contentEncodingHeader)) > 0 ||
+		len(res.Body()) < p.cfg.Option.LimitBytesCompressMin ||
+		!p.isCompressContentType(res.Header.ContentType()) {
+		return
+	}
+
+	addVary(res, acceptEncodingHeader)
+	encoding := util.NegotiateEncoding(ctx.Request.Header.Peek(acceptEncodingHeader), compressEncodings...)
+	if encoding == "" {
+		return
+	}
+
+	value, err := util.Compress(encoding, res.Body())
+	if err != nil {
+		log.Errorf("%s: compress response with %s failed with error %s",
+			requestTag,
+			encoding,
+			err)
+		return
+	}
+
+	res.Header.Set(contentEncodingHeader, encoding)
+	res.SetBody(value)
+}
+
+func (p *Proxy) isCompressContentType(contentType []byte) bool {
+	if idx := bytes.IndexByte(contentType, ';'); idx >= 0 {
+		contentType = contentType[:idx]
+	}
+	contentType = bytes.ToLower(bytes.TrimSpace(contentType))
+
+	for _, value := range p.cfg.Option.CompressContentTypes {
+		if bytes.HasPrefix(contentType, hack.StringToSlice(value)) {
+			return true
+		}
+	}
+
+	return false
+}
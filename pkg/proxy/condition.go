@@ -0,0 +1,225 @@
+package proxy
+
+import (
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+// condition is the runtime of the metapb.Condition, the expect is parsed once
+type condition struct {
+	meta     *metapb.Condition
+	children []*condition
+	pattern  *regexp.Regexp
+	set      map[string]struct{}
+	ipNets   []*net.IPNet
+	version  util.SemVer
+	err      error
+}
+
+func newConditions(metas []metapb.Condition) []*condition {
+	var values []*condition
+	for idx := range metas {
+		values = append(values, newCondition(&metas[idx]))
+	}
+
+	return values
+}
+
+func newCondition(meta *metapb.Condition) *condition {
+	c := &condition{
+		meta: meta,
+	}
+
+	if len(meta.Children) > 0 {
+		c.children = newConditions(meta.Children)
+		return c
+	}
+
+	switch meta.Cmp {
+	case metapb.CMPMatch:
+		c.pattern, c.err = regexp.Compile(meta.Expect)
+	case metapb.CMPInSet:
+		c.set = make(map[string]struct{})
+		for _, value := range strings.Split(meta.Expect, ",") {
+			c.set[strings.TrimSpace(value)] = struct{}{}
+		}
+	case metapb.CMPCIDR:
+		c.ipNets, c.err = util.ParseCIDRs(meta.Expect)
+	case metapb.CMPVersionEQ, metapb.CMPVersionLT, metapb.CMPVersionLE,
+		metapb.CMPVersionGT, metapb.CMPVersionGE:
+		c.version, c.err = util.ParseSemVer(meta.Expect)
+	}
+
+	if c.err != nil {
+		log.Errorf("condition %+v is invalid, errors:\n%+v",
+			meta,
+			c.err)
+	}
+
+	return c
+}
+
+// conditionsMatches returns true if all the conditions are matched
+func conditionsMatches(conditions []*condition, req *fasthttp.Request, clientIP string) bool {
+	for _, c := range conditions {
+		if !c.matches(req, clientIP) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *condition) matches(req *fasthttp.Request, clientIP string) bool {
+	if c.err != nil {
+		return false
+	}
+
+	if len(c.children) > 0 {
+		return c.groupMatches(req, clientIP)
+	}
+
+	switch c.meta.Cmp {
+	case metapb.CMPCIDR:
+		return c.cidr(clientIP)
+	case metapb.CMPExists:
+		return paramValue(&c.meta.Parameter, req) != ""
+	case metapb.CMPNotExists:
+		return paramValue(&c.meta.Parameter, req) == ""
+	}
+
+	attrValue := paramValue(&c.meta.Parameter, req)
+	if attrValue == "" {
+		return false
+	}
+
+	switch c.meta.Cmp {
+	case metapb.CMPEQ:
+		return attrValue == c.meta.Expect
+	case metapb.CMPNE:
+		return attrValue != c.meta.Expect
+	case metapb.CMPLT:
+		value, ok := compareNumber(attrValue, c.meta.Expect)
+		return ok && value < 0
+	case metapb.CMPLE:
+		value, ok := compareNumber(attrValue, c.meta.Expect)
+		return ok && value <= 0
+	case metapb.CMPGT:
+		value, ok := compareNumber(attrValue, c.meta.Expect)
+		return ok && value > 0
+	case metapb.CMPGE:
+		value, ok := compareNumber(attrValue, c.meta.Expect)
+		return ok && value >= 0
+	case metapb.CMPIn:
+		return strings.Index(c.meta.Expect, attrValue) != -1
+	case metapb.CMPMatch:
+		return c.pattern.MatchString(attrValue)
+	case metapb.CMPPrefix:
+		return strings.HasPrefix(attrValue, c.meta.Expect)
+	case metapb.CMPSuffix:
+		return strings.HasSuffix(attrValue, c.meta.Expect)
+	case metapb.CMPInSet:
+		_, ok := c.set[attrValue]
+		return ok
+	case metapb.CMPVersionEQ:
+		value, ok := c.compareVersion(attrValue)
+		return ok && value == 0
+	case metapb.CMPVersionLT:
+		value, ok := c.compareVersion(attrValue)
+		return ok && value < 0
+	case metapb.CMPVersionLE:
+		value, ok := c.compareVersion(attrValue)
+		return ok && value <= 0
+	case metapb.CMPVersionGT:
+		value, ok := c.compareVersion(attrValue)
+		return ok && value > 0
+	case metapb.CMPVersionGE:
+		value, ok := c.compareVersion(attrValue)
+		return ok && value >= 0
+	default:
+		return false
+	}
+}
+
+func (c *condition) groupMatches(req *fasthttp.Request, clientIP string) bool {
+	switch c.meta.Logic {
+	case metapb.LogicAnd:
+		return conditionsMatches(c.children, req, clientIP)
+	case metapb.LogicOr:
+		for _, child := range c.children {
+			if child.matches(req, clientIP) {
+				return true
+			}
+		}
+		return false
+	case metapb.LogicNot:
+		return !conditionsMatches(c.children, req, clientIP)
+	default:
+		return false
+	}
+}
+
+func (c *condition) cidr(clientIP string) bool {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+
+	for _, ipNet := range c.ipNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *condition) compareVersion(attrValue string) (int, bool) {
+	value, err := util.ParseSemVer(attrValue)
+	if err != nil {
+		return 0, false
+	}
+
+	return value.Compare(c.version), true
+}
+
+// compareNumber compare the integers, or the floats if any of them is not a integer
+func compareNumber(attrValue, expect string) (int, bool) {
+	a, errA := strconv.ParseInt(attrValue, 10, 64)
+	b, errB := strconv.ParseInt(expect, 10, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	fa, err := strconv.ParseFloat(attrValue, 64)
+	if err != nil {
+		return 0, false
+	}
+	fb, err := strconv.ParseFloat(expect, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	switch {
+	case fa < fb:
+		return -1, true
+	case fa > fb:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
@@ -0,0 +1,134 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+func queryCondition(name string, cmp metapb.CMP, expect string) metapb.Condition {
+	return metapb.Condition{
+		Parameter: metapb.Parameter{Name: name, Source: metapb.QueryString},
+		Cmp:       cmp,
+		Expect:    expect,
+	}
+}
+
+func groupCondition(logic metapb.Logic, children ...metapb.Condition) metapb.Condition {
+	return metapb.Condition{
+		Logic:    logic,
+		Children: children,
+	}
+}
+
+func TestConditionMatches(t *testing.T) {
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.SetRequestURI("http://gw/a?age=18&score=9.5&name=zhang&version=1.2.3")
+	req.Header.Set("X-Channel", "ios")
+
+	cases := []struct {
+		name   string
+		meta   metapb.Condition
+		expect bool
+	}{
+		{"eq", queryCondition("age", metapb.CMPEQ, "18"), true},
+		{"eq not matched", queryCondition("age", metapb.CMPEQ, "19"), false},
+		{"ne", queryCondition("age", metapb.CMPNE, "19"), true},
+		{"lt integer", queryCondition("age", metapb.CMPLT, "100"), true},
+		{"le", queryCondition("age", metapb.CMPLE, "18"), true},
+		{"gt", queryCondition("age", metapb.CMPGT, "18"), false},
+		{"ge float", queryCondition("score", metapb.CMPGE, "9.5"), true},
+		{"gt not number", queryCondition("name", metapb.CMPGT, "1"), false},
+		{"in", queryCondition("name", metapb.CMPIn, "zhang,li"), true},
+		{"match", queryCondition("name", metapb.CMPMatch, "^zh"), true},
+		{"invalid match", queryCondition("name", metapb.CMPMatch, "("), false},
+		{"prefix", queryCondition("name", metapb.CMPPrefix, "zh"), true},
+		{"suffix", queryCondition("name", metapb.CMPSuffix, "ng"), true},
+		{"in set", queryCondition("name", metapb.CMPInSet, "li, zhang"), true},
+		{"not in set", queryCondition("name", metapb.CMPInSet, "li,zhan"), false},
+		{"exists", queryCondition("name", metapb.CMPExists, ""), true},
+		{"not exists", queryCondition("missing", metapb.CMPNotExists, ""), true},
+		{"missing value", queryCondition("missing", metapb.CMPNE, "1"), false},
+		{"cidr", queryCondition("", metapb.CMPCIDR, "10.0.0.0/8"), true},
+		{"cidr not matched", queryCondition("", metapb.CMPCIDR, "192.168.0.0/16"), false},
+		{"version eq", queryCondition("version", metapb.CMPVersionEQ, "1.2.3"), true},
+		{"version lt", queryCondition("version", metapb.CMPVersionLT, "1.10.0"), true},
+		{"version ge", queryCondition("version", metapb.CMPVersionGE, "1.3"), false},
+		{"header", metapb.Condition{
+			Parameter: metapb.Parameter{Name: "X-Channel", Source: metapb.Header},
+			Cmp:       metapb.CMPEQ,
+			Expect:    "ios",
+		}, true},
+		{"and", groupCondition(metapb.LogicAnd,
+			queryCondition("age", metapb.CMPEQ, "18"),
+			queryCondition("name", metapb.CMPEQ, "li")), false},
+		{"or", groupCondition(metapb.LogicOr,
+			queryCondition("age", metapb.CMPEQ, "18"),
+			queryCondition("name", metapb.CMPEQ, "li")), true},
+		{"not", groupCondition(metapb.LogicNot,
+			queryCondition("name", metapb.CMPEQ, "li")), true},
+		{"nested", groupCondition(metapb.LogicAnd,
+			queryCondition("age", metapb.CMPGE, "18"),
+			groupCondition(metapb.LogicOr,
+				queryCondition("name", metapb.CMPEQ, "li"),
+				queryCondition("version", metapb.CMPVersionGT, "1.0.0"))), true},
+	}
+
+	for _, c := range cases {
+		meta := c.meta
+		if value := newCondition(&meta).matches(req, "10.1.1.1"); value != c.expect {
+			t.Errorf("%s: expect %v, but %v", c.name, c.expect, value)
+		}
+	}
+}
+
+func TestConditionsMatches(t *testing.T) {
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.SetRequestURI("http://gw/a?age=18")
+
+	conditions := newConditions([]metapb.Condition{
+		queryCondition("age", metapb.CMPGE, "18"),
+		queryCondition("", metapb.CMPCIDR, "10.0.0.0/8"),
+	})
+
+	if !conditionsMatches(conditions, req, "10.1.1.1") {
+		t.Errorf("expect all conditions matched")
+	}
+
+	if conditionsMatches(conditions, req, "192.168.1.1") {
+		t.Errorf("expect not matched by cidr")
+	}
+
+	if conditionsMatches(conditions, req, "") {
+		t.Errorf("expect not matched without client ip")
+	}
+
+	if !conditionsMatches(nil, req, "") {
+		t.Errorf("expect empty conditions matched")
+	}
+}
+
+func TestCompareNumber(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		expect int
+		ok     bool
+	}{
+		{"1", "2", -1, true},
+		{"10", "9", 1, true},
+		{"1.5", "1.5", 0, true},
+		{"2", "1.5", 1, true},
+		{"a", "1", 0, false},
+		{"1", "b", 0, false},
+	}
+
+	for _, c := range cases {
+		value, ok := compareNumber(c.a, c.b)
+		if value != c.expect || ok != c.ok {
+			t.Errorf("compare %s and %s expect %d %v, but %d %v", c.a, c.b, c.expect, c.ok, value, ok)
+		}
+	}
+}
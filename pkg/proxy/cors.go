@@ -0,0 +1,213 @@
+package proxy
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	originHeader                        = "Origin"
+	accessControlRequestMethodHeader    = "Access-Control-Request-Method"
+	accessControlRequestHeadersHeader   = "Access-Control-Request-Headers"
+	accessControlAllowOriginHeader      = "Access-Control-Allow-Origin"
+	accessControlAllowMethodsHeader     = "Access-Control-Allow-Methods"
+	accessControlAllowHeadersHeader     = "Access-Control-Allow-Headers"
+	accessControlAllowCredentialsHeader = "Access-Control-Allow-Credentials"
+	accessControlExposeHeadersHeader    = "Access-Control-Expose-Headers"
+	accessControlMaxAgeHeader           = "Access-Control-Max-Age"
+)
+
+var (
+	// the headers of the backend server are replaced by the cors policy
+	corsResponseHeaders = []string{
+		accessControlAllowOriginHeader,
+		accessControlAllowMethodsHeader,
+		accessControlAllowHeadersHeader,
+		accessControlAllowCredentialsHeader,
+		accessControlExposeHeadersHeader,
+		accessControlMaxAgeHeader,
+	}
+)
+
+// corsPolicy is the parsed cors policy of the api
+type corsPolicy struct {
+	meta *metapb.CORSPolicy
+
+	anyOrigin     bool
+	methods       map[string]struct{}
+	anyHeader     bool
+	headers       map[string]struct{}
+	exposeHeaders string
+	maxAge        string
+}
+
+func newCORSPolicy(meta *metapb.CORSPolicy) *corsPolicy {
+	p := &corsPolicy{
+		meta:          meta,
+		methods:       make(map[string]struct{}),
+		headers:       make(map[string]struct{}),
+		exposeHeaders: strings.Join(meta.ExposeHeaders, ", "),
+	}
+
+	for _, origin := range meta.AllowOrigins {
+		if origin == "*" {
+			p.anyOrigin = true
+		}
+	}
+
+	for _, method := range meta.AllowMethods {
+		p.methods[strings.ToUpper(method)] = struct{}{}
+	}
+
+	for _, header := range meta.AllowHeaders {
+		if header == "*" {
+			p.anyHeader = true
+		}
+		p.headers[strings.ToLower(header)] = struct{}{}
+	}
+
+	if meta.MaxAge > 0 {
+		p.maxAge = strconv.FormatInt(meta.MaxAge, 10)
+	}
+
+	return p
+}
+
+func (p *corsPolicy) allowOrigin(origin string) bool {
+	for _, pattern := range p.meta.AllowOrigins {
+		if util.MatchOrigin(pattern, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowMethod returns true if the method is allowed, all the methods of the api
+// are allowed if no methods are specified
+func (p *corsPolicy) allowMethod(method string) bool {
+	if len(p.methods) == 0 {
+		return true
+	}
+
+	_, ok := p.methods[strings.ToUpper(method)]
+	return ok
+}
+
+// allowHeaders returns true if all the headers of the comma separated list are allowed
+func (p *corsPolicy) allowHeaders(value []byte) bool {
+	if p.anyHeader {
+		return true
+	}
+
+	for _, header := range bytes.Split(value, []byte(",")) {
+		header = bytes.TrimSpace(header)
+		if len(header) == 0 {
+			continue
+		}
+
+		if _, ok := p.headers[strings.ToLower(hack.SliceToString(header))]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// preflight answers the preflight request, returns false if the request is not allowed
+func (p *corsPolicy) preflight(ctx *fasthttp.RequestCtx) bool {
+	res := &ctx.Response
+	addVary(res, originHeader)
+	addVary(res, accessControlRequestMethodHeader)
+	addVary(res, accessControlRequestHeadersHeader)
+
+	origin := string(ctx.Request.Header.Peek(originHeader))
+	method := string(ctx.Request.Header.Peek(accessControlRequestMethodHeader))
+	headers := ctx.Request.Header.Peek(accessControlRequestHeadersHeader)
+	if !p.allowOrigin(origin) || !p.allowMethod(method) || !p.allowHeaders(headers) {
+		ctx.SetStatusCode(fasthttp.StatusForbidden)
+		return false
+	}
+
+	p.setAllowOrigin(res, origin)
+	res.Header.Set(accessControlAllowMethodsHeader, method)
+	if len(headers) > 0 {
+		res.Header.SetBytesV(accessControlAllowHeadersHeader, headers)
+	}
+	if p.maxAge != "" {
+		res.Header.Set(accessControlMaxAgeHeader, p.maxAge)
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusNoContent)
+	return true
+}
+
+// apply adds the cors headers to the response of the actual request
+func (p *corsPolicy) apply(ctx *fasthttp.RequestCtx) {
+	res := &ctx.Response
+	for _, header := range corsResponseHeaders {
+		res.Header.Del(header)
+	}
+
+	if !p.anyOrigin || p.meta.AllowCredentials {
+		addVary(res, originHeader)
+	}
+
+	origin := ctx.Request.Header.Peek(originHeader)
+	if len(origin) == 0 || !p.allowOrigin(hack.SliceToString(origin)) {
+		return
+	}
+
+	p.setAllowOrigin(res, hack.SliceToString(origin))
+	if p.exposeHeaders != "" {
+		res.Header.Set(accessControlExposeHeadersHeader, p.exposeHeaders)
+	}
+}
+
+// setAllowOrigin use * if any origin is allowed without credentials, otherwise the origin
+func (p *corsPolicy) setAllowOrigin(res *fasthttp.Response, origin string) {
+	if p.anyOrigin && !p.meta.AllowCredentials {
+		res.Header.Set(accessControlAllowOriginHeader, "*")
+		return
+	}
+
+	res.Header.Set(accessControlAllowOriginHeader, origin)
+	if p.meta.AllowCredentials {
+		res.Header.Set(accessControlAllowCredentialsHeader, "true")
+	}
+}
+
+// servePreflight answers the cors preflight request by the cors policy of the api,
+// the preflight request is not sent to the backend server
+func (p *Proxy) servePreflight(ctx *fasthttp.RequestCtx, api *apiRuntime, requestTag string) {
+	if !api.cors.preflight(ctx) {
+		log.Infof("%s: cors preflight of api %s not allowed, return with 403",
+			requestTag,
+			api.meta.Name)
+		return
+	}
+
+	log.Infof("%s: cors preflight of api %s allowed",
+		requestTag,
+		api.meta.Name)
+}
+
+// isCORSPreflight returns true if the request is a cors preflight request
+func isCORSPreflight(ctx *fasthttp.RequestCtx) bool {
+	return ctx.IsOptions() &&
+		len(ctx.Request.Header.Peek(originHeader)) > 0 &&
+		len(ctx.Request.Header.Peek(accessControlRequestMethodHeader)) > 0
+}
+
+func addVary(res *fasthttp.Response, name string) {
+	if !bytes.Contains(bytes.ToLower(res.Header.Peek(varyHeader)), []byte(strings.ToLower(name))) {
+		res.Header.Add(varyHeader, name)
+	}
+}
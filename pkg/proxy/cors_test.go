@@ -0,0 +1,261 @@
+package proxy
+
+import (
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+// doCORSRequest sends the request to the proxy with the header pairs
+func doCORSRequest(p *Proxy, method, uri string, headers ...string) *fasthttp.Response {
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.Header.SetMethod(method)
+	req.SetRequestURI(uri)
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(req, nil, nil)
+	p.ServeFastHTTP(ctx)
+
+	res := fasthttp.AcquireResponse()
+	ctx.Response.CopyTo(res)
+	return res
+}
+
+func varyOf(res *fasthttp.Response) string {
+	var values []string
+	res.Header.VisitAll(func(key, value []byte) {
+		if strings.EqualFold(string(key), varyHeader) {
+			values = append(values, string(value))
+		}
+	})
+	return strings.Join(values, ", ")
+}
+
+func TestCORSPreflight(t *testing.T) {
+	policy := newCORSPolicy(&metapb.CORSPolicy{
+		AllowOrigins: []string{"https://*.a.com"},
+		AllowMethods: []string{"GET", "put"},
+		AllowHeaders: []string{"X-Token"},
+		MaxAge:       600,
+	})
+
+	cases := []struct {
+		name    string
+		origin  string
+		method  string
+		headers string
+		allowed bool
+	}{
+		{"allowed", "https://b.a.com", "PUT", "x-token", true},
+		{"allowed without headers", "https://b.a.com", "GET", "", true},
+		{"origin denied", "https://evil.com", "GET", "", false},
+		{"partial label denied", "https://evila.com", "GET", "", false},
+		{"method denied", "https://b.a.com", "DELETE", "", false},
+		{"headers denied", "https://b.a.com", "GET", "X-Token, X-Other", false},
+	}
+
+	for _, c := range cases {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.SetMethod("OPTIONS")
+		ctx.Request.Header.Set(originHeader, c.origin)
+		ctx.Request.Header.Set(accessControlRequestMethodHeader, c.method)
+		if c.headers != "" {
+			ctx.Request.Header.Set(accessControlRequestHeadersHeader, c.headers)
+		}
+
+		if !isCORSPreflight(ctx) {
+			t.Errorf("%s: expect a preflight request", c.name)
+		}
+
+		res := &ctx.Response
+		if policy.preflight(ctx) != c.allowed {
+			t.Errorf("%s: expect allowed %+v, but not", c.name, c.allowed)
+		}
+		if vary := varyOf(res); vary != "Origin, Access-Control-Request-Method, Access-Control-Request-Headers" {
+			t.Errorf("%s: expect the vary of the preflight, but %q", c.name, vary)
+		}
+
+		if !c.allowed {
+			if res.StatusCode() != fasthttp.StatusForbidden || len(res.Header.Peek(accessControlAllowOriginHeader)) > 0 {
+				t.Errorf("%s: expect 403 without the allow origin, but %d %s", c.name, res.StatusCode(), res.Header.Peek(accessControlAllowOriginHeader))
+			}
+			continue
+		}
+
+		if res.StatusCode() != fasthttp.StatusNoContent ||
+			string(res.Header.Peek(accessControlAllowOriginHeader)) != c.origin ||
+			string(res.Header.Peek(accessControlAllowMethodsHeader)) != c.method ||
+			string(res.Header.Peek(accessControlAllowHeadersHeader)) != c.headers ||
+			string(res.Header.Peek(accessControlMaxAgeHeader)) != "600" ||
+			len(res.Header.Peek(accessControlAllowCredentialsHeader)) > 0 {
+			t.Errorf("%s: expect the preflight allowed, but %s", c.name, res.Header.String())
+		}
+	}
+}
+
+func TestCORSAllowOrigin(t *testing.T) {
+	cases := []struct {
+		name        string
+		policy      *metapb.CORSPolicy
+		origin      string
+		expect      string
+		credentials bool
+		vary        bool
+	}{
+		{"any origin", &metapb.CORSPolicy{AllowOrigins: []string{"*"}}, "https://a.com", "*", false, false},
+		{"any origin with the other", &metapb.CORSPolicy{AllowOrigins: []string{"https://b.com", "*"}}, "https://a.com", "*", false, false},
+		{"exact origin", &metapb.CORSPolicy{AllowOrigins: []string{"https://a.com"}}, "https://a.com", "https://a.com", false, true},
+		{"credentials echo the origin", &metapb.CORSPolicy{AllowOrigins: []string{"https://*.a.com"}, AllowCredentials: true}, "https://b.a.com", "https://b.a.com", true, true},
+		{"port wildcard", &metapb.CORSPolicy{AllowOrigins: []string{"http://127.0.0.1:*"}}, "http://127.0.0.1:8080", "http://127.0.0.1:8080", false, true},
+		{"origin denied", &metapb.CORSPolicy{AllowOrigins: []string{"https://a.com"}, AllowCredentials: true}, "https://b.com", "", false, true},
+		{"without origin", &metapb.CORSPolicy{AllowOrigins: []string{"https://a.com"}}, "", "", false, true},
+	}
+
+	for _, c := range cases {
+		ctx := &fasthttp.RequestCtx{}
+		if c.origin != "" {
+			ctx.Request.Header.Set(originHeader, c.origin)
+		}
+		for _, header := range corsResponseHeaders {
+			ctx.Response.Header.Set(header, "backend")
+		}
+
+		c.policy.ExposeHeaders = []string{"X-Total", "X-Page"}
+		newCORSPolicy(c.policy).apply(ctx)
+
+		res := &ctx.Response
+		if value := string(res.Header.Peek(accessControlAllowOriginHeader)); value != c.expect {
+			t.Errorf("%s: expect allow origin %q, but %q", c.name, c.expect, value)
+		}
+		if value := string(res.Header.Peek(accessControlAllowCredentialsHeader)); (value == "true") != c.credentials {
+			t.Errorf("%s: expect allow credentials %+v, but %q", c.name, c.credentials, value)
+		}
+		if vary := varyOf(res); (vary == originHeader) != c.vary {
+			t.Errorf("%s: expect vary origin %+v, but %q", c.name, c.vary, vary)
+		}
+
+		expose := ""
+		if c.expect != "" {
+			expose = "X-Total, X-Page"
+		}
+		if value := string(res.Header.Peek(accessControlExposeHeadersHeader)); value != expose {
+			t.Errorf("%s: expect expose headers %q, but %q", c.name, expose, value)
+		}
+
+		for _, header := range []string{accessControlAllowMethodsHeader, accessControlAllowHeadersHeader, accessControlMaxAgeHeader} {
+			if value := res.Header.Peek(header); len(value) > 0 {
+				t.Errorf("%s: expect the backend %s removed, but %s", c.name, header, value)
+			}
+		}
+	}
+}
+
+func TestPreflightAPI(t *testing.T) {
+	p := newTestProxy(t, &Option{}, nil)
+	defer p.GracefulStop()
+
+	users := newTestAPI("/users")
+	users.Method = "GET"
+	users.CORS = &metapb.CORSPolicy{AllowOrigins: []string{"https://a.com"}}
+	orders := newTestAPI("/orders")
+	orders.ID = 2
+	orders.Name = "orders"
+	p.dispatcher.addAPI(users)
+	p.dispatcher.addAPI(orders)
+
+	cases := []struct {
+		path   string
+		method string
+		expect uint64
+	}{
+		{"/users", "GET", 1},
+		{"/users", "get", 1},
+		{"/users", "PUT", 0},
+		{"/orders", "GET", 0},
+		{"/others", "GET", 0},
+	}
+
+	for _, c := range cases {
+		req := fasthttp.AcquireRequest()
+		req.Header.SetMethod("OPTIONS")
+		req.SetRequestURI("http://gw" + c.path)
+		req.Header.Set(accessControlRequestMethodHeader, c.method)
+
+		api := p.dispatcher.preflightAPI(req)
+		if c.expect == 0 && api != nil {
+			t.Errorf("%s %s: expect no preflight api, but %s", c.method, c.path, api.meta.Name)
+		} else if c.expect != 0 && (api == nil || api.meta.ID != c.expect) {
+			t.Errorf("%s %s: expect preflight api %d, but %+v", c.method, c.path, c.expect, api)
+		}
+		fasthttp.ReleaseRequest(req)
+	}
+}
+
+func TestCORSProxy(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+
+	// the headers of the aggregated response are copied from the backend server
+	api := newTestAPI("/cors")
+	api.Nodes = []*metapb.DispatchNode{{ClusterID: 1, AttrName: "a"}, {ClusterID: 1, AttrName: "b"}}
+	api.CORS = &metapb.CORSPolicy{
+		AllowOrigins:     []string{"https://*.a.com"},
+		AllowMethods:     []string{"GET"},
+		AllowCredentials: true,
+	}
+
+	p := newTestProxy(t, &Option{}, api, testServerAddr(b.URL))
+	defer p.GracefulStop()
+
+	backendHeaders := url.Values{}
+	for _, header := range corsResponseHeaders {
+		backendHeaders.Set(header, "backend")
+	}
+	uri := "http://gw/cors?" + backendHeaders.Encode()
+
+	res := doCORSRequest(p, "OPTIONS", uri, originHeader, "https://b.a.com", accessControlRequestMethodHeader, "GET")
+	if res.StatusCode() != fasthttp.StatusNoContent ||
+		string(res.Header.Peek(accessControlAllowOriginHeader)) != "https://b.a.com" ||
+		string(res.Header.Peek(accessControlAllowCredentialsHeader)) != "true" {
+		t.Errorf("expect the preflight allowed, but %s", res.Header.String())
+	}
+
+	res = doCORSRequest(p, "OPTIONS", uri, originHeader, "https://evil.com", accessControlRequestMethodHeader, "GET")
+	if res.StatusCode() != fasthttp.StatusForbidden {
+		t.Errorf("expect the preflight denied, but %d", res.StatusCode())
+	}
+	if hits := atomic.LoadInt64(&b.hits); hits != 0 {
+		t.Errorf("expect the preflight not sent to the backend, but %d", hits)
+	}
+
+	res = doCORSRequest(p, "GET", uri, originHeader, "https://b.a.com")
+	if res.StatusCode() != fasthttp.StatusOK ||
+		string(res.Header.Peek(accessControlAllowOriginHeader)) != "https://b.a.com" ||
+		string(res.Header.Peek(accessControlAllowCredentialsHeader)) != "true" ||
+		varyOf(res) != originHeader {
+		t.Errorf("expect the cors headers of the policy, but %s", res.Header.String())
+	}
+	for _, header := range corsResponseHeaders {
+		if string(res.Header.Peek(header)) == "backend" {
+			t.Errorf("expect the backend %s removed, but not", header)
+		}
+	}
+
+	res = doCORSRequest(p, "GET", uri, originHeader, "https://evil.com")
+	if res.StatusCode() != fasthttp.StatusOK {
+		t.Errorf("expect the actual request is served, but %d", res.StatusCode())
+	}
+	for _, header := range corsResponseHeaders {
+		if value := res.Header.Peek(header); len(value) > 0 {
+			t.Errorf("expect no %s of the denied origin, but %s", header, value)
+		}
+	}
+}
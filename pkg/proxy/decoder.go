@@ -0,0 +1,79 @@
+package proxy
+
+import (
+	"bytes"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+)
+
+var (
+	jsonContentType     = []byte("json")
+	xmlContentType      = []byte("xml")
+	formContentType     = []byte("application/x-www-form-urlencoded")
+	textContentType     = []byte("text/")
+	protobufContentType = []byte("protobuf")
+)
+
+type decodeFunc func([]byte) ([]byte, error)
+
+// decoder returns the decoder of the response, the auto decoder is only used
+// if the response is merged or rendered
+func (n *apiNode) decoder(contentType []byte, merged bool) decodeFunc {
+	switch n.meta.Decoder {
+	case metapb.DecodeNone:
+		return nil
+	case metapb.DecodeXML:
+		return util.XMLToJSON
+	case metapb.DecodeForm:
+		return util.FormToJSON
+	case metapb.DecodeText:
+		return util.TextToJSON
+	case metapb.DecodeProtobuf:
+		return n.protobufDecoder.Decode
+	}
+
+	if !merged {
+		return nil
+	}
+
+	contentType = bytes.ToLower(contentType)
+	switch {
+	case bytes.Contains(contentType, jsonContentType):
+		return nil
+	case bytes.Contains(contentType, xmlContentType):
+		return util.XMLToJSON
+	case bytes.HasPrefix(contentType, formContentType):
+		return util.FormToJSON
+	case bytes.Contains(contentType, protobufContentType):
+		if n.protobufDecoder != nil {
+			return n.protobufDecoder.Decode
+		}
+	case bytes.HasPrefix(contentType, textContentType):
+		return util.TextToJSON
+	}
+
+	return nil
+}
+
+// decodeResponse convert the response into json before it is merged and rendered
+func (dn *dispatchNode) decodeResponse() error {
+	if dn.res == nil || dn.stream != nil {
+		return nil
+	}
+
+	merged := dn.multiCtx != nil || dn.element || dn.api.hasRenderTemplate()
+	decoder := dn.node.decoder(dn.res.Header.ContentType(), merged)
+	if decoder == nil {
+		return nil
+	}
+
+	value, err := decoder(dn.res.Body())
+	if err != nil {
+		return err
+	}
+
+	dn.res.SetBody(value)
+	dn.res.Header.SetContentType(MultiResultsContentType)
+	return nil
+}
@@ -1,7 +1,8 @@
 package proxy
 
 import (
-	"sync"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/fagongzi/gateway/pkg/plugin"
@@ -24,11 +25,17 @@ type copyReq struct {
 	node       *apiNode
 	to         *serverRuntime
 	params     map[string][]byte
+	depend     []byte
 	idx        int
 	requestTag string
+	routing    *metapb.Routing
+	primary    *shadowResponse
 }
 
 func (req *copyReq) prepare() {
+	ctx := req.exprCtx()
+	req.node.rewriteRequest(req.origin, ctx)
+
 	if req.needRewrite() {
 		// if not use rewrite, it only change uri path and query string
 		realPath := req.rewriteURL()
@@ -42,17 +49,35 @@ func (req *copyReq) prepare() {
 				realPath)
 		}
 	}
+
+	// the copy request is transformed as the request sent by the transform filter
+	for _, t := range req.node.transformations {
+		if t.isRequest() {
+			t.applyRequest(req.origin, ctx)
+		}
+	}
 }
 
 func (req *copyReq) needRewrite() bool {
 	return req.node.meta.URLRewrite != ""
 }
 
-func (req *copyReq) rewriteURL() string {
+func (req *copyReq) exprCtx() *expr.Ctx {
 	ctx := &expr.Ctx{}
 	ctx.Origin = req.origin
 	ctx.Params = req.params
-	return hack.SliceToString(expr.Exec(ctx, req.node.parsedExprs...))
+	ctx.Depend = req.depend
+	return ctx
+}
+
+func (req *copyReq) rewriteURL() string {
+	return hack.SliceToString(expr.Exec(req.exprCtx(), req.node.parsedExprs...))
+}
+
+type nodeError struct {
+	Attr  string `json:"attr"`
+	Code  int    `json:"code"`
+	Error string `json:"error"`
 }
 
 type dispatchNode struct {
@@ -60,17 +85,22 @@ type dispatchNode struct {
 	ctx      *fasthttp.RequestCtx
 	multiCtx *multiContext
 	exprCtx  *expr.Ctx
-	wg       *sync.WaitGroup
-
-	requestTag string
-	idx        int
-	api        *apiRuntime
-	node       *apiNode
-	dest       *serverRuntime
-	copyTo     *serverRuntime
-	res        *fasthttp.Response
-	err        error
-	code       int
+	element  bool
+
+	requestTag  string
+	idx         int
+	api         *apiRuntime
+	node        *apiNode
+	dest        *serverRuntime
+	cluster     uint64
+	rollout     bool
+	copyTo      *serverRuntime
+	copyRouting *metapb.Routing
+	res         *fasthttp.Response
+	stream      *util.BodyStream
+	sse         bool
+	err         error
+	code        int
 }
 
 func (dn *dispatchNode) setHost(forwardReq *fasthttp.Request) {
@@ -123,10 +153,39 @@ func (dn *dispatchNode) hasDefaultValue() bool {
 	return dn.node.meta.DefaultValue != nil
 }
 
+// isFailed returns true if the node has error and has no default value
+func (dn *dispatchNode) isFailed() bool {
+	return dn.hasError() && !dn.hasDefaultValue()
+}
+
+// errorObject returns the json object of the error of the failed node. The error
+// may contain the address of the backend server, so only the status text is returned
+// to the client, the error is logged where it occurred.
+func (dn *dispatchNode) errorObject() []byte {
+	value := &nodeError{
+		Attr: dn.node.meta.AttrName,
+		Code: dn.code,
+	}
+	if value.Code == 0 && dn.res != nil {
+		value.Code = dn.res.StatusCode()
+	}
+	if value.Code == 0 {
+		value.Code = fasthttp.StatusInternalServerError
+	}
+	value.Error = fasthttp.StatusMessage(value.Code)
+
+	data, _ := json.Marshal(value)
+	return data
+}
+
 func (dn *dispatchNode) release() {
 	if nil != dn.res {
 		fasthttp.ReleaseResponse(dn.res)
 	}
+
+	if nil != dn.stream {
+		dn.stream.Close()
+	}
 }
 
 func (dn *dispatchNode) needRewrite() bool {
@@ -177,59 +236,83 @@ func (dn *dispatchNode) copyHeaderTo(ctx *fasthttp.RequestCtx) {
 
 func (dn *dispatchNode) maybeDone() {
 	if nil != dn.multiCtx {
-		dn.multiCtx.completePart(dn.node.meta.AttrName, dn.getResponseBody())
-		if nil != dn.wg {
-			dn.wg.Done()
+		if !dn.isFailed() {
+			dn.multiCtx.completePart(dn.node.meta.AttrName, dn.getResponseBody())
+		} else if dn.node.meta.FailurePolicy == metapb.FailureOptionalWithError {
+			dn.multiCtx.completePart(dn.node.meta.AttrName, dn.errorObject())
 		}
+		dn.multiCtx.completeNode(dn)
+	}
+}
+
+// skip completes the node without sending the request, the depend of
+// the node is failed and it is required
+func (dn *dispatchNode) skip(dep *dispatchNode) {
+	dn.err = ErrDependFailed
+	dn.code = dep.code
+	if dn.code < fasthttp.StatusBadRequest {
+		dn.code = fasthttp.StatusInternalServerError
 	}
+
+	log.Infof("%s: dispatch node %d skipped, depend node %d failed",
+		dn.requestTag,
+		dn.idx,
+		dep.idx)
+	dn.maybeDone()
 }
 
 type dispatcher struct {
-	cnf            *Cfg
-	routings       map[uint64]*routingRuntime
-	route          *route.Route
-	apis           map[uint64]*apiRuntime
+	cnf      *Cfg
+	routings map[uint64]*routingRuntime
+	route    *route.Route
+	apis     map[uint64]*apiRuntime
+	// the fields of the graphql query type, rebuilt when the apis changed
+	graphQLSchema  map[string]*apiRuntime
 	clusters       map[uint64]*clusterRuntime
 	servers        map[uint64]*serverRuntime
 	binds          map[uint64]*binds
 	proxies        map[string]*metapb.Proxy
 	plugins        map[uint64]*metapb.Plugin
 	appliedPlugins *metapb.AppliedPlugins
-	jsEngineFunc   func(*plugin.Engine)
-	checkerC       chan uint64
-	watchStopC     chan bool
-	watchEventC    chan *store.Evt
-	analysiser     *util.Analysis
-	store          store.Store
-	httpClient     *util.FastHTTPClient
-	tw             *goetty.TimeoutWheel
-	runner         *task.Runner
+	// the global ip access control of all the apis
+	ipAccessControl *ipAccessControlRuntime
+	jsEngineFunc    func(*plugin.Engine)
+	checkerC        chan uint64
+	watchStopC      chan bool
+	watchEventC     chan *store.Evt
+	analysiser      *util.Analysis
+	store           store.Store
+	httpClient      *util.FastHTTPClient
+	tw              *goetty.TimeoutWheel
+	runner          *task.Runner
 }
 
 func newDispatcher(cnf *Cfg, db store.Store, runner *task.Runner, jsEngineFunc func(*plugin.Engine)) *dispatcher {
 	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Second))
 	rt := &dispatcher{
-		cnf:          cnf,
-		tw:           tw,
-		store:        db,
-		runner:       runner,
-		analysiser:   util.NewAnalysis(tw),
-		httpClient:   util.NewFastHTTPClient(),
-		clusters:     make(map[uint64]*clusterRuntime),
-		servers:      make(map[uint64]*serverRuntime),
-		route:        route.NewRoute(),
-		apis:         make(map[uint64]*apiRuntime),
-		routings:     make(map[uint64]*routingRuntime),
-		binds:        make(map[uint64]*binds),
-		proxies:      make(map[string]*metapb.Proxy),
-		plugins:      make(map[uint64]*metapb.Plugin),
-		jsEngineFunc: jsEngineFunc,
-		checkerC:     make(chan uint64, 1024),
-		watchStopC:   make(chan bool),
-		watchEventC:  make(chan *store.Evt),
+		cnf:           cnf,
+		tw:            tw,
+		store:         db,
+		runner:        runner,
+		analysiser:    util.NewAnalysis(tw),
+		httpClient:    util.NewFastHTTPClient(),
+		clusters:      make(map[uint64]*clusterRuntime),
+		servers:       make(map[uint64]*serverRuntime),
+		route:         route.NewRoute(),
+		apis:          make(map[uint64]*apiRuntime),
+		graphQLSchema: make(map[string]*apiRuntime),
+		routings:      make(map[uint64]*routingRuntime),
+		binds:         make(map[uint64]*binds),
+		proxies:       make(map[string]*metapb.Proxy),
+		plugins:       make(map[uint64]*metapb.Plugin),
+		jsEngineFunc:  jsEngineFunc,
+		checkerC:      make(chan uint64, 1024),
+		watchStopC:    make(chan bool),
+		watchEventC:   make(chan *store.Evt),
 	}
 
 	rt.readyToHeathChecker()
+	rt.readyToRollout()
 	return rt
 }
 
@@ -272,16 +355,42 @@ func (r *dispatcher) dispatch(reqCtx *fasthttp.RequestCtx, requestTag string) (*
 	return targetAPI, dispatches, exprCtx
 }
 
+// preflightAPI returns the api with the cors policy of the actual request of the cors preflight request
+func (r *dispatcher) preflightAPI(req *fasthttp.Request) *apiRuntime {
+	method := strings.ToUpper(string(req.Header.Peek(accessControlRequestMethodHeader)))
+
+	exprCtx := acquireExprCtx()
+	defer releaseExprCtx(exprCtx)
+
+	id, ok := r.route.Find(req.URI().Path(), method, exprCtx.AddParam)
+	if !ok {
+		return nil
+	}
+
+	api, ok := r.apis[id]
+	if !ok || api.cors == nil || !api.matchesMethod(req, method) {
+		return nil
+	}
+
+	return api
+}
+
 func (r *dispatcher) selectServer(reqCtx *fasthttp.RequestCtx, dn *dispatchNode, requestTag string) {
 	dn.dest = r.selectServerFromCluster(reqCtx, dn.node.meta.ClusterID)
+	dn.cluster = dn.node.meta.ClusterID
+	dn.rollout = false
 	r.adjustByRouting(dn.api.meta.ID, reqCtx, dn, requestTag)
 }
 
 func (r *dispatcher) adjustByRouting(apiID uint64, reqCtx *fasthttp.RequestCtx, dn *dispatchNode, requestTag string) {
 	routings := r.routings
+	if len(routings) == 0 {
+		return
+	}
 
+	clientIP := util.ClientIP(reqCtx)
 	for _, routing := range routings {
-		if routing.isUp() && routing.matches(apiID, &reqCtx.Request, requestTag) {
+		if routing.isUp() && routing.matches(apiID, &reqCtx.Request, clientIP, requestTag) {
 			log.Infof("%s: match routing %s, %s traffic to cluster %d",
 				requestTag,
 				routing.meta.Name,
@@ -293,11 +402,21 @@ func (r *dispatcher) adjustByRouting(apiID uint64, reqCtx *fasthttp.RequestCtx,
 			switch routing.meta.Strategy {
 			case metapb.Split:
 				dn.dest = svr
+				dn.cluster = routing.meta.ClusterID
+				dn.rollout = routing.isRollingOut()
 			case metapb.Copy:
 				dn.copyTo = svr
+				dn.copyRouting = routing.meta
 			}
 			break
 		}
+
+		// the request not split to the canary cluster is sent to the baseline cluster
+		if routing.isRollingOut() &&
+			(routing.meta.API == 0 || routing.meta.API == apiID) &&
+			routing.meta.Rollout.BaselineClusterID == dn.cluster {
+			dn.rollout = true
+		}
 	}
 }
 
@@ -48,6 +48,8 @@ func (r *dispatcher) readyToReceiveWatchEvent() {
 			r.doPluginEvent(evt)
 		} else if evt.Src == store.EventSrcApplyPlugin {
 			r.doApplyPluginEvent(evt)
+		} else if evt.Src == store.EventSrcIPAccessControl {
+			r.doIPAccessControlEvent(evt)
 		} else if evt.Src == eventSrcStatusChanged {
 			r.doStatusChangedEvent(evt)
 		} else {
@@ -148,6 +150,18 @@ func (r *dispatcher) doApplyPluginEvent(evt *store.Evt) {
 	}
 }
 
+func (r *dispatcher) doIPAccessControlEvent(evt *store.Evt) {
+	value, _ := evt.Value.(*metapb.IPAccessControl)
+
+	if evt.Type == store.EventTypeNew {
+		r.updateIPAccessControl(value)
+	} else if evt.Type == store.EventTypeDelete {
+		r.removeIPAccessControl()
+	} else if evt.Type == store.EventTypeUpdate {
+		r.updateIPAccessControl(value)
+	}
+}
+
 func (r *dispatcher) doStatusChangedEvent(evt *store.Evt) {
 	value := evt.Value.(statusChanged)
 	oldStatus := r.getServerStatus(value.meta.ID)
@@ -40,6 +40,7 @@ func (r *dispatcher) load() {
 	r.loadRoutings()
 	r.loadPlugins()
 	r.loadAppliedPlugins()
+	r.loadIPAccessControl()
 }
 
 func (r *dispatcher) loadProxies() {
@@ -164,6 +165,19 @@ func (r *dispatcher) loadAppliedPlugins() {
 	}
 }
 
+func (r *dispatcher) loadIPAccessControl() {
+	log.Infof("load global ip access control")
+
+	value, err := r.store.GetIPAccessControl()
+	if nil != err {
+		log.Errorf("load global ip access control failed, errors:\n%+v",
+			err)
+		return
+	}
+
+	r.updateIPAccessControl(value)
+}
+
 func (r *dispatcher) addRouting(meta *metapb.Routing) error {
 	if _, ok := r.routings[meta.ID]; ok {
 		return errRoutingExists
@@ -172,6 +186,7 @@ func (r *dispatcher) addRouting(meta *metapb.Routing) error {
 	newValues := r.copyRoutings(0)
 	newValues[meta.ID] = newRoutingRuntime(meta)
 	r.routings = newValues
+	r.addRolloutAnalysis(meta)
 	log.Infof("routing <%d> added, data <%s>",
 		meta.ID,
 		meta.String())
@@ -189,6 +204,7 @@ func (r *dispatcher) updateRouting(meta *metapb.Routing) error {
 	rt = newValues[meta.ID]
 	rt.updateMeta(meta)
 	r.routings = newValues
+	r.addRolloutAnalysis(meta)
 
 	log.Infof("routing <%d> updated, data <%s>",
 		meta.ID,
@@ -257,6 +273,7 @@ func (r *dispatcher) addAPI(api *metapb.API) error {
 
 	r.apis = newValues
 	r.route = newRoute
+	r.graphQLSchema = newGraphQLSchema(newValues)
 	log.Infof("api <%d> added, data <%s>",
 		api.ID,
 		api.String())
@@ -286,6 +303,7 @@ func (r *dispatcher) updateAPI(api *metapb.API) error {
 
 	r.apis = newValues
 	r.route = newRoute
+	r.graphQLSchema = newGraphQLSchema(newValues)
 	log.Infof("api <%d> updated, data <%s>",
 		api.ID,
 		api.String())
@@ -301,6 +319,7 @@ func (r *dispatcher) removeAPI(id uint64) error {
 	newRoute, newValues := r.copyAPIs(id, 0)
 	r.route = newRoute
 	r.apis = newValues
+	r.graphQLSchema = newGraphQLSchema(newValues)
 
 	log.Infof("api <%d> removed", id)
 	return nil
@@ -615,6 +634,18 @@ func (r *dispatcher) removeAppliedPlugin() error {
 	return nil
 }
 
+func (r *dispatcher) updateIPAccessControl(value *metapb.IPAccessControl) {
+	r.ipAccessControl = newIPAccessControlRuntime(value)
+	log.Infof("global ip access control updated with whitelist %+v, blacklist %+v",
+		value.Whitelist,
+		value.Blacklist)
+}
+
+func (r *dispatcher) removeIPAccessControl() {
+	r.ipAccessControl = nil
+	log.Infof("global ip access control removed")
+}
+
 func (r *dispatcher) maybeUpdateJSEngine(id uint64) error {
 	if r.inAppliedPlugins(id) {
 		return r.updateJSEngine()
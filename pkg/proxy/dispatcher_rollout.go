@@ -0,0 +1,159 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/log"
+	pbutil "github.com/fagongzi/util/protoc"
+)
+
+const (
+	rolloutCheckInterval = time.Second
+)
+
+// readyToRollout check the progressive rollouts of the routings. Every proxy
+// checks the rollouts with its own analysis data, and the routing in store is
+// updated by compare and swap, so only one proxy can change a step.
+func (r *dispatcher) readyToRollout() {
+	r.runner.RunCancelableTask(func(ctx context.Context) {
+		ticker := time.NewTicker(rolloutCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, rt := range r.routings {
+					if rt.meta.Rollout != nil {
+						r.checkRollout(rt.meta, time.Now())
+					}
+				}
+			}
+		}
+	})
+}
+
+// addRolloutAnalysis analysis the canary and the baseline cluster
+func (r *dispatcher) addRolloutAnalysis(meta *metapb.Routing) {
+	if meta.Rollout == nil {
+		return
+	}
+
+	period := time.Duration(meta.Rollout.CheckPeriod)
+	r.analysiser.AddTarget(meta.ClusterID, period)
+	r.analysiser.AddTarget(meta.Rollout.BaselineClusterID, period)
+}
+
+func (r *dispatcher) checkRollout(meta *metapb.Routing, now time.Time) {
+	if meta.Status != metapb.Up ||
+		meta.Rollout.Status.State != metapb.RolloutRunning {
+		return
+	}
+
+	value := &metapb.Routing{}
+	pbutil.MustUnmarshal(value, pbutil.MustMarshal(meta))
+	rollout := value.Rollout
+	status := &rollout.Status
+
+	if status.StepStartAt == 0 {
+		rolloutTo(value, 0, now, "start")
+	} else if reason, ok := r.rolloutBreached(meta); ok {
+		value.Status = metapb.Down
+		status.State = metapb.RolloutRolledBack
+		addRolloutEvent(value, now, reason)
+	} else if now.Sub(time.Unix(0, status.StepStartAt)) >= time.Duration(rollout.StepInterval) {
+		next := status.Step + 1
+		if int(next) < len(rollout.Steps) {
+			rolloutTo(value, next, now, "promote")
+		} else {
+			status.State = metapb.RolloutSucceeded
+			addRolloutEvent(value, now, "completed")
+		}
+	} else {
+		return
+	}
+
+	err := r.store.UpdateRouting(meta, value)
+	if err == store.ErrStaleOP {
+		log.Infof("rollout: routing <%d> is changed by others, skip",
+			meta.ID)
+		return
+	}
+	if err != nil {
+		log.Errorf("rollout: update routing <%d> failed, errors:\n%+v",
+			meta.ID,
+			err)
+		return
+	}
+
+	log.Infof("rollout: routing <%d> %s at step %d, traffic rate %d",
+		meta.ID,
+		status.State.String(),
+		status.Step,
+		value.TrafficRate)
+}
+
+// rolloutBreached returns true if the canary cluster is worse than the baseline
+// cluster in the last check period
+func (r *dispatcher) rolloutBreached(meta *metapb.Routing) (string, bool) {
+	rollout := meta.Rollout
+	period := time.Duration(rollout.CheckPeriod)
+	canary := meta.ClusterID
+	baseline := rollout.BaselineClusterID
+
+	requests := r.analysiser.GetRecentlyRequestCount(canary, period)
+	if requests == 0 || int64(requests) < rollout.MinRequests {
+		return "", false
+	}
+
+	if rollout.MaxFailureRateIncrease > 0 {
+		canaryRate := r.analysiser.GetRecentlyRequestFailureRate(canary, period)
+		baselineRate := r.analysiser.GetRecentlyRequestFailureRate(baseline, period)
+		if baselineRate < 0 {
+			baselineRate = 0
+		}
+
+		if canaryRate-baselineRate > int(rollout.MaxFailureRateIncrease) {
+			return fmt.Sprintf("failure rate %d%% is higher than baseline %d%%",
+				canaryRate,
+				baselineRate), true
+		}
+	}
+
+	if rollout.MaxLatencyIncrease > 0 {
+		canaryAvg := r.analysiser.GetRecentlyAvg(canary, period)
+		baselineAvg := r.analysiser.GetRecentlyAvg(baseline, period)
+		if baselineAvg > 0 &&
+			canaryAvg*100 > baselineAvg*(100+int(rollout.MaxLatencyIncrease)) {
+			return fmt.Sprintf("avg latency %dms is higher than baseline %dms",
+				canaryAvg,
+				baselineAvg), true
+		}
+	}
+
+	return "", false
+}
+
+func rolloutTo(value *metapb.Routing, step int32, now time.Time, reason string) {
+	status := &value.Rollout.Status
+	status.Step = step
+	status.StepStartAt = now.UnixNano()
+	value.TrafficRate = value.Rollout.Steps[step]
+	addRolloutEvent(value, now, reason)
+}
+
+func addRolloutEvent(value *metapb.Routing, now time.Time, reason string) {
+	status := &value.Rollout.Status
+	status.History = append(status.History, metapb.RolloutEvent{
+		At:          now.UnixNano(),
+		Step:        status.Step,
+		TrafficRate: value.TrafficRate,
+		State:       status.State,
+		Reason:      reason,
+	})
+}
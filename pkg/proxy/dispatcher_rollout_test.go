@@ -0,0 +1,196 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/goetty"
+	"github.com/valyala/fasthttp"
+)
+
+type rolloutStore struct {
+	store.Store
+
+	err     error
+	updated *metapb.Routing
+}
+
+func (s *rolloutStore) UpdateRouting(old, routing *metapb.Routing) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.updated = routing
+	return nil
+}
+
+func newRolloutDispatcher(err error) (*dispatcher, *rolloutStore) {
+	s := &rolloutStore{err: err}
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	return &dispatcher{
+		store:      s,
+		tw:         tw,
+		analysiser: util.NewAnalysis(tw),
+		routings:   make(map[uint64]*routingRuntime),
+	}, s
+}
+
+func newRollout(state metapb.RolloutState, step int32, startAt time.Time) *metapb.Routing {
+	value := &metapb.Routing{
+		ID:          1,
+		API:         1,
+		ClusterID:   2,
+		Name:        "canary",
+		Strategy:    metapb.Split,
+		TrafficRate: 10,
+		Status:      metapb.Up,
+		Rollout: &metapb.Rollout{
+			Steps:                  []int32{10, 50, 100},
+			StepInterval:           int64(time.Minute),
+			CheckPeriod:            int64(time.Second),
+			BaselineClusterID:      1,
+			MinRequests:            10,
+			MaxFailureRateIncrease: 10,
+		},
+	}
+	value.Rollout.Status.State = state
+	value.Rollout.Status.Step = step
+	if !startAt.IsZero() {
+		value.Rollout.Status.StepStartAt = startAt.UnixNano()
+		value.TrafficRate = value.Rollout.Steps[step]
+	}
+	return value
+}
+
+func TestCheckRollout(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name    string
+		meta    *metapb.Routing
+		state   metapb.RolloutState
+		step    int32
+		rate    int32
+		status  metapb.Status
+		updated bool
+	}{
+		{"start", newRollout(metapb.RolloutRunning, 0, time.Time{}), metapb.RolloutRunning, 0, 10, metapb.Up, true},
+		{"wait", newRollout(metapb.RolloutRunning, 0, now.Add(-time.Second)), metapb.RolloutRunning, 0, 10, metapb.Up, false},
+		{"promote", newRollout(metapb.RolloutRunning, 0, now.Add(-time.Minute)), metapb.RolloutRunning, 1, 50, metapb.Up, true},
+		{"complete", newRollout(metapb.RolloutRunning, 2, now.Add(-time.Minute)), metapb.RolloutSucceeded, 2, 100, metapb.Up, true},
+		{"paused", newRollout(metapb.RolloutPaused, 0, now.Add(-time.Minute)), metapb.RolloutPaused, 0, 10, metapb.Up, false},
+	}
+
+	for _, c := range cases {
+		r, s := newRolloutDispatcher(nil)
+		r.checkRollout(c.meta, now)
+
+		if !c.updated {
+			if s.updated != nil {
+				t.Errorf("%s: expect not updated", c.name)
+			}
+			continue
+		}
+
+		if s.updated == nil {
+			t.Errorf("%s: expect updated", c.name)
+			continue
+		}
+
+		status := s.updated.Rollout.Status
+		if status.State != c.state || status.Step != c.step ||
+			s.updated.TrafficRate != c.rate || s.updated.Status != c.status {
+			t.Errorf("%s: expect %s at step %d with rate %d, but %s at step %d with rate %d",
+				c.name, c.state, c.step, c.rate, status.State, status.Step, s.updated.TrafficRate)
+		}
+
+		if len(status.History) == 0 || status.History[len(status.History)-1].State != c.state {
+			t.Errorf("%s: expect rollout event", c.name)
+		}
+
+		if c.meta.Rollout.Status.State != metapb.RolloutRunning || len(c.meta.Rollout.Status.History) != 0 {
+			t.Errorf("%s: expect the meta is not changed", c.name)
+		}
+	}
+}
+
+func TestCheckRolloutStale(t *testing.T) {
+	r, s := newRolloutDispatcher(store.ErrStaleOP)
+	r.checkRollout(newRollout(metapb.RolloutRunning, 0, time.Time{}), time.Now())
+	if s.updated != nil {
+		t.Errorf("expect not updated by stale op")
+	}
+}
+
+func TestCheckRolloutRollback(t *testing.T) {
+	r, s := newRolloutDispatcher(nil)
+	meta := newRollout(metapb.RolloutRunning, 0, time.Now())
+	period := time.Duration(meta.Rollout.CheckPeriod)
+	r.addRolloutAnalysis(meta)
+
+	for i := 0; i < 20; i++ {
+		r.analysiser.Request(meta.ClusterID)
+		r.analysiser.Request(meta.Rollout.BaselineClusterID)
+		r.analysiser.Response(meta.Rollout.BaselineClusterID, 1)
+		if i%2 == 0 {
+			r.analysiser.Failure(meta.ClusterID)
+		} else {
+			r.analysiser.Response(meta.ClusterID, 1)
+		}
+	}
+
+	deadline := time.Now().Add(time.Second * 5)
+	for r.analysiser.GetRecentlyRequestCount(meta.ClusterID, period) == 0 && time.Now().Before(deadline) {
+		time.Sleep(period / 5)
+	}
+
+	r.checkRollout(meta, time.Now())
+	if s.updated == nil {
+		t.Fatalf("expect rolled back")
+	}
+
+	if s.updated.Status != metapb.Down || s.updated.Rollout.Status.State != metapb.RolloutRolledBack {
+		t.Errorf("expect rolled back, but %s", s.updated.Rollout.Status.State)
+	}
+}
+
+func TestRolloutAnalysisOnlyForRunningRollout(t *testing.T) {
+	r, _ := newRolloutDispatcher(nil)
+	api := &apiRuntime{meta: &metapb.API{ID: 1}}
+	node := &apiNode{meta: &metapb.DispatchNode{ClusterID: 1}}
+	ctx := &fasthttp.RequestCtx{}
+
+	cases := []struct {
+		name    string
+		state   metapb.RolloutState
+		rate    int32
+		cluster uint64
+		rollout bool
+	}{
+		{"canary", metapb.RolloutRunning, 100, 2, true},
+		{"baseline", metapb.RolloutRunning, 0, 1, true},
+		{"succeeded", metapb.RolloutSucceeded, 100, 2, false},
+	}
+
+	for _, c := range cases {
+		meta := newRollout(c.state, 0, time.Time{})
+		meta.TrafficRate = c.rate
+		r.routings = map[uint64]*routingRuntime{meta.ID: newRoutingRuntime(meta)}
+
+		dn := &dispatchNode{api: api, node: node}
+		r.selectServer(ctx, dn, "test")
+		if dn.cluster != c.cluster || dn.rollout != c.rollout {
+			t.Errorf("%s: expect cluster %d and rollout %v, but %d and %v",
+				c.name, c.cluster, c.rollout, dn.cluster, dn.rollout)
+		}
+	}
+
+	r.routings = map[uint64]*routingRuntime{}
+	dn := &dispatchNode{api: api, node: node}
+	r.selectServer(ctx, dn, "test")
+	if dn.rollout {
+		t.Errorf("expect no rollout without routing")
+	}
+}
@@ -1,11 +1,13 @@
 package proxy
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"net"
 	"net/url"
 	"regexp"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -24,6 +26,11 @@ import (
 
 var (
 	dependP = regexp.MustCompile(`\$\w+\.\w+`)
+
+	bearerPrefix = []byte("Bearer ")
+
+	allIPv4 = &net.IPNet{IP: net.IPv4zero.To4(), Mask: net.CIDRMask(0, 8*net.IPv4len)}
+	allIPv6 = &net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 8*net.IPv6len)}
 )
 
 type binds struct {
@@ -174,31 +181,73 @@ func (s *serverRuntime) reset() {
 	s.useCheckDuration = time.Duration(s.meta.HeathCheck.CheckInterval)
 }
 
-type ipSegment struct {
-	value []string
+// ipAccessControlRuntime is the parsed ip access control of the api or the global
+type ipAccessControlRuntime struct {
+	meta      *metapb.IPAccessControl
+	whitelist *util.IPTrie
+	blacklist *util.IPTrie
+}
+
+func newIPAccessControlRuntime(meta *metapb.IPAccessControl) *ipAccessControlRuntime {
+	if meta == nil {
+		return nil
+	}
+
+	return &ipAccessControlRuntime{
+		meta:      meta,
+		whitelist: newIPTrie(meta.Whitelist, false),
+		blacklist: newIPTrie(meta.Blacklist, true),
+	}
 }
 
-func parseFrom(value string) *ipSegment {
-	ip := &ipSegment{}
-	ip.value = strings.Split(value, ".")
-	return ip
+// allowWithBlacklist returns false if the ip is in the blacklist
+func (acl *ipAccessControlRuntime) allowWithBlacklist(ip net.IP) bool {
+	return acl == nil || !acl.blacklist.Contains(ip)
 }
 
-func (ip *ipSegment) matches(value string) bool {
-	tmp := strings.Split(value, ".")
+// allowWithWhitelist returns true if the ip is in the whitelist or no whitelist,
+// the invalid ip is not in the whitelist
+func (acl *ipAccessControlRuntime) allowWithWhitelist(ip net.IP) bool {
+	if acl == nil || len(acl.meta.Whitelist) == 0 {
+		return true
+	}
+
+	return acl.whitelist.Contains(ip)
+}
+
+// newIPTrie returns the ip trie of the values, the invalid values are ignored if
+// failClosed is false, otherwise the trie contains all the ips, so that the blacklist
+// stored by the old versions denies all the ips instead of letting them in
+func newIPTrie(values []string, failClosed bool) *util.IPTrie {
+	trie := util.NewIPTrie()
+	for _, value := range values {
+		nets, err := util.ParseIPValue(value)
+		if err != nil && failClosed {
+			log.Errorf("ip access control value %s is invalid, deny all the ips, errors:\n%+v",
+				value,
+				err)
+			trie.Add(allIPv4)
+			trie.Add(allIPv6)
+			return trie
+		} else if err != nil {
+			log.Errorf("ip access control value %s ignored, errors:\n%+v",
+				value,
+				err)
+			continue
+		}
 
-	for index, v := range ip.value {
-		if v != "*" && v != tmp[index] {
-			return false
+		for _, n := range nets {
+			trie.Add(n)
 		}
 	}
 
-	return true
+	return trie
 }
 
 type apiValidation struct {
-	meta  *metapb.Validation
-	rules []*apiRule
+	meta       *metapb.Validation
+	rules      []*apiRule
+	conditions []*condition
 }
 
 type apiRule struct {
@@ -206,11 +255,19 @@ type apiRule struct {
 }
 
 type apiNode struct {
-	httpOption     util.HTTPOption
-	meta           *metapb.DispatchNode
-	validations    []*apiValidation
-	defaultCookies []*fasthttp.Cookie
-	parsedExprs    []expr.Expr
+	httpOption      util.HTTPOption
+	meta            *metapb.DispatchNode
+	validations     []*apiValidation
+	defaultCookies  []*fasthttp.Cookie
+	parsedExprs     []expr.Expr
+	parsedBodyExprs []expr.Expr
+	jsonBody        bool
+	cacheConditions []*condition
+	transformations []*transformation
+	depends         []int
+	dependents      []int
+	forEachPath     []string
+	protobufDecoder *util.ProtobufDecoder
 }
 
 func newAPINode(meta *metapb.DispatchNode) *apiNode {
@@ -226,6 +283,15 @@ func newAPINode(meta *metapb.DispatchNode) *apiNode {
 		rn.parsedExprs = exprs
 	}
 
+	if meta.BodyTemplate != "" {
+		exprs, err := expr.Parse([]byte(meta.BodyTemplate))
+		if err != nil {
+			log.Fatalf("bug: parse body template expr failed with error %+v", err)
+		}
+		rn.parsedBodyExprs = exprs
+		rn.jsonBody = isJSONTemplate(meta.BodyTemplate, meta.ContentType)
+	}
+
 	if nil != meta.DefaultValue {
 		for _, c := range meta.DefaultValue.Cookies {
 			ck := &fasthttp.Cookie{}
@@ -237,7 +303,8 @@ func newAPINode(meta *metapb.DispatchNode) *apiNode {
 
 	for _, v := range meta.Validations {
 		rv := &apiValidation{
-			meta: v,
+			meta:       v,
+			conditions: newConditions(v.Conditions),
 		}
 
 		for _, r := range v.Rules {
@@ -249,6 +316,22 @@ func newAPINode(meta *metapb.DispatchNode) *apiNode {
 		rn.validations = append(rn.validations, rv)
 	}
 
+	if nil != meta.Cache {
+		rn.cacheConditions = newConditions(meta.Cache.Conditions)
+	}
+
+	if nil != meta.ForEach {
+		rn.forEachPath = strings.Split(meta.ForEach.Path, ".")
+	}
+
+	if nil != meta.Protobuf {
+		decoder, err := util.NewProtobufDecoder(meta.Protobuf.DescriptorSet, meta.Protobuf.MessageType)
+		if err != nil {
+			log.Fatalf("bug: parse protobuf descriptor failed with error %+v", err)
+		}
+		rn.protobufDecoder = decoder
+	}
+
 	rn.httpOption = *globalHTTPOptions
 	if meta.ReadTimeout > 0 {
 		rn.httpOption.ReadTimeout = time.Duration(meta.ReadTimeout)
@@ -262,16 +345,51 @@ func newAPINode(meta *metapb.DispatchNode) *apiNode {
 func (n *apiNode) clone() *apiNode {
 	meta := &metapb.DispatchNode{}
 	pbutil.MustUnmarshal(meta, pbutil.MustMarshal(n.meta))
-	return newAPINode(meta)
+	// the parsed exprs, transformations and protobuf descriptors are never
+	// changed after the node is created, so the clone shares them
+	rn := *n
+	rn.meta = meta
+	return &rn
+}
+
+// rewriteRequest override the method, the content type and the body of the
+// forward request
+func (n *apiNode) rewriteRequest(req *fasthttp.Request, ctx *expr.Ctx) {
+	if n.meta.Method != "" {
+		req.Header.SetMethod(n.meta.Method)
+	}
+
+	if n.meta.BodyTemplate != "" {
+		if n.jsonBody {
+			req.SetBody(expr.ExecJSON(ctx, n.parsedBodyExprs...))
+		} else {
+			req.SetBody(expr.Exec(ctx, n.parsedBodyExprs...))
+		}
+	}
+
+	if n.meta.ContentType != "" {
+		req.Header.SetContentType(n.meta.ContentType)
+	}
+}
+
+// isJSONTemplate returns true if the body template builds a json body, the values
+// of the json body are escaped by the json context
+func isJSONTemplate(template, contentType string) bool {
+	if strings.Contains(strings.ToLower(contentType), "json") {
+		return true
+	}
+
+	value := strings.TrimSpace(template)
+	return strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")
 }
 
-func (n *apiNode) validate(req *fasthttp.Request) bool {
+func (n *apiNode) validate(req *fasthttp.Request, clientIP string) bool {
 	if len(n.validations) == 0 {
 		return true
 	}
 
 	for _, v := range n.validations {
-		if !v.validate(req) {
+		if !v.validate(req, clientIP) {
 			return false
 		}
 	}
@@ -282,6 +400,7 @@ func (n *apiNode) validate(req *fasthttp.Request) bool {
 type renderAttr struct {
 	meta     *metapb.RenderAttr
 	extracts [][]string
+	template *expr.Template
 }
 
 type renderObject struct {
@@ -295,9 +414,9 @@ type apiRuntime struct {
 	meta                *metapb.API
 	nodes               []*apiNode
 	defaultCookies      []*fasthttp.Cookie
-	parsedWhitelist     []*ipSegment
-	parsedBlacklist     []*ipSegment
+	ipAccessControl     *ipAccessControlRuntime
 	parsedRenderObjects []*renderObject
+	cors                *corsPolicy
 }
 
 func newAPIRuntime(meta *metapb.API, tw *goetty.TimeoutWheel, activeQPS int64) *apiRuntime {
@@ -314,7 +433,18 @@ func newAPIRuntime(meta *metapb.API, tw *goetty.TimeoutWheel, activeQPS int64) *
 func (a *apiRuntime) clone() *apiRuntime {
 	meta := &metapb.API{}
 	pbutil.MustUnmarshal(meta, pbutil.MustMarshal(a.meta))
-	return newAPIRuntime(meta, a.tw, a.activeQPS)
+
+	ar := &apiRuntime{
+		meta: meta,
+	}
+	ar.activeQPS = a.activeQPS
+	ar.tw = a.tw
+	// the dispatch nodes are never changed after they are created, the clone
+	// shares them instead of parsing them again
+	ar.nodes = a.nodes
+	ar.initAPI()
+
+	return ar
 }
 
 func (a *apiRuntime) updateMeta(meta *metapb.API) {
@@ -332,13 +462,100 @@ func (a *apiRuntime) compare(i, j int) bool {
 	return a.nodes[i].meta.BatchIndex-a.nodes[j].meta.BatchIndex < 0
 }
 
+// initDepends build the dependency graph of the dispatch nodes by the depend
+// exprs of the url rewrite and the body template, a node with a larger batch
+// index depends on all nodes with a smaller batch index
+func (a *apiRuntime) initDepends() {
+	for i, node := range a.nodes {
+		exprs := append([]expr.Expr(nil), node.parsedExprs...)
+		exprs = append(exprs, node.parsedBodyExprs...)
+		attrs := expr.Depends(exprs...)
+		if node.forEachPath != nil {
+			attrs = append(attrs, node.forEachPath[0])
+		}
+
+		for _, attr := range attrs {
+			for j, dep := range a.nodes {
+				if i != j && dep.meta.AttrName == attr {
+					node.depends = append(node.depends, j)
+				}
+			}
+		}
+
+		for j, dep := range a.nodes {
+			if dep.meta.BatchIndex < node.meta.BatchIndex {
+				node.depends = append(node.depends, j)
+			}
+		}
+	}
+
+	if a.hasCyclicDepends() {
+		log.Errorf("api %s has cyclic depends, only use the batch index",
+			a.meta.Name)
+		for _, node := range a.nodes {
+			node.depends = nil
+			for j, dep := range a.nodes {
+				if dep.meta.BatchIndex < node.meta.BatchIndex {
+					node.depends = append(node.depends, j)
+				}
+			}
+		}
+	}
+
+	for i, node := range a.nodes {
+		for _, j := range node.depends {
+			a.nodes[j].dependents = append(a.nodes[j].dependents, i)
+		}
+	}
+}
+
+func (a *apiRuntime) hasCyclicDepends() bool {
+	// 0: not visited, 1: visiting, 2: visited
+	states := make([]int, len(a.nodes))
+	var visit func(int) bool
+	visit = func(i int) bool {
+		switch states[i] {
+		case 1:
+			return true
+		case 2:
+			return false
+		}
+
+		states[i] = 1
+		for _, j := range a.nodes[i].depends {
+			if visit(j) {
+				return true
+			}
+		}
+		states[i] = 2
+		return false
+	}
+
+	for i := range a.nodes {
+		if visit(i) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *apiRuntime) init() {
+	// the transformations of the api are applied before the dispatch node
+	transformations := newTransformations(a.meta.Transformations)
 	for _, n := range a.meta.Nodes {
-		a.nodes = append(a.nodes, newAPINode(n))
+		node := newAPINode(n)
+		node.transformations = append(node.transformations[:0:0], transformations...)
+		node.transformations = append(node.transformations, newTransformations(n.Transformations)...)
+		a.nodes = append(a.nodes, node)
 	}
 
 	sort.Slice(a.nodes, a.compare)
+	a.initDepends()
+	a.initAPI()
+}
 
+func (a *apiRuntime) initAPI() {
 	if nil != a.meta.DefaultValue {
 		for _, c := range a.meta.DefaultValue.Cookies {
 			ck := &fasthttp.Cookie{}
@@ -348,22 +565,12 @@ func (a *apiRuntime) init() {
 		}
 	}
 
-	a.parsedWhitelist = make([]*ipSegment, 0)
-	a.parsedBlacklist = make([]*ipSegment, 0)
-	if nil != a.meta.IPAccessControl {
-		if a.meta.IPAccessControl.Whitelist != nil {
-			for _, ip := range a.meta.IPAccessControl.Whitelist {
-				a.parsedWhitelist = append(a.parsedWhitelist, parseFrom(ip))
-			}
-		}
-
-		if a.meta.IPAccessControl.Blacklist != nil {
-			for _, ip := range a.meta.IPAccessControl.Blacklist {
-				a.parsedBlacklist = append(a.parsedBlacklist, parseFrom(ip))
-			}
-		}
+	if nil != a.meta.CORS {
+		a.cors = newCORSPolicy(a.meta.CORS)
 	}
 
+	a.ipAccessControl = newIPAccessControlRuntime(a.meta.IPAccessControl)
+
 	if nil != a.meta.RenderTemplate {
 		for _, obj := range a.meta.RenderTemplate.Objects {
 			rob := &renderObject{
@@ -376,6 +583,15 @@ func (a *apiRuntime) init() {
 				}
 				rob.attrs = append(rob.attrs, rattr)
 
+				if attr.Expression != "" {
+					template, err := expr.ParseTemplate(attr.Expression)
+					if err != nil {
+						log.Fatalf("bug: parse render template expression failed with error %+v", err)
+					}
+					rattr.template = template
+					continue
+				}
+
 				extracts := strings.Split(attr.ExtractExp, ",")
 				for _, extract := range extracts {
 					rattr.extracts = append(rattr.extracts, strings.Split(extract, "."))
@@ -407,6 +623,21 @@ func (a *apiRuntime) webSocketOptions() *metapb.WebSocketOptions {
 	return a.meta.WebSocketOptions
 }
 
+func (a *apiRuntime) isStreaming() bool {
+	return a.meta.Streaming
+}
+
+func (a *apiRuntime) isSSE() bool {
+	return a.meta.SSEOptions != nil
+}
+
+// canStream returns true if the response of the api can be streamed to the client
+func (a *apiRuntime) canStream() bool {
+	return len(a.meta.Nodes) == 1 &&
+		!a.hasRenderTemplate() &&
+		!a.isWebSocket()
+}
+
 func (a *apiRuntime) hasRenderTemplate() bool {
 	return a.meta.RenderTemplate != nil
 }
@@ -415,32 +646,12 @@ func (a *apiRuntime) hasDefaultValue() bool {
 	return a.meta.DefaultValue != nil
 }
 
-func (a *apiRuntime) allowWithBlacklist(ip string) bool {
-	if a.meta.IPAccessControl == nil {
-		return true
-	}
-
-	for _, i := range a.parsedBlacklist {
-		if i.matches(ip) {
-			return false
-		}
-	}
-
-	return true
+func (a *apiRuntime) allowWithBlacklist(ip net.IP) bool {
+	return a.ipAccessControl.allowWithBlacklist(ip)
 }
 
-func (a *apiRuntime) allowWithWhitelist(ip string) bool {
-	if a.meta.IPAccessControl == nil || len(a.meta.IPAccessControl.Whitelist) == 0 {
-		return true
-	}
-
-	for _, i := range a.parsedWhitelist {
-		if i.matches(ip) {
-			return true
-		}
-	}
-
-	return false
+func (a *apiRuntime) allowWithWhitelist(ip net.IP) bool {
+	return a.ipAccessControl.allowWithWhitelist(ip)
 }
 
 func (a *apiRuntime) isUp() bool {
@@ -448,22 +659,28 @@ func (a *apiRuntime) isUp() bool {
 }
 
 func (a *apiRuntime) matches(req *fasthttp.Request) bool {
+	return a.matchesMethod(req, hack.SliceToString(req.Header.Method()))
+}
+
+// matchesMethod returns true if the request with the method matches the api, the method
+// of the cors preflight request is the method of the actual request
+func (a *apiRuntime) matchesMethod(req *fasthttp.Request, method string) bool {
 	if !a.isUp() {
 		return false
 	}
 
 	switch a.matchRule() {
 	case metapb.MatchAll:
-		return a.isDomainMatches(req) && a.isMethodMatches(req)
+		return a.isDomainMatches(req) && a.isMethodMatches(method)
 	case metapb.MatchAny:
-		return a.isDomainMatches(req) || a.isMethodMatches(req)
+		return a.isDomainMatches(req) || a.isMethodMatches(method)
 	default:
-		return a.isDomainMatches(req) || a.isMethodMatches(req)
+		return a.isDomainMatches(req) || a.isMethodMatches(method)
 	}
 }
 
-func (a *apiRuntime) isMethodMatches(req *fasthttp.Request) bool {
-	return a.meta.Method == "*" || strings.ToUpper(hack.SliceToString(req.Header.Method())) == a.meta.Method
+func (a *apiRuntime) isMethodMatches(method string) bool {
+	return a.meta.Method == "*" || strings.ToUpper(method) == a.meta.Method
 }
 
 func (a *apiRuntime) isDomainMatches(req *fasthttp.Request) bool {
@@ -478,7 +695,11 @@ func (a *apiRuntime) matchRule() metapb.MatchRule {
 	return a.meta.GetMatchRule()
 }
 
-func (v *apiValidation) validate(req *fasthttp.Request) bool {
+func (v *apiValidation) validate(req *fasthttp.Request, clientIP string) bool {
+	if !conditionsMatches(v.conditions, req, clientIP) {
+		return false
+	}
+
 	if len(v.rules) == 0 && !v.meta.Required {
 		return true
 	}
@@ -504,8 +725,9 @@ func (r *apiRule) validate(value []byte) bool {
 }
 
 type routingRuntime struct {
-	meta    *metapb.Routing
-	barrier *util.RateBarrier
+	meta       *metapb.Routing
+	barrier    *util.RateBarrier
+	conditions []*condition
 }
 
 func newRoutingRuntime(meta *metapb.Routing) *routingRuntime {
@@ -524,24 +746,39 @@ func (a *routingRuntime) clone() *routingRuntime {
 func (a *routingRuntime) updateMeta(meta *metapb.Routing) {
 	a.meta = meta
 	a.barrier = util.NewRateBarrier(int(a.meta.TrafficRate))
+	a.conditions = newConditions(a.meta.Conditions)
 }
 
-func (a *routingRuntime) matches(apiID uint64, req *fasthttp.Request, requestTag string) bool {
+func (a *routingRuntime) matches(apiID uint64, req *fasthttp.Request, clientIP string, requestTag string) bool {
 	if a.meta.API > 0 && apiID != a.meta.API {
 		return false
 	}
 
-	for _, c := range a.meta.Conditions {
-		if !conditionsMatches(&c, req) {
+	for _, c := range a.conditions {
+		if !c.matches(req, clientIP) {
 			log.Debugf("%s: skip routing %s by condition %+v",
 				requestTag,
 				a.meta.Name,
-				c)
+				c.meta)
 			return false
 		}
 	}
 
-	value := a.barrier.Allow()
+	var value bool
+	if a.meta.StickyKey != nil {
+		key := paramValue(a.meta.StickyKey, req)
+		if key == "" {
+			log.Debugf("%s: skip routing %s by missing sticky key",
+				requestTag,
+				a.meta.Name)
+			return false
+		}
+
+		value = a.barrier.AllowKey(a.meta.ID, key)
+	} else {
+		value = a.barrier.Allow()
+	}
+
 	if !value {
 		log.Debugf("%s: skip routing %s by rate",
 			requestTag,
@@ -555,99 +792,11 @@ func (a *routingRuntime) isUp() bool {
 	return a.meta.Status == metapb.Up
 }
 
-func conditionsMatches(cond *metapb.Condition, req *fasthttp.Request) bool {
-	attrValue := paramValue(&cond.Parameter, req)
-	if attrValue == "" {
-		return false
-	}
-
-	switch cond.Cmp {
-	case metapb.CMPEQ:
-		return eq(attrValue, cond.Expect)
-	case metapb.CMPLT:
-		return lt(attrValue, cond.Expect)
-	case metapb.CMPLE:
-		return le(attrValue, cond.Expect)
-	case metapb.CMPGT:
-		return gt(attrValue, cond.Expect)
-	case metapb.CMPGE:
-		return ge(attrValue, cond.Expect)
-	case metapb.CMPIn:
-		return in(attrValue, cond.Expect)
-	case metapb.CMPMatch:
-		return reg(attrValue, cond.Expect)
-	default:
-		return false
-	}
-}
-
-func eq(attrValue string, expect string) bool {
-	return attrValue == expect
-}
-
-func lt(attrValue string, expect string) bool {
-	s, err := strconv.Atoi(attrValue)
-	if err != nil {
-		return false
-	}
-
-	t, err := strconv.Atoi(expect)
-	if err != nil {
-		return false
-	}
-
-	return s < t
-}
-
-func le(attrValue string, expect string) bool {
-	s, err := strconv.Atoi(attrValue)
-	if err != nil {
-		return false
-	}
-
-	t, err := strconv.Atoi(expect)
-	if err != nil {
-		return false
-	}
-
-	return s <= t
-}
-
-func gt(attrValue string, expect string) bool {
-	s, err := strconv.Atoi(attrValue)
-	if err != nil {
-		return false
-	}
-
-	t, err := strconv.Atoi(expect)
-	if err != nil {
-		return false
-	}
-
-	return s > t
-}
-
-func ge(attrValue string, expect string) bool {
-	s, err := strconv.Atoi(attrValue)
-	if err != nil {
-		return false
-	}
-
-	t, err := strconv.Atoi(expect)
-	if err != nil {
-		return false
-	}
-
-	return s >= t
-}
-
-func in(attrValue string, expect string) bool {
-	return strings.Index(expect, attrValue) != -1
-}
-
-func reg(attrValue string, expect string) bool {
-	matches, err := regexp.MatchString(expect, attrValue)
-	return err == nil && matches
+// isRollingOut returns true if the routing has a running rollout
+func (a *routingRuntime) isRollingOut() bool {
+	return a.isUp() &&
+		a.meta.Rollout != nil &&
+		a.meta.Rollout.Status.State == metapb.RolloutRunning
 }
 
 func paramValue(param *metapb.Parameter, req *fasthttp.Request) string {
@@ -668,6 +817,8 @@ func paramValue(param *metapb.Parameter, req *fasthttp.Request) string {
 		return getCookieValue(param.Name, req)
 	case metapb.PathValue:
 		return getPathValue(int(param.Index), req)
+	case metapb.JWTClaim:
+		return getJWTClaimValue(param.Name, req)
 	default:
 		return ""
 	}
@@ -696,6 +847,34 @@ func getPathValue(idx int, req *fasthttp.Request) string {
 	return values[idx]
 }
 
+// getJWTClaimValue returns the claim of the bearer token in the authorization header.
+// The token is not verified here, the JWT filter verifies the token.
+func getJWTClaimValue(name string, req *fasthttp.Request) string {
+	value := req.Header.Peek("Authorization")
+	if len(value) <= len(bearerPrefix) ||
+		!bytes.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+
+	parts := bytes.Split(value[len(bearerPrefix):], []byte{'.'})
+	if len(parts) != 3 {
+		return ""
+	}
+
+	encoded := bytes.TrimRight(parts[1], "=")
+	payload := make([]byte, base64.RawURLEncoding.DecodedLen(len(encoded)))
+	n, err := base64.RawURLEncoding.Decode(payload, encoded)
+	if err != nil {
+		return ""
+	}
+
+	claim, _, _, err := jsonparser.Get(payload[:n], name)
+	if err != nil {
+		return ""
+	}
+	return hack.SliceToString(claim)
+}
+
 func getFormValue(name string, req *fasthttp.Request) string {
 	return string(req.PostArgs().Peek(name))
 }
@@ -0,0 +1,248 @@
+package proxy
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/expr"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/valyala/fasthttp"
+)
+
+func TestCopyRequestTransformed(t *testing.T) {
+	api := newAPIRuntime(&metapb.API{
+		ID:   1,
+		Name: "a",
+		Transformations: []*metapb.Transformation{
+			{Target: metapb.RequestHeader, Action: metapb.TransformSet, Name: "X-API", Value: "api"},
+		},
+		Nodes: []*metapb.DispatchNode{
+			{
+				ClusterID: 1,
+				Transformations: []*metapb.Transformation{
+					{Target: metapb.RequestHeader, Action: metapb.TransformRemove, Name: "X-Secret"},
+				},
+			},
+		},
+	}, nil, 0)
+
+	node := api.nodes[0].clone()
+	if len(node.transformations) != 2 {
+		t.Fatalf("expect 2 transformations of the clone, but %d", len(node.transformations))
+	}
+
+	origin := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(origin)
+	origin.SetRequestURI("http://gw/a")
+	origin.Header.Set("X-Secret", "secret")
+
+	req := &copyReq{
+		origin: origin,
+		node:   node,
+		to:     &serverRuntime{meta: &metapb.Server{Addr: "127.0.0.1:8080"}},
+	}
+	req.prepare()
+
+	if value := string(origin.Header.Peek("X-API")); value != "api" {
+		t.Errorf("expect api transformation of the copy request, but %q", value)
+	}
+	if value := string(origin.Header.Peek("X-Secret")); value != "" {
+		t.Errorf("expect node transformation of the copy request, but %q", value)
+	}
+}
+
+func TestCopyRequestDepend(t *testing.T) {
+	node := newAPINode(&metapb.DispatchNode{
+		ClusterID:    1,
+		URLRewrite:   "/accounts/$(depend.user.id)",
+		BodyTemplate: `{"user":$(depend.user)}`,
+	})
+
+	origin := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(origin)
+	origin.SetRequestURI("http://gw/a")
+
+	req := &copyReq{
+		origin: origin,
+		node:   node,
+		to:     &serverRuntime{meta: &metapb.Server{Addr: "127.0.0.1:8080"}},
+		depend: []byte(`{"user":{"id":1}}`),
+	}
+	req.prepare()
+
+	if value := string(origin.URI().Path()); value != "/accounts/1" {
+		t.Errorf("expect the depend in the url of the copy request, but %s", value)
+	}
+	if value := string(origin.Body()); value != `{"user":{"id":1}}` {
+		t.Errorf("expect the depend in the body of the copy request, but %s", value)
+	}
+}
+
+func TestRewriteRequestJSONBody(t *testing.T) {
+	node := newAPINode(&metapb.DispatchNode{
+		ClusterID:    1,
+		Method:       "POST",
+		BodyTemplate: `{"user":$(depend.user),"id":"$(origin.query.id)"}`,
+	})
+
+	origin := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(origin)
+	origin.SetRequestURI(`http://gw/a?id=1%22,%22admin%22:true,%22x%22:%22`)
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	node.rewriteRequest(req, &expr.Ctx{Origin: origin, Depend: []byte(`{"user":{"name":"a"}}`)})
+
+	expect := `{"user":{"name":"a"},"id":"1\",\"admin\":true,\"x\":\""}`
+	if string(req.Body()) != expect {
+		t.Errorf("expect %s, but %s", expect, req.Body())
+	}
+	if string(req.Header.Method()) != "POST" {
+		t.Errorf("expect POST, but %s", req.Header.Method())
+	}
+}
+
+func TestCloneSharesParsedNodes(t *testing.T) {
+	set, err := proto.Marshal(&descriptor.FileDescriptorSet{
+		File: []*descriptor.FileDescriptorProto{
+			{
+				Name:    proto.String("user.proto"),
+				Package: proto.String("test"),
+				MessageType: []*descriptor.DescriptorProto{
+					{
+						Name: proto.String("User"),
+						Field: []*descriptor.FieldDescriptorProto{
+							{Name: proto.String("name"), Number: proto.Int32(1), Label: descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: descriptor.FieldDescriptorProto_TYPE_STRING.Enum()},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	api := newAPIRuntime(&metapb.API{
+		ID:   1,
+		Name: "a",
+		Nodes: []*metapb.DispatchNode{
+			{
+				ClusterID: 1,
+				Decoder:   metapb.DecodeProtobuf,
+				Protobuf:  &metapb.ProtobufDescriptor{DescriptorSet: set, MessageType: "test.User"},
+			},
+		},
+	}, nil, 0)
+	if api.nodes[0].protobufDecoder == nil {
+		t.Fatalf("expect protobuf decoder")
+	}
+
+	if node := api.nodes[0].clone(); node.protobufDecoder != api.nodes[0].protobufDecoder {
+		t.Errorf("expect the clone of the node shares the protobuf decoder")
+	}
+
+	cloned := api.clone()
+	if cloned.nodes[0].protobufDecoder != api.nodes[0].protobufDecoder {
+		t.Errorf("expect the clone of the api shares the protobuf decoder")
+	}
+	if cloned.meta == api.meta {
+		t.Errorf("expect the clone of the api has its own meta")
+	}
+}
+
+func TestIPAccessControlInvalidValues(t *testing.T) {
+	acl := newIPAccessControlRuntime(&metapb.IPAccessControl{
+		Whitelist: []string{"10.*.1.*", "1.1.1.300"},
+		Blacklist: []string{"192.168.*.1"},
+	})
+
+	if !acl.allowWithWhitelist(net.ParseIP("10.2.1.1")) || acl.allowWithWhitelist(net.ParseIP("10.2.2.1")) {
+		t.Errorf("expect the whitelist with the wildcard in the middle")
+	}
+	if acl.allowWithWhitelist(net.ParseIP("1.1.1.1")) {
+		t.Errorf("expect the invalid whitelist value is ignored")
+	}
+	if acl.allowWithBlacklist(net.ParseIP("192.168.2.1")) || !acl.allowWithBlacklist(net.ParseIP("192.168.2.2")) {
+		t.Errorf("expect the blacklist with the wildcard in the middle")
+	}
+
+	// the invalid blacklist value denies all the ips
+	acl = newIPAccessControlRuntime(&metapb.IPAccessControl{
+		Blacklist: []string{"192.168.1.1", "192.168.1.300"},
+	})
+	for _, ip := range []string{"10.0.0.1", "2001:db8::1"} {
+		if acl.allowWithBlacklist(net.ParseIP(ip)) {
+			t.Errorf("expect %s is denied by the invalid blacklist", ip)
+		}
+	}
+}
+
+func jwtToken(payload string) string {
+	return "Bearer eyJhbGciOiJIUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestParamValueJWTClaim(t *testing.T) {
+	param := &metapb.Parameter{Name: "sub", Source: metapb.JWTClaim}
+	cases := []struct {
+		authorization string
+		expect        string
+	}{
+		{jwtToken(`{"sub":"user1","exp":1}`), "user1"},
+		{"bearer " + jwtToken(`{"sub":"user2"}`)[len("Bearer "):], "user2"},
+		{jwtToken(`{"sub":100}`), "100"},
+		{jwtToken(`{"name":"user1"}`), ""},
+		{"Basic dXNlcjpwYXNz", ""},
+		{"Bearer invalid", ""},
+		{"Bearer a.!!!.c", ""},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		req := fasthttp.AcquireRequest()
+		if c.authorization != "" {
+			req.Header.Set("Authorization", c.authorization)
+		}
+		if value := paramValue(param, req); value != c.expect {
+			t.Errorf("%q expect claim %q, but %q", c.authorization, c.expect, value)
+		}
+		fasthttp.ReleaseRequest(req)
+	}
+}
+
+func TestRoutingStickyByJWTClaim(t *testing.T) {
+	r := newRoutingRuntime(&metapb.Routing{
+		ID:          1,
+		Name:        "sticky",
+		TrafficRate: 50,
+		StickyKey:   &metapb.Parameter{Name: "sub", Source: metapb.JWTClaim},
+	})
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	if r.matches(1, req, "", "test") {
+		t.Errorf("expect the request without the token is not routed")
+	}
+
+	matched := 0
+	for i := 0; i < 100; i++ {
+		req.Header.Set("Authorization", jwtToken(fmt.Sprintf(`{"sub":"user%d"}`, i)))
+		value := r.matches(1, req, "", "test")
+		for j := 0; j < 10; j++ {
+			if r.matches(1, req, "", "test") != value {
+				t.Fatalf("expect the same user always in or out of the traffic rate")
+			}
+		}
+		if value {
+			matched++
+		}
+	}
+
+	if matched == 0 || matched == 100 {
+		t.Errorf("expect the users are split by the traffic rate, but %d matched", matched)
+	}
+}
@@ -11,4 +11,10 @@ var (
 	ErrNoServer = errors.New("has no server")
 	// ErrRewriteNotMatch rewrite not match request url
 	ErrRewriteNotMatch = errors.New("rewrite not match request url")
+	// ErrNotCached the only-if-cached request has no cached response
+	ErrNotCached = errors.New("has no cached response")
+	// ErrCoalescedFailure the coalesced request failed with an error response
+	ErrCoalescedFailure = errors.New("coalesced request failed")
+	// ErrDependFailed the required depend of the dispatch node failed
+	ErrDependFailed = errors.New("depend failed")
 )
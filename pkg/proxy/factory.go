@@ -40,6 +40,8 @@ const (
 	FilterJWT = "JWT"
 	// FilterCross cross filter
 	FilterCross = "CROSS"
+	// FilterTransform transform filter
+	FilterTransform = "TRANSFORM"
 	// FilterJSPlugin js plugin engine
 	FilterJSPlugin = "JS-ENGINE"
 )
@@ -59,7 +61,7 @@ func (p *Proxy) newFilter(filterSpec *FilterSpec) (filter.Filter, error) {
 	case FilterHeader:
 		return newHeadersFilter(), nil
 	case FilterXForward:
-		return newXForwardForFilter(), nil
+		return newXForwardForFilter(p.trustedProxies), nil
 	case FilterAnalysis:
 		return newAnalysisFilter(), nil
 	case FilterBlackList:
@@ -73,9 +75,11 @@ func (p *Proxy) newFilter(filterSpec *FilterSpec) (filter.Filter, error) {
 	case FilterValidation:
 		return newValidationFilter(), nil
 	case FilterCaching:
-		return newCachingFilter(p.cfg.Option.LimitBytesCaching, p.dispatcher.tw), nil
+		return newCachingFilter(p.cacheStorage, p.client), nil
 	case FilterJWT:
 		return newJWTFilter(p.cfg.Option.JWTCfgFile)
+	case FilterTransform:
+		return newTransformFilter(), nil
 	case FilterCross:
 		return newCrossDomainFilter(p.cfg.Option.CrossCfgFile)
 	case FilterJSPlugin:
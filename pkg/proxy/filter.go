@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"net"
 	"net/http"
 	"time"
 
@@ -80,6 +81,10 @@ func (c *proxyContext) init(rt *dispatcher, originCtx *fasthttp.RequestCtx, forw
 }
 
 func (c *proxyContext) reset() {
+	if c.attrs != nil {
+		finishCachingFlight(c)
+	}
+
 	if c.forwardReq != nil {
 		fasthttp.ReleaseRequest(c.forwardReq)
 	}
@@ -135,15 +140,22 @@ func (c *proxyContext) setEndAt(endAt time.Time) {
 }
 
 func (c *proxyContext) validateRequest() bool {
-	return c.result.node.validate(c.ForwardRequest())
+	return c.result.node.validate(c.ForwardRequest(),
+		filter.StringValue(filter.AttrClientRealIP, c))
 }
 
+// allowWithBlacklist returns false if the ip is in the global blacklist or the blacklist of the api
 func (c *proxyContext) allowWithBlacklist(ip string) bool {
-	return c.result.api.allowWithBlacklist(ip)
+	value := net.ParseIP(ip)
+	return c.rt.ipAccessControl.allowWithBlacklist(value) &&
+		c.result.api.allowWithBlacklist(value)
 }
 
+// allowWithWhitelist returns true if the ip is in both the global whitelist and the whitelist of the api
 func (c *proxyContext) allowWithWhitelist(ip string) bool {
-	return c.result.api.allowWithWhitelist(ip)
+	value := net.ParseIP(ip)
+	return c.rt.ipAccessControl.allowWithWhitelist(value) &&
+		c.result.api.allowWithWhitelist(value)
 }
 
 func (c *proxyContext) circuitResourceID() uint64 {
@@ -27,16 +27,26 @@ func (f *AnalysisFilter) Name() string {
 func (f *AnalysisFilter) Pre(c filter.Context) (statusCode int, err error) {
 	// TODO: avoid lock overhead in every request
 	c.Analysis().Request(c.(*proxyContext).circuitResourceID())
+	// the clusters are only analysed for the running rollouts
+	if dn := c.(*proxyContext).result; dn.rollout {
+		c.Analysis().Request(dn.cluster)
+	}
 	return f.BaseFilter.Pre(c)
 }
 
 // Post execute after proxy
 func (f *AnalysisFilter) Post(c filter.Context) (statusCode int, err error) {
 	c.Analysis().Response(c.(*proxyContext).circuitResourceID(), c.EndAt().Sub(c.StartAt()).Nanoseconds())
+	if dn := c.(*proxyContext).result; dn.rollout {
+		c.Analysis().Response(dn.cluster, c.EndAt().Sub(c.StartAt()).Nanoseconds())
+	}
 	return f.BaseFilter.Post(c)
 }
 
 // PostErr execute proxy has errors
 func (f *AnalysisFilter) PostErr(c filter.Context, code int, err error) {
 	c.Analysis().Failure(c.(*proxyContext).circuitResourceID())
+	if dn := c.(*proxyContext).result; dn.rollout {
+		c.Analysis().Failure(dn.cluster)
+	}
 }
@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -8,30 +9,35 @@ import (
 	"github.com/fagongzi/gateway/pkg/filter"
 	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/gateway/pkg/util"
-	"github.com/fagongzi/goetty"
-	"github.com/fagongzi/util/hack"
+	"github.com/fagongzi/log"
 	"github.com/valyala/fasthttp"
 )
 
-var (
-	cachePool sync.Pool
+const (
+	cacheTagHeader = "Cache-Tag"
+	// cachingVariantSep separates the cache key and the vary headers of the variants
+	cachingVariantSep = "\x00"
 )
 
 // CachingFilter cache api result
 type CachingFilter struct {
 	filter.BaseFilter
 
-	tw    *goetty.TimeoutWheel
-	cache *util.Cache
+	storage util.CacheStorage
+	client  *util.FastHTTPClient
+
+	// the caching keys in background revalidation
+	revalidating sync.Map
+	// the in-flight requests and the recent failures of the coalesced caching keys
+	flights  sync.Map
+	failures sync.Map
 }
 
-func newCachingFilter(maxBytes uint64, tw *goetty.TimeoutWheel) filter.Filter {
-	f := &CachingFilter{
-		tw: tw,
+func newCachingFilter(storage util.CacheStorage, client *util.FastHTTPClient) filter.Filter {
+	return &CachingFilter{
+		storage: storage,
+		client:  client,
 	}
-
-	f.cache = util.NewLRUCache(maxBytes, f.onEvicted)
-	return f
 }
 
 // Name return name of this filter
@@ -45,16 +51,40 @@ func (f *CachingFilter) Pre(c filter.Context) (statusCode int, err error) {
 		return f.BaseFilter.Post(c)
 	}
 
+	// the grpc response is written to the client by the grpc call
+	if c.GetAttr(grpcCallKey) != nil {
+		return f.BaseFilter.Post(c)
+	}
+
 	matches, id := getCachingID(c)
 	if !matches {
 		return f.BaseFilter.Post(c)
 	}
 
-	if value, ok := f.cache.Get(id); ok {
+	statusCode, err = f.lookupCached(c, id)
+	if err != nil || !c.DispatchNode().Cache.Coalesce {
+		return statusCode, err
+	}
+
+	return f.coalesce(c, id)
+}
+
+// lookupCached use the cached response as the response if found
+func (f *CachingFilter) lookupCached(c filter.Context, id string) (int, error) {
+	if c.DispatchNode().Cache.HTTPSemantics {
+		return f.preHTTP(c, id)
+	}
+
+	value, err := f.storage.Get(id)
+	if err != nil {
+		log.Errorf("get cache %s failed with error %s",
+			id,
+			err)
+	} else if value != nil {
 		c.SetAttr(filter.AttrUsingCachingValue, value)
 	}
 
-	return f.BaseFilter.Post(c)
+	return f.BaseFilter.Pre(c)
 }
 
 // Post execute after proxy
@@ -63,30 +93,79 @@ func (f *CachingFilter) Post(c filter.Context) (statusCode int, err error) {
 		return f.BaseFilter.Post(c)
 	}
 
+	// the streaming body and the grpc response can not be cached
+	if c.(*proxyContext).result.stream != nil || c.GetAttr(grpcCallKey) != nil {
+		return f.BaseFilter.Post(c)
+	}
+
+	// the waiting requests are notified after the response is stored
+	defer finishCachingFlight(c)
+
+	if c.DispatchNode().Cache.HTTPSemantics {
+		f.postHTTP(c)
+		return f.BaseFilter.Post(c)
+	}
+
+	// the response is from the cache
+	if c.GetAttr(filter.AttrUsingCachingValue) != nil {
+		return f.BaseFilter.Post(c)
+	}
+
+	if !cachingStatusCode(c.DispatchNode().Cache, c.Response().StatusCode()) {
+		return f.BaseFilter.Post(c)
+	}
+
 	matches, id := getCachingID(c)
 	if !matches {
 		return f.BaseFilter.Post(c)
 	}
 
-	f.cache.Add(id, genCachedValue(c))
-	if c.DispatchNode().Cache.Deadline > 0 {
-		f.tw.Schedule(time.Duration(c.DispatchNode().Cache.Deadline),
-			f.removeCache, id)
+	buf := filter.NewCachedValue(c.Response())
+	err = f.storage.Set(id, buf.RawBuf()[buf.GetReaderIndex():buf.GetWriteIndex()],
+		time.Second*time.Duration(c.DispatchNode().Cache.Deadline),
+		cachingTags(c.API().ID, c.Response())...)
+	buf.Release()
+	if err != nil {
+		log.Errorf("set cache %s failed with error %s",
+			id,
+			err)
 	}
 
 	return f.BaseFilter.Post(c)
 }
 
-func (f *CachingFilter) removeCache(id interface{}) {
-	f.cache.Remove(id)
-}
+// PostErr execute proxy has errors
+func (f *CachingFilter) PostErr(c filter.Context, code int, err error) {
+	if c.DispatchNode().Cache == nil {
+		return
+	}
+
+	if c.DispatchNode().Cache.HTTPSemantics {
+		f.postErrHTTP(c, code, err)
+	}
 
-func (f *CachingFilter) onEvicted(key util.Key, value *goetty.ByteBuf) {
-	f.tw.Schedule(time.Second*10, f.doReleaseCacheBuf, value)
+	f.postErrCoalesce(c, code, err)
 }
 
-func (f *CachingFilter) doReleaseCacheBuf(arg interface{}) {
-	arg.(*goetty.ByteBuf).Release()
+// cachingStatusCode returns true if the response with the status code can be cached,
+// all the status codes of the successful responses can be cached by default
+func cachingStatusCode(cache *metapb.Cache, code int) bool {
+	codes := cache.StatusCodes
+	if len(codes) == 0 {
+		if !cache.HTTPSemantics {
+			return true
+		}
+
+		codes = defaultCachingStatusCodes
+	}
+
+	for _, value := range codes {
+		if int(value) == code {
+			return true
+		}
+	}
+
+	return false
 }
 
 func getCachingID(c filter.Context) (bool, string) {
@@ -95,29 +174,23 @@ func getCachingID(c filter.Context) (bool, string) {
 		return true, getID(req, c.DispatchNode().Cache.Keys)
 	}
 
-	matches := true
-	for _, cond := range c.DispatchNode().Cache.Conditions {
-		matches = conditionsMatches(&cond, req)
-		if !matches {
-			break
-		}
-	}
-
-	if !matches {
+	conditions := c.(*proxyContext).result.node.cacheConditions
+	if !conditionsMatches(conditions, req, filter.StringValue(filter.AttrClientRealIP, c)) {
 		return false, ""
 	}
 
-	return matches, getID(req, c.DispatchNode().Cache.Keys)
+	return true, getID(req, c.DispatchNode().Cache.Keys)
 }
 
 func getID(req *fasthttp.Request, keys []metapb.Parameter) string {
+	// the id is kept by the cache storage, so the request uri is copied
 	size := len(keys)
 	if size == 0 {
-		return hack.SliceToString(req.RequestURI())
+		return string(req.RequestURI())
 	}
 
 	ids := make([]string, size+1, size+1)
-	ids[0] = hack.SliceToString(req.RequestURI())
+	ids[0] = string(req.RequestURI())
 	for idx, param := range keys {
 		ids[idx+1] = paramValue(&param, req)
 	}
@@ -125,6 +198,21 @@ func getID(req *fasthttp.Request, keys []metapb.Parameter) string {
 	return strings.Join(ids, "-")
 }
 
-func genCachedValue(c filter.Context) *goetty.ByteBuf {
-	return filter.NewCachedValue(c.Response())
+// cachingTags returns the tags of the cached response, the tag of the api,
+// and the tags of the Cache-Tag header divided by comma or space
+func cachingTags(api uint64, res *fasthttp.Response) []string {
+	tags := []string{apiCachingTag(api)}
+	for _, tag := range strings.FieldsFunc(string(res.Header.Peek(cacheTagHeader)), isCachingTagSep) {
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+func apiCachingTag(id uint64) string {
+	return fmt.Sprintf("api:%d", id)
+}
+
+func isCachingTagSep(c rune) bool {
+	return c == ',' || c == ' '
 }
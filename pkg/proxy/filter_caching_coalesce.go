@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	cachingFlightKey = "__caching_flight"
+
+	defaultCoalesceTimeout = time.Second * 3
+
+	// the results of the coalesced requests
+	coalescedHit      = "hit"
+	coalescedMiss     = "miss"
+	coalescedError    = "error"
+	coalescedNegative = "negative"
+	coalescedTimeout  = "timeout"
+)
+
+// cachingFlight is the in-flight request to the backend server of a caching key,
+// the concurrent requests of the same caching key wait for it
+type cachingFlight struct {
+	key     string
+	flights *sync.Map
+	done    chan struct{}
+	once    sync.Once
+	// the count of the requests waiting for the flight
+	waiters int32
+
+	// the failure of the request, err is nil if the request succeed
+	code int
+	err  error
+}
+
+func (fl *cachingFlight) finish(code int, err error) {
+	fl.once.Do(func() {
+		fl.code = code
+		fl.err = err
+		fl.flights.Delete(fl.key)
+		close(fl.done)
+	})
+}
+
+// cachingFailure is the failure of a caching key shared within the error ttl
+type cachingFailure struct {
+	expireAt time.Time
+	code     int
+	err      error
+}
+
+// coalesce makes the first request missed the cache go to the backend server, and the
+// concurrent requests of the same caching key wait for the first request up to the timeout,
+// then use the cached response or share the failure of the first request
+func (f *CachingFilter) coalesce(c filter.Context, id string) (int, error) {
+	key, missed := cachingMissedKey(c, id)
+	if !missed {
+		return f.BaseFilter.Pre(c)
+	}
+
+	name := c.API().Name
+	if value, ok := f.failures.Load(key); ok {
+		failure := value.(*cachingFailure)
+		if time.Now().Before(failure.expireAt) {
+			incrCacheCoalesced(name, coalescedNegative)
+			return shareCachingFailure(c, failure.code, failure.err)
+		}
+	}
+
+	flight := &cachingFlight{
+		key:     key,
+		flights: &f.flights,
+		done:    make(chan struct{}),
+	}
+	value, loaded := f.flights.LoadOrStore(key, flight)
+	if !loaded {
+		c.SetAttr(cachingFlightKey, flight)
+		return f.BaseFilter.Pre(c)
+	}
+	flight = value.(*cachingFlight)
+
+	timeout := defaultCoalesceTimeout
+	if c.DispatchNode().Cache.CoalesceTimeout > 0 {
+		timeout = seconds(int64(c.DispatchNode().Cache.CoalesceTimeout))
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	atomic.AddInt32(&flight.waiters, 1)
+	defer atomic.AddInt32(&flight.waiters, -1)
+
+	select {
+	case <-flight.done:
+	case <-timer.C:
+		incrCacheCoalesced(name, coalescedTimeout)
+		log.Warnf("%s: wait for the coalesced request of cache %s timeout",
+			c.(*proxyContext).result.requestTag,
+			key)
+		return f.BaseFilter.Pre(c)
+	}
+
+	if flight.err != nil {
+		incrCacheCoalesced(name, coalescedError)
+		return shareCachingFailure(c, flight.code, flight.err)
+	}
+
+	// the response of the first request is shared by the cache, the request goes
+	// to the backend server if the response is not cached
+	statusCode, err := f.lookupCached(c, id)
+	if _, missed := cachingMissedKey(c, id); missed {
+		incrCacheCoalesced(name, coalescedMiss)
+	} else {
+		incrCacheCoalesced(name, coalescedHit)
+	}
+
+	return statusCode, err
+}
+
+// postErrCoalesce shares the failure of the first request with the waiting requests,
+// and the requests within the error ttl
+func (f *CachingFilter) postErrCoalesce(c filter.Context, code int, err error) {
+	flight, ok := c.GetAttr(cachingFlightKey).(*cachingFlight)
+	if !ok {
+		return
+	}
+
+	if err == nil && code < fasthttp.StatusInternalServerError {
+		flight.finish(0, nil)
+		return
+	}
+
+	if err == nil {
+		err = ErrCoalescedFailure
+	}
+
+	if ttl := seconds(int64(c.DispatchNode().Cache.ErrorTTL)); ttl > 0 {
+		failure := &cachingFailure{
+			expireAt: time.Now().Add(ttl),
+			code:     code,
+			err:      err,
+		}
+		f.failures.Store(flight.key, failure)
+		time.AfterFunc(ttl, func() {
+			if value, ok := f.failures.Load(flight.key); ok && value == failure {
+				f.failures.Delete(flight.key)
+			}
+		})
+	}
+
+	flight.finish(code, err)
+}
+
+// finishCachingFlight notify the waiting requests if the request is the first request
+// of the caching key, it is called when the context is released in case of the filters
+// are not called
+func finishCachingFlight(c filter.Context) {
+	if flight, ok := c.GetAttr(cachingFlightKey).(*cachingFlight); ok {
+		flight.finish(0, nil)
+	}
+}
+
+// cachingMissedKey returns the caching key and true if the request missed the cache
+func cachingMissedKey(c filter.Context, id string) (string, bool) {
+	if !c.DispatchNode().Cache.HTTPSemantics {
+		return id, c.GetAttr(filter.AttrUsingCachingValue) == nil
+	}
+
+	// the request is not cacheable if no caching state
+	state, ok := c.GetAttr(cachingKey).(*cachingState)
+	if !ok || state.hit {
+		return "", false
+	}
+
+	return state.key, true
+}
+
+// shareCachingFailure use the stale response if it can be used when the backend server
+// is failed, otherwise returns the failure
+func shareCachingFailure(c filter.Context, code int, err error) (int, error) {
+	if state, ok := c.GetAttr(cachingKey).(*cachingState); ok {
+		if res := staleIfError(state); res != nil {
+			c.SetAttr(filter.AttrUsingResponse, res)
+			return fasthttp.StatusOK, nil
+		}
+	}
+
+	return code, err
+}
@@ -0,0 +1,182 @@
+package proxy
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/goetty"
+	"github.com/valyala/fasthttp"
+)
+
+func newCoalesceFilter(cache *metapb.Cache) (*CachingFilter, *apiRuntime, util.CacheStorage) {
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	storage := util.NewLocalCacheStorage(0, tw)
+	api := newAPIRuntime(&metapb.API{
+		ID:    1,
+		Name:  "coalesce",
+		Nodes: []*metapb.DispatchNode{{ClusterID: 1, Cache: cache}},
+	}, nil, 0)
+
+	return newCachingFilter(storage, nil).(*CachingFilter), api, storage
+}
+
+func newCoalesceContext(api *apiRuntime) *proxyContext {
+	c := &proxyContext{}
+	c.init(nil, nil, nil, &dispatchNode{
+		requestTag: "test",
+		api:        api,
+		node:       api.nodes[0],
+	})
+	return c
+}
+
+type coalesceWaiters struct {
+	wg    sync.WaitGroup
+	codes []int
+	errs  []error
+	ctxs  []*proxyContext
+}
+
+// startCoalesceWaiters calls coalesce with n concurrent requests, and waits for them
+// to wait for the flight of the first request
+func startCoalesceWaiters(t *testing.T, f *CachingFilter, api *apiRuntime, n int) *coalesceWaiters {
+	value, ok := f.flights.Load("key")
+	if !ok {
+		t.Fatalf("expect the flight of the first request")
+	}
+	flight := value.(*cachingFlight)
+
+	w := &coalesceWaiters{
+		codes: make([]int, n),
+		errs:  make([]error, n),
+		ctxs:  make([]*proxyContext, n),
+	}
+	for i := 0; i < n; i++ {
+		w.ctxs[i] = newCoalesceContext(api)
+		w.wg.Add(1)
+		go func(i int) {
+			defer w.wg.Done()
+			w.codes[i], w.errs[i] = f.coalesce(w.ctxs[i], "key")
+		}(i)
+	}
+
+	if !waitFor(time.Second, func() bool { return atomic.LoadInt32(&flight.waiters) == int32(n) }) {
+		t.Fatalf("expect %d requests wait for the flight, but %d", n, atomic.LoadInt32(&flight.waiters))
+	}
+	return w
+}
+
+func TestCachingCoalesceSharedFailure(t *testing.T) {
+	f, api, _ := newCoalesceFilter(&metapb.Cache{CoalesceTimeout: 1, ErrorTTL: 1})
+
+	first := newCoalesceContext(api)
+	if code, err := f.coalesce(first, "key"); code != fasthttp.StatusOK || err != nil {
+		t.Fatalf("expect the first request go to the backend server, but %d %+v", code, err)
+	}
+
+	w := startCoalesceWaiters(t, f, api, 3)
+	f.PostErr(first, fasthttp.StatusServiceUnavailable, ErrNoServer)
+	w.wg.Wait()
+
+	for i := range w.codes {
+		if w.codes[i] != fasthttp.StatusServiceUnavailable || w.errs[i] != ErrNoServer {
+			t.Errorf("expect the waiters share the failure, but %d %+v", w.codes[i], w.errs[i])
+		}
+	}
+
+	// the requests within the error ttl get the failure without waiting
+	startAt := time.Now()
+	code, err := f.coalesce(newCoalesceContext(api), "key")
+	if code != fasthttp.StatusServiceUnavailable || err != ErrNoServer {
+		t.Errorf("expect the failure within the error ttl, but %d %+v", code, err)
+	}
+	if time.Since(startAt) > time.Millisecond*100 {
+		t.Errorf("expect the failure without waiting")
+	}
+
+	// the error ttl is in seconds
+	value, ok := f.failures.Load("key")
+	if !ok {
+		t.Fatalf("expect the failure is kept within the error ttl")
+	}
+	if ttl := time.Until(value.(*cachingFailure).expireAt); ttl <= time.Millisecond*500 || ttl > time.Second {
+		t.Errorf("expect the failure expired in 1 second, but %s", ttl)
+	}
+}
+
+func TestCachingCoalesceErrorResponse(t *testing.T) {
+	f, api, _ := newCoalesceFilter(&metapb.Cache{CoalesceTimeout: 1})
+
+	first := newCoalesceContext(api)
+	f.coalesce(first, "key")
+
+	w := startCoalesceWaiters(t, f, api, 1)
+	f.PostErr(first, fasthttp.StatusBadGateway, nil)
+	w.wg.Wait()
+
+	if w.codes[0] != fasthttp.StatusBadGateway || w.errs[0] != ErrCoalescedFailure {
+		t.Errorf("expect the waiter share the error response, but %d %+v", w.codes[0], w.errs[0])
+	}
+
+	// no error ttl, the next request goes to the backend server
+	next := newCoalesceContext(api)
+	if code, err := f.coalesce(next, "key"); code != fasthttp.StatusOK || err != nil {
+		t.Errorf("expect no failure without error ttl, but %d %+v", code, err)
+	}
+	if next.GetAttr(cachingFlightKey) == nil {
+		t.Errorf("expect the next request is the first request")
+	}
+	finishCachingFlight(next)
+}
+
+func TestCachingCoalesceCached(t *testing.T) {
+	f, api, storage := newCoalesceFilter(&metapb.Cache{CoalesceTimeout: 1})
+
+	first := newCoalesceContext(api)
+	f.coalesce(first, "key")
+
+	w := startCoalesceWaiters(t, f, api, 3)
+	storage.Set("key", []byte("value"), 0)
+	f.PostErr(first, fasthttp.StatusOK, nil)
+	w.wg.Wait()
+
+	for i := range w.codes {
+		if w.codes[i] != fasthttp.StatusOK || w.errs[i] != nil {
+			t.Errorf("expect the waiters succeed, but %d %+v", w.codes[i], w.errs[i])
+		}
+		if value, ok := w.ctxs[i].GetAttr(filter.AttrUsingCachingValue).([]byte); !ok || string(value) != "value" {
+			t.Errorf("expect the waiters use the cached value, but %+v", w.ctxs[i].GetAttr(filter.AttrUsingCachingValue))
+		}
+	}
+
+	if _, ok := f.failures.Load("key"); ok {
+		t.Errorf("expect no failure for the succeed request")
+	}
+}
+
+func TestCachingCoalesceTimeout(t *testing.T) {
+	f, api, _ := newCoalesceFilter(&metapb.Cache{CoalesceTimeout: 1})
+
+	first := newCoalesceContext(api)
+	f.coalesce(first, "key")
+	defer finishCachingFlight(first)
+
+	// the coalesce timeout is in seconds
+	c := newCoalesceContext(api)
+	startAt := time.Now()
+	code, err := f.coalesce(c, "key")
+	if code != fasthttp.StatusOK || err != nil {
+		t.Errorf("expect the request go to the backend server after timeout, but %d %+v", code, err)
+	}
+	if cost := time.Since(startAt); cost < time.Second || cost >= defaultCoalesceTimeout {
+		t.Errorf("expect timeout after 1 second, but %s", cost)
+	}
+	if c.GetAttr(cachingFlightKey) != nil {
+		t.Errorf("expect the timeout request is not the first request")
+	}
+}
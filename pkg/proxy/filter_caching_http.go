@@ -0,0 +1,611 @@
+package proxy
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	cachingKey      = "__caching"
+	cachingStaleKey = "__caching_stale"
+
+	cacheControlHeader    = "Cache-Control"
+	pragmaHeader          = "Pragma"
+	etagHeader            = "ETag"
+	lastModifiedHeader    = "Last-Modified"
+	expiresHeader         = "Expires"
+	dateHeader            = "Date"
+	ageHeader             = "Age"
+	warningHeader         = "Warning"
+	authorizationHeader   = "Authorization"
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+
+	staleWarning            = `110 - "Response is Stale"`
+	revalidateFailedWarning = `111 - "Revalidation Failed"`
+
+	// the bytes of type, stored at, lifetime, stale-while-revalidate, stale-if-error,
+	// status code and flags of the cached entry
+	cachedEntryMetaSize = 1 + 8*4 + 4 + 4
+
+	mustRevalidateFlag = 1
+
+	// the cached value is a response, or the vary header names of the responses
+	cachedEntryType = byte(1)
+	cachedVaryType  = byte(2)
+)
+
+var (
+	// the status codes of RFC 7231 and RFC 7538 which are cacheable by default,
+	// except the partial content and the errors
+	defaultCachingStatusCodes = []int32{200, 203, 204, 300, 301, 308}
+
+	noCacheValue = []byte("no-cache")
+)
+
+// cachingState is the caching state of a request in HTTP semantics mode
+type cachingState struct {
+	api uint64
+	id  string
+	key string
+	// the stored response, nil if not found
+	entry *cachedEntry
+	// the request is sent with the validators of the stored response
+	revalidating bool
+	// the response is from the cache
+	hit     bool
+	noStore bool
+}
+
+// cachedEntry is the stored response with the freshness information of RFC 7234
+type cachedEntry struct {
+	// the time the response was generated by the backend server, the initial age is removed
+	storedAt             time.Time
+	lifetime             time.Duration
+	staleWhileRevalidate time.Duration
+	staleIfError         time.Duration
+	statusCode           int
+	mustRevalidate       bool
+	// the cached value of the response
+	value []byte
+}
+
+func decodeCachedEntry(data []byte) (*cachedEntry, bool) {
+	if len(data) < cachedEntryMetaSize || data[0] != cachedEntryType {
+		return nil, false
+	}
+
+	return &cachedEntry{
+		storedAt:             time.Unix(0, goetty.Byte2Int64(data[1:9])),
+		lifetime:             time.Duration(goetty.Byte2Int64(data[9:17])),
+		staleWhileRevalidate: time.Duration(goetty.Byte2Int64(data[17:25])),
+		staleIfError:         time.Duration(goetty.Byte2Int64(data[25:33])),
+		statusCode:           goetty.Byte2Int(data[33:37]),
+		mustRevalidate:       goetty.Byte2Int(data[37:41])&mustRevalidateFlag != 0,
+		value:                data[cachedEntryMetaSize:],
+	}, true
+}
+
+func (e *cachedEntry) encode(res *fasthttp.Response) *goetty.ByteBuf {
+	flags := 0
+	if e.mustRevalidate {
+		flags |= mustRevalidateFlag
+	}
+
+	buf := goetty.NewByteBuf(cachedEntryMetaSize + len(res.Body()) + 128)
+	buf.WriteByte(cachedEntryType)
+	buf.WriteInt64(e.storedAt.UnixNano())
+	buf.WriteInt64(int64(e.lifetime))
+	buf.WriteInt64(int64(e.staleWhileRevalidate))
+	buf.WriteInt64(int64(e.staleIfError))
+	buf.WriteInt(e.statusCode)
+	buf.WriteInt(flags)
+	filter.WriteCachedValue(buf, res)
+	return buf
+}
+
+func (e *cachedEntry) age(now time.Time) time.Duration {
+	if age := now.Sub(e.storedAt); age > 0 {
+		return age
+	}
+
+	return 0
+}
+
+// ttl returns the duration the entry can be used even if it is stale
+func (e *cachedEntry) ttl() time.Duration {
+	stale := e.staleWhileRevalidate
+	if e.staleIfError > stale {
+		stale = e.staleIfError
+	}
+
+	return e.lifetime + stale - e.age(time.Now())
+}
+
+// acceptable returns true if the entry satisfies the cache control of the request
+func (e *cachedEntry) acceptable(age time.Duration, cc util.CacheControl) bool {
+	if cc.MaxAge >= 0 && age > seconds(cc.MaxAge) {
+		return false
+	}
+
+	if cc.MinFresh >= 0 && e.lifetime-age < seconds(cc.MinFresh) {
+		return false
+	}
+
+	if age < e.lifetime {
+		return true
+	}
+
+	return cc.MaxStale >= 0 && !e.mustRevalidate && age-e.lifetime <= seconds(cc.MaxStale)
+}
+
+// response returns the stored response with the age
+func (e *cachedEntry) response(now time.Time) *fasthttp.Response {
+	res := fasthttp.AcquireResponse()
+	filter.ReadCachedBytesTo(e.value, res)
+	res.SetStatusCode(e.statusCode)
+	res.Header.Set(ageHeader, strconv.FormatInt(int64(e.age(now)/time.Second), 10))
+	return res
+}
+
+// mergeTo replace the response of 304 by the stored response, the headers of
+// the stored response are updated by the headers of the response of 304
+func (e *cachedEntry) mergeTo(res *fasthttp.Response) {
+	value := fasthttp.AcquireResponse()
+	filter.ReadCachedBytesTo(e.value, value)
+	value.SetStatusCode(e.statusCode)
+	value.Header.Del(ageHeader)
+	res.Header.VisitAll(func(key, v []byte) {
+		switch string(key) {
+		case "Content-Length", "Content-Type", "Content-Encoding", "Transfer-Encoding", "Connection":
+			return
+		}
+
+		value.Header.SetBytesKV(key, v)
+	})
+
+	value.CopyTo(res)
+	fasthttp.ReleaseResponse(value)
+}
+
+func (f *CachingFilter) preHTTP(c filter.Context, id string) (int, error) {
+	req := c.ForwardRequest()
+	if !req.Header.IsGet() {
+		return f.BaseFilter.Pre(c)
+	}
+
+	cc := util.ParseCacheControl(req.Header.Peek(cacheControlHeader))
+	noCache := cc.NoCache ||
+		(len(req.Header.Peek(cacheControlHeader)) == 0 && bytes.Equal(req.Header.Peek(pragmaHeader), noCacheValue))
+
+	state := &cachingState{
+		api:     c.API().ID,
+		id:      id,
+		noStore: cc.NoStore,
+	}
+	c.SetAttr(cachingKey, state)
+
+	entry, err := f.lookup(state, req)
+	if err != nil {
+		log.Errorf("%s: get cache %s failed with error %s",
+			c.(*proxyContext).result.requestTag,
+			state.key,
+			err)
+	}
+
+	if entry == nil {
+		if cc.OnlyIfCached {
+			return fasthttp.StatusGatewayTimeout, ErrNotCached
+		}
+
+		return f.BaseFilter.Pre(c)
+	}
+
+	now := time.Now()
+	state.entry = entry
+	age := state.entry.age(now)
+
+	if !noCache && state.entry.acceptable(age, cc) {
+		f.serve(c, state, now, age >= state.entry.lifetime)
+		return f.BaseFilter.Pre(c)
+	}
+
+	// the stale response is served, and the response is revalidated in background
+	if !noCache &&
+		!state.entry.mustRevalidate &&
+		cc.MaxAge < 0 &&
+		cc.MinFresh < 0 &&
+		age < state.entry.lifetime+state.entry.staleWhileRevalidate {
+		f.serve(c, state, now, true)
+		f.revalidate(c, state)
+		return f.BaseFilter.Pre(c)
+	}
+
+	if cc.OnlyIfCached {
+		return fasthttp.StatusGatewayTimeout, ErrNotCached
+	}
+
+	state.revalidating = setValidators(req, state.entry)
+	return f.BaseFilter.Pre(c)
+}
+
+func (f *CachingFilter) postHTTP(c filter.Context) {
+	state, ok := c.GetAttr(cachingKey).(*cachingState)
+	if !ok || state.hit {
+		return
+	}
+
+	f.store(c.DispatchNode().Cache, state, c.ForwardRequest(), c.Response())
+
+	// the client validators are replaced by the validators of the stored response
+	if state.revalidating && notModified(c, c.Response()) {
+		toNotModified(c.Response())
+	}
+}
+
+func (f *CachingFilter) postErrHTTP(c filter.Context, code int, err error) {
+	state, ok := c.GetAttr(cachingKey).(*cachingState)
+	if !ok || state.hit {
+		return
+	}
+
+	if err == nil && code < fasthttp.StatusInternalServerError {
+		f.store(c.DispatchNode().Cache, state, c.ForwardRequest(), c.Response())
+		return
+	}
+
+	if res := staleIfError(state); res != nil {
+		c.SetAttr(cachingStaleKey, res)
+	}
+}
+
+// staleIfError returns the stored response if it can be used when the backend server
+// is failed, returns nil if no stored response can be used
+func staleIfError(state *cachingState) *fasthttp.Response {
+	if state.entry == nil || state.entry.mustRevalidate {
+		return nil
+	}
+
+	now := time.Now()
+	if state.entry.age(now) >= state.entry.lifetime+state.entry.staleIfError {
+		return nil
+	}
+
+	res := state.entry.response(now)
+	res.Header.Set(warningHeader, revalidateFailedWarning)
+	state.hit = true
+	return res
+}
+
+// serve use the stored response as the response, the 304 is returned if the
+// response is not modified for the conditional request of the client
+func (f *CachingFilter) serve(c filter.Context, state *cachingState, now time.Time, stale bool) {
+	res := state.entry.response(now)
+	if stale {
+		res.Header.Set(warningHeader, staleWarning)
+	}
+
+	if notModified(c, res) {
+		toNotModified(res)
+	}
+
+	state.hit = true
+	c.SetAttr(filter.AttrUsingResponse, res)
+}
+
+// revalidate revalidate the stored response in background, only one revalidation
+// is in progress for the same caching key
+func (f *CachingFilter) revalidate(c filter.Context, state *cachingState) {
+	if _, loaded := f.revalidating.LoadOrStore(state.key, struct{}{}); loaded {
+		return
+	}
+
+	dn := c.(*proxyContext).result
+	cache := c.DispatchNode().Cache
+	addr := c.Server().Addr
+	option := *dn.httpOption()
+
+	req := fasthttp.AcquireRequest()
+	c.ForwardRequest().CopyTo(req)
+	dn.setHost(req)
+	// the cached response is not compressed
+	req.Header.Del(acceptEncodingHeader)
+	revalidating := setValidators(req, state.entry)
+	requestTag := dn.requestTag
+
+	go func() {
+		defer f.revalidating.Delete(state.key)
+		defer fasthttp.ReleaseRequest(req)
+
+		res, err := f.client.Do(req, addr, &option)
+		if err != nil {
+			log.Errorf("%s: revalidate cache %s failed with error %s",
+				requestTag,
+				state.key,
+				err)
+			return
+		}
+		defer fasthttp.ReleaseResponse(res)
+
+		if revalidating && res.StatusCode() == fasthttp.StatusNotModified {
+			state.entry.mergeTo(res)
+		}
+
+		f.store(cache, &cachingState{api: state.api, id: state.id}, req, res)
+		log.Infof("%s: revalidate cache %s with %d",
+			requestTag,
+			state.key,
+			res.StatusCode())
+	}()
+}
+
+// store store the response if it is allowed to be stored by RFC 7234
+func (f *CachingFilter) store(cache *metapb.Cache, state *cachingState, req *fasthttp.Request, res *fasthttp.Response) {
+	if res == nil ||
+		state.noStore ||
+		!cachingStatusCode(cache, res.StatusCode()) ||
+		len(res.Header.Peek(contentEncodingHeader)) > 0 {
+		return
+	}
+
+	cc := util.ParseCacheControl(res.Header.Peek(cacheControlHeader))
+	if cc.NoStore || cc.Private {
+		return
+	}
+
+	// the shared cache must not store the response of the authorized request, unless it is allowed explicitly
+	if len(req.Header.Peek(authorizationHeader)) > 0 &&
+		!cc.Public &&
+		!cc.MustRevalidate &&
+		cc.SMaxAge < 0 {
+		return
+	}
+
+	varies, ok := parseVary(res.Header.Peek(varyHeader))
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	date := now
+	if value, err := fasthttp.ParseHTTPDate(res.Header.Peek(dateHeader)); err == nil && value.Before(now) {
+		date = value
+	}
+
+	hasValidators := len(res.Header.Peek(etagHeader)) > 0 || len(res.Header.Peek(lastModifiedHeader)) > 0
+	lifetime := freshnessLifetime(cache, cc, res, date)
+	if lifetime <= 0 && !hasValidators {
+		return
+	}
+
+	var age time.Duration
+	if value, err := strconv.ParseInt(string(res.Header.Peek(ageHeader)), 10, 64); err == nil && value > 0 {
+		age = seconds(value)
+	}
+
+	entry := &cachedEntry{
+		storedAt:             now.Add(-age),
+		lifetime:             lifetime,
+		staleWhileRevalidate: seconds(int64(cache.StaleWhileRevalidate)),
+		staleIfError:         seconds(int64(cache.StaleIfError)),
+		statusCode:           res.StatusCode(),
+		mustRevalidate:       cc.MustRevalidate || cc.ProxyRevalidate || cc.SMaxAge >= 0,
+	}
+	if cc.StaleWhileRevalidate >= 0 {
+		entry.staleWhileRevalidate = seconds(cc.StaleWhileRevalidate)
+	}
+	if cc.StaleIfError >= 0 {
+		entry.staleIfError = seconds(cc.StaleIfError)
+	}
+
+	tags := cachingTags(state.api, res)
+
+	// the entry with validators is kept to revalidate until it is evicted
+	var ttl time.Duration
+	if !hasValidators {
+		ttl = entry.ttl()
+		if ttl <= 0 {
+			return
+		}
+	}
+
+	// the vary header names are stored by the caching id, and the responses are
+	// stored by the caching id with the values of the vary headers
+	key := state.id
+	if len(varies) > 0 {
+		err := f.storage.Set(key, append([]byte{cachedVaryType}, strings.Join(varies, ",")...), ttl, tags...)
+		if err != nil {
+			log.Errorf("set cache %s failed with error %s",
+				key,
+				err)
+			return
+		}
+
+		key = varyKey(state.id, varies, req)
+	}
+
+	buf := entry.encode(res)
+	err := f.storage.Set(key, buf.RawBuf()[buf.GetReaderIndex():buf.GetWriteIndex()], ttl, tags...)
+	buf.Release()
+	if err != nil {
+		log.Errorf("set cache %s failed with error %s",
+			key,
+			err)
+	}
+}
+
+// lookup returns the stored response of the request, the vary header names
+// stored by the caching id are used to find the response of the variant
+func (f *CachingFilter) lookup(state *cachingState, req *fasthttp.Request) (*cachedEntry, error) {
+	state.key = state.id
+	value, err := f.storage.Get(state.key)
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+
+	if value[0] == cachedVaryType {
+		state.key = varyKey(state.id, strings.Split(string(value[1:]), ","), req)
+		value, err = f.storage.Get(state.key)
+		if err != nil || len(value) == 0 {
+			return nil, err
+		}
+	}
+
+	entry, _ := decodeCachedEntry(value)
+	return entry, nil
+}
+
+// freshnessLifetime returns the freshness lifetime of RFC 7234, the deadline of
+// the cache is used if the response has no explicit expiration time
+func freshnessLifetime(cache *metapb.Cache, cc util.CacheControl, res *fasthttp.Response, date time.Time) time.Duration {
+	if cc.NoCache {
+		return 0
+	}
+
+	if cc.SMaxAge >= 0 {
+		return seconds(cc.SMaxAge)
+	}
+
+	if cc.MaxAge >= 0 {
+		return seconds(cc.MaxAge)
+	}
+
+	if value := res.Header.Peek(expiresHeader); len(value) > 0 {
+		// the invalid date represents a time in the past
+		expires, err := fasthttp.ParseHTTPDate(value)
+		if err != nil {
+			return 0
+		}
+
+		return expires.Sub(date)
+	}
+
+	if cache.Deadline > 0 {
+		return seconds(int64(cache.Deadline))
+	}
+
+	// the heuristic freshness is 10% of the time since the last modification
+	if value, err := fasthttp.ParseHTTPDate(res.Header.Peek(lastModifiedHeader)); err == nil && value.Before(date) {
+		return date.Sub(value) / 10
+	}
+
+	return 0
+}
+
+// setValidators set the validators of the stored response to the request,
+// returns false if the stored response has no validators
+func setValidators(req *fasthttp.Request, entry *cachedEntry) bool {
+	res := fasthttp.AcquireResponse()
+	filter.ReadCachedBytesTo(entry.value, res)
+	etag := res.Header.Peek(etagHeader)
+	lastModified := res.Header.Peek(lastModifiedHeader)
+	if len(etag) == 0 && len(lastModified) == 0 {
+		fasthttp.ReleaseResponse(res)
+		return false
+	}
+
+	req.Header.Del(ifNoneMatchHeader)
+	req.Header.Del(ifModifiedSinceHeader)
+	if len(etag) > 0 {
+		req.Header.SetBytesV(ifNoneMatchHeader, etag)
+	}
+	if len(lastModified) > 0 {
+		req.Header.SetBytesV(ifModifiedSinceHeader, lastModified)
+	}
+
+	fasthttp.ReleaseResponse(res)
+	return true
+}
+
+// notModified returns true if the response is not modified for the conditional request
+// of the client, only the response sent to the client as is can be replaced by 304
+func notModified(c filter.Context, res *fasthttp.Response) bool {
+	if res.StatusCode() != fasthttp.StatusOK ||
+		len(c.API().Nodes) != 1 ||
+		!c.(*proxyContext).result.rawResponse() {
+		return false
+	}
+
+	req := &c.OriginRequest().Request
+	if value := req.Header.Peek(ifNoneMatchHeader); len(value) > 0 {
+		return util.MatchETag(value, res.Header.Peek(etagHeader))
+	}
+
+	if value := req.Header.Peek(ifModifiedSinceHeader); len(value) > 0 {
+		since, err := fasthttp.ParseHTTPDate(value)
+		if err != nil {
+			return false
+		}
+
+		modified, err := fasthttp.ParseHTTPDate(res.Header.Peek(lastModifiedHeader))
+		return err == nil && !modified.After(since)
+	}
+
+	return false
+}
+
+func toNotModified(res *fasthttp.Response) {
+	res.SetStatusCode(fasthttp.StatusNotModified)
+	res.ResetBody()
+}
+
+// refreshNotModified replace the response of 304 by the stored response if the
+// request is revalidated with the validators of the stored response
+func refreshNotModified(c filter.Context) {
+	state, ok := c.GetAttr(cachingKey).(*cachingState)
+	if !ok ||
+		!state.revalidating ||
+		c.Response().StatusCode() != fasthttp.StatusNotModified {
+		return
+	}
+
+	state.entry.mergeTo(c.Response())
+}
+
+// parseVary returns the header names of the vary header,
+// returns false if the response varies on all the request
+func parseVary(value []byte) ([]string, bool) {
+	var names []string
+	for _, name := range strings.Split(string(value), ",") {
+		name = strings.TrimSpace(name)
+		if name == "*" {
+			return nil, false
+		}
+
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names, true
+}
+
+func varyKey(id string, names []string, req *fasthttp.Request) string {
+	if len(names) == 0 {
+		return id
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(id)
+	for _, name := range names {
+		buf.WriteString(cachingVariantSep)
+		buf.WriteString(strings.ToLower(name))
+		buf.WriteByte('=')
+		buf.Write(req.Header.Peek(name))
+	}
+
+	return buf.String()
+}
+
+func seconds(value int64) time.Duration {
+	return time.Second * time.Duration(value)
+}
@@ -0,0 +1,431 @@
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/valyala/fasthttp"
+)
+
+type cachingBackend struct {
+	*httptest.Server
+	hits int64
+	// the backend returns 500 if failed is not 0
+	failed int32
+}
+
+// newCachingBackend returns a backend server, the response headers are set by the
+// query args named by the header names, and the status code is set by the status
+// query arg. The body is the count of the requests, the 304 is returned if the ETag
+// matches the If-None-Match.
+func newCachingBackend() *cachingBackend {
+	b := &cachingBackend{}
+	b.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		hits := atomic.AddInt64(&b.hits, 1)
+		if atomic.LoadInt32(&b.failed) != 0 {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		status := http.StatusOK
+		for name, values := range req.URL.Query() {
+			if name == "status" {
+				status, _ = strconv.Atoi(values[0])
+				continue
+			}
+			// the header names are not normalized by the client of the tests
+			rw.Header()[name] = values[:1]
+		}
+
+		if etag := req.Header.Get(ifNoneMatchHeader); etag != "" && etag == req.URL.Query().Get(etagHeader) {
+			rw.Header().Set("X-Revalidated", strconv.FormatInt(hits, 10))
+			rw.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		rw.WriteHeader(status)
+		fmt.Fprintf(rw, "%d", hits)
+	}))
+
+	return b
+}
+
+// headerRecorder records the response header of the last request seen by the post
+// filters, the headers of the single node are not sent to the client
+type headerRecorder struct {
+	filter.BaseFilter
+	sync.Mutex
+
+	header fasthttp.ResponseHeader
+}
+
+func (f *headerRecorder) Name() string {
+	return "RECORDER"
+}
+
+func (f *headerRecorder) Post(c filter.Context) (int, error) {
+	f.Lock()
+	c.Response().Header.CopyTo(&f.header)
+	f.Unlock()
+	return f.BaseFilter.Post(c)
+}
+
+func (f *headerRecorder) get(name string) string {
+	f.Lock()
+	defer f.Unlock()
+	return string(f.header.Peek(name))
+}
+
+// newCachingProxy returns a proxy with the caching filter, the responses of the api
+// are cached in HTTP semantics mode
+func newCachingProxy(t *testing.T, cache *metapb.Cache, b *cachingBackend) (*Proxy, *headerRecorder) {
+	cache.HTTPSemantics = true
+	api := newTestAPI("/cache")
+	api.Nodes[0].Cache = cache
+
+	p := newTestProxy(t, &Option{}, api, testServerAddr(b.URL))
+	recorder := &headerRecorder{}
+	p.filters = []filter.Filter{newCachingFilter(util.NewLocalCacheStorage(0, p.dispatcher.tw), p.client), recorder}
+	return p, recorder
+}
+
+// doCachingRequest sends the request with the response headers of the backend server,
+// and the header pairs of the request
+func doCachingRequest(p *Proxy, resHeaders url.Values, headers ...string) *fasthttp.Response {
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.SetRequestURI("http://gw/cache?" + resHeaders.Encode())
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(req, nil, nil)
+	p.ServeFastHTTP(ctx)
+
+	res := fasthttp.AcquireResponse()
+	ctx.Response.CopyTo(res)
+	return res
+}
+
+func TestCachingFreshnessLifetime(t *testing.T) {
+	date := time.Now().Truncate(time.Second)
+	httpDate := func(d time.Duration) string {
+		return date.Add(d).UTC().Format(http.TimeFormat)
+	}
+
+	cases := []struct {
+		name     string
+		deadline uint64
+		headers  []string
+		expect   time.Duration
+	}{
+		{"max-age", 0, []string{cacheControlHeader, "max-age=60"}, time.Minute},
+		{"s-maxage overrides max-age", 0, []string{cacheControlHeader, "max-age=60, s-maxage=30"}, time.Second * 30},
+		{"max-age overrides expires", 0, []string{cacheControlHeader, "max-age=60", expiresHeader, httpDate(time.Hour)}, time.Minute},
+		{"no-cache", 0, []string{cacheControlHeader, "no-cache, max-age=60"}, 0},
+		{"expires", 0, []string{expiresHeader, httpDate(time.Minute * 2)}, time.Minute * 2},
+		{"expired", 0, []string{expiresHeader, httpDate(-time.Minute)}, -time.Minute},
+		{"invalid expires", 0, []string{expiresHeader, "0"}, 0},
+		{"expires overrides deadline", 10, []string{expiresHeader, httpDate(time.Minute)}, time.Minute},
+		{"deadline overrides heuristic", 10, []string{lastModifiedHeader, httpDate(-time.Hour)}, time.Second * 10},
+		{"heuristic", 0, []string{lastModifiedHeader, httpDate(-time.Second * 100)}, time.Second * 10},
+		{"last modified in the future", 0, []string{lastModifiedHeader, httpDate(time.Hour)}, 0},
+		{"no expiration", 0, nil, 0},
+	}
+
+	for _, c := range cases {
+		res := fasthttp.AcquireResponse()
+		for i := 0; i+1 < len(c.headers); i += 2 {
+			res.Header.Set(c.headers[i], c.headers[i+1])
+		}
+
+		cc := util.ParseCacheControl(res.Header.Peek(cacheControlHeader))
+		if value := freshnessLifetime(&metapb.Cache{Deadline: c.deadline}, cc, res, date); value != c.expect {
+			t.Errorf("%s: expect lifetime %s, but %s", c.name, c.expect, value)
+		}
+		fasthttp.ReleaseResponse(res)
+	}
+}
+
+func TestCachingFreshResponse(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, recorder := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	headers := url.Values{cacheControlHeader: {"max-age=60"}}
+	res := doCachingRequest(p, headers)
+	defer fasthttp.ReleaseResponse(res)
+	if string(res.Body()) != "1" {
+		t.Fatalf("expect the response of the backend server, but %s", res.Body())
+	}
+
+	cached := doCachingRequest(p, headers)
+	defer fasthttp.ReleaseResponse(cached)
+	if string(cached.Body()) != "1" || recorder.get(ageHeader) == "" {
+		t.Errorf("expect the stored response with the age, but %s %q", cached.Body(), recorder.get(ageHeader))
+	}
+
+	// the request requires the response not older than the age
+	aged := url.Values{cacheControlHeader: {"max-age=60"}, ageHeader: {"30"}}
+	fasthttp.ReleaseResponse(doCachingRequest(p, aged))
+	res = doCachingRequest(p, aged, cacheControlHeader, "max-age=10")
+	defer fasthttp.ReleaseResponse(res)
+	if string(res.Body()) != "3" {
+		t.Errorf("expect the request max-age skips the older response, but %s", res.Body())
+	}
+
+	// the request no-cache skips the stored response
+	res = doCachingRequest(p, headers, cacheControlHeader, "no-cache")
+	defer fasthttp.ReleaseResponse(res)
+	if string(res.Body()) != "4" {
+		t.Errorf("expect the request no-cache goes to the backend server, but %s", res.Body())
+	}
+}
+
+func TestCachingNotStored(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, _ := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	cases := []struct {
+		name    string
+		values  url.Values
+		headers []string
+	}{
+		{"no-store", url.Values{cacheControlHeader: {"no-store, max-age=60"}}, nil},
+		{"private", url.Values{cacheControlHeader: {"private, max-age=60"}}, nil},
+		{"request no-store", url.Values{cacheControlHeader: {"max-age=60"}, "n": {"1"}}, []string{cacheControlHeader, "no-store"}},
+		{"authorization", url.Values{cacheControlHeader: {"max-age=60"}, "n": {"2"}}, []string{authorizationHeader, "Bearer t"}},
+		{"vary all", url.Values{cacheControlHeader: {"max-age=60"}, varyHeader: {"*"}}, nil},
+		{"no expiration", url.Values{"n": {"3"}}, nil},
+	}
+
+	for _, c := range cases {
+		hits := atomic.LoadInt64(&b.hits)
+		for i := 0; i < 2; i++ {
+			fasthttp.ReleaseResponse(doCachingRequest(p, c.values, c.headers...))
+		}
+		if value := atomic.LoadInt64(&b.hits) - hits; value != 2 {
+			t.Errorf("%s: expect the response is not stored, but %d requests", c.name, value)
+		}
+	}
+
+	// the authorized response is stored if it is allowed explicitly
+	for _, cc := range []string{"public, max-age=60", "s-maxage=60", "must-revalidate, max-age=60"} {
+		values := url.Values{cacheControlHeader: {cc}}
+		hits := atomic.LoadInt64(&b.hits)
+		for i := 0; i < 2; i++ {
+			fasthttp.ReleaseResponse(doCachingRequest(p, values, authorizationHeader, "Bearer t"))
+		}
+		if value := atomic.LoadInt64(&b.hits) - hits; value != 1 {
+			t.Errorf("%s: expect the authorized response is stored, but %d requests", cc, value)
+		}
+	}
+}
+
+func TestCachingVary(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, _ := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	values := url.Values{cacheControlHeader: {"max-age=60"}, varyHeader: {"Accept-Language"}}
+	cases := []struct {
+		lang   string
+		expect string
+	}{
+		{"en", "1"},
+		{"zh", "2"},
+		{"en", "1"},
+		{"zh", "2"},
+		{"", "3"},
+		{"", "3"},
+	}
+
+	for _, c := range cases {
+		res := doCachingRequest(p, values, "Accept-Language", c.lang)
+		if string(res.Body()) != c.expect {
+			t.Errorf("%s: expect the variant %s, but %s", c.lang, c.expect, res.Body())
+		}
+		fasthttp.ReleaseResponse(res)
+	}
+}
+
+func TestCachingNotModified(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, _ := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	values := url.Values{cacheControlHeader: {"max-age=60"}, etagHeader: {`"v1"`}}
+	fasthttp.ReleaseResponse(doCachingRequest(p, values))
+
+	res := doCachingRequest(p, values, ifNoneMatchHeader, `"v1"`)
+	defer fasthttp.ReleaseResponse(res)
+	if res.StatusCode() != fasthttp.StatusNotModified || len(res.Body()) != 0 {
+		t.Errorf("expect 304 for the matched etag, but %d %s", res.StatusCode(), res.Body())
+	}
+
+	res = doCachingRequest(p, values, ifNoneMatchHeader, `"v0"`)
+	defer fasthttp.ReleaseResponse(res)
+	if res.StatusCode() != fasthttp.StatusOK || string(res.Body()) != "1" {
+		t.Errorf("expect the stored response for the other etag, but %d %s", res.StatusCode(), res.Body())
+	}
+
+	if value := atomic.LoadInt64(&b.hits); value != 1 {
+		t.Errorf("expect the conditional requests are served by the cache, but %d requests", value)
+	}
+}
+
+func TestCachingRevalidate(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, recorder := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	// the response is stale, but it is stored to revalidate by the etag
+	values := url.Values{cacheControlHeader: {"max-age=0"}, etagHeader: {`"v1"`}}
+	fasthttp.ReleaseResponse(doCachingRequest(p, values))
+
+	res := doCachingRequest(p, values)
+	defer fasthttp.ReleaseResponse(res)
+	if res.StatusCode() != fasthttp.StatusOK || string(res.Body()) != "1" {
+		t.Errorf("expect the stored response merged with the 304, but %d %s", res.StatusCode(), res.Body())
+	}
+	if value := recorder.get("X-Revalidated"); value != "2" {
+		t.Errorf("expect the headers of the 304 are merged, but %q", value)
+	}
+
+	// the 304 of the revalidation is returned to the client with the matched etag
+	res = doCachingRequest(p, values, ifNoneMatchHeader, `"v1"`)
+	defer fasthttp.ReleaseResponse(res)
+	if res.StatusCode() != fasthttp.StatusNotModified {
+		t.Errorf("expect 304 for the matched etag, but %d", res.StatusCode())
+	}
+
+	if value := atomic.LoadInt64(&b.hits); value != 3 {
+		t.Errorf("expect every request is revalidated, but %d requests", value)
+	}
+}
+
+func TestCachingStaleWhileRevalidate(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, recorder := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	values := url.Values{cacheControlHeader: {"max-age=10, stale-while-revalidate=60"}, ageHeader: {"20"}}
+	fasthttp.ReleaseResponse(doCachingRequest(p, values))
+
+	res := doCachingRequest(p, values)
+	defer fasthttp.ReleaseResponse(res)
+	if string(res.Body()) != "1" || recorder.get(warningHeader) != staleWarning {
+		t.Errorf("expect the stale response with warning, but %s %q", res.Body(), recorder.get(warningHeader))
+	}
+
+	// the response is revalidated in background
+	if !waitFor(time.Second, func() bool { return atomic.LoadInt64(&b.hits) == 2 }) {
+		t.Fatalf("expect the background revalidation")
+	}
+
+	// the stale response is not served out of the stale-while-revalidate
+	expired := url.Values{cacheControlHeader: {"max-age=10, stale-while-revalidate=60"}, ageHeader: {"69"}}
+	fasthttp.ReleaseResponse(doCachingRequest(p, expired))
+	hits := atomic.LoadInt64(&b.hits)
+
+	// the age is in seconds, the response is out of the stale-while-revalidate after 1 second
+	time.Sleep(time.Second)
+	fasthttp.ReleaseResponse(doCachingRequest(p, expired))
+	if recorder.get(warningHeader) != "" || atomic.LoadInt64(&b.hits) != hits+1 {
+		t.Errorf("expect no stale response after the stale-while-revalidate")
+	}
+}
+
+func TestCachingStaleIfError(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, recorder := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	values := url.Values{cacheControlHeader: {"max-age=10, stale-if-error=60"}, ageHeader: {"20"}}
+	fasthttp.ReleaseResponse(doCachingRequest(p, values))
+
+	atomic.StoreInt32(&b.failed, 1)
+	res := doCachingRequest(p, values)
+	defer fasthttp.ReleaseResponse(res)
+	if res.StatusCode() != fasthttp.StatusOK || string(res.Body()) != "1" ||
+		recorder.get(warningHeader) != revalidateFailedWarning {
+		t.Errorf("expect the stale response for the error, but %d %s %q",
+			res.StatusCode(), res.Body(), recorder.get(warningHeader))
+	}
+
+	// the stale response is not used with must-revalidate
+	values = url.Values{cacheControlHeader: {"max-age=10, stale-if-error=60, must-revalidate"}, ageHeader: {"20"}}
+	atomic.StoreInt32(&b.failed, 0)
+	fasthttp.ReleaseResponse(doCachingRequest(p, values))
+	atomic.StoreInt32(&b.failed, 1)
+	res = doCachingRequest(p, values)
+	defer fasthttp.ReleaseResponse(res)
+	if res.StatusCode() != fasthttp.StatusInternalServerError {
+		t.Errorf("expect the error with must-revalidate, but %d %s", res.StatusCode(), res.Body())
+	}
+}
+
+func TestCachingStatusCodes(t *testing.T) {
+	b := newCachingBackend()
+	defer b.Close()
+	p, _ := newCachingProxy(t, &metapb.Cache{}, b)
+	defer p.GracefulStop()
+
+	cases := []struct {
+		status int
+		stored bool
+	}{
+		{http.StatusOK, true},
+		{http.StatusMovedPermanently, true},
+		{http.StatusCreated, false},
+		{http.StatusNotFound, false},
+	}
+
+	stored := func(status int) bool {
+		values := url.Values{cacheControlHeader: {"max-age=60"}, "status": {strconv.Itoa(status)}}
+		hits := atomic.LoadInt64(&b.hits)
+		for i := 0; i < 2; i++ {
+			fasthttp.ReleaseResponse(doCachingRequest(p, values))
+		}
+		return atomic.LoadInt64(&b.hits)-hits == 1
+	}
+
+	for _, c := range cases {
+		if value := stored(c.status); value != c.stored {
+			t.Errorf("%d: expect stored %v by default, but %v", c.status, c.stored, value)
+		}
+	}
+
+	// the status codes of the cache replace the default
+	p.dispatcher.apis[1].nodes[0].meta.Cache.StatusCodes = []int32{http.StatusNotFound}
+	if !stored(http.StatusNotFound) {
+		t.Errorf("expect the 404 is stored by the status codes")
+	}
+	values := url.Values{cacheControlHeader: {"max-age=60"}, "n": {"1"}}
+	hits := atomic.LoadInt64(&b.hits)
+	for i := 0; i < 2; i++ {
+		fasthttp.ReleaseResponse(doCachingRequest(p, values))
+	}
+	if value := atomic.LoadInt64(&b.hits) - hits; value != 2 {
+		t.Errorf("expect the 200 is not stored by the status codes, but %d requests", value)
+	}
+}
@@ -0,0 +1,159 @@
+package proxy
+
+import (
+	"github.com/fagongzi/gateway/pkg/expr"
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+type transformation struct {
+	meta  *metapb.Transformation
+	exprs []expr.Expr
+}
+
+func newTransformations(metas []*metapb.Transformation) []*transformation {
+	var values []*transformation
+	for _, meta := range metas {
+		t := &transformation{
+			meta: meta,
+		}
+
+		if meta.Action == metapb.TransformSet || meta.Action == metapb.TransformAppend {
+			exprs, err := expr.Parse([]byte(meta.Value))
+			if err != nil {
+				log.Fatalf("bug: parse transformation expr failed with error %+v", err)
+			}
+			t.exprs = exprs
+		}
+
+		values = append(values, t)
+	}
+
+	return values
+}
+
+func (t *transformation) isRequest() bool {
+	return t.meta.Target != metapb.ResponseHeader
+}
+
+func (t *transformation) value(ctx *expr.Ctx) []byte {
+	return expr.Exec(ctx, t.exprs...)
+}
+
+func (t *transformation) applyRequest(req *fasthttp.Request, ctx *expr.Ctx) {
+	name := t.meta.Name
+	switch t.meta.Target {
+	case metapb.RequestHeader:
+		switch t.meta.Action {
+		case metapb.TransformSet:
+			req.Header.SetBytesV(name, t.value(ctx))
+		case metapb.TransformAppend:
+			req.Header.AddBytesV(name, t.value(ctx))
+		case metapb.TransformRemove:
+			req.Header.Del(name)
+		case metapb.TransformRename:
+			if value := req.Header.Peek(name); len(value) > 0 {
+				req.Header.SetBytesV(t.meta.Value, append([]byte(nil), value...))
+				req.Header.Del(name)
+			}
+		}
+	case metapb.RequestQuery:
+		args := req.URI().QueryArgs()
+		switch t.meta.Action {
+		case metapb.TransformSet:
+			args.SetBytesV(name, t.value(ctx))
+		case metapb.TransformAppend:
+			args.AddBytesV(name, t.value(ctx))
+		case metapb.TransformRemove:
+			args.Del(name)
+		case metapb.TransformRename:
+			if args.Has(name) {
+				for _, value := range args.PeekMulti(name) {
+					args.AddBytesV(t.meta.Value, append([]byte(nil), value...))
+				}
+				args.Del(name)
+			}
+		}
+		// the query string is used if all the args are removed
+		req.URI().SetQueryStringBytes(args.QueryString())
+	case metapb.RequestCookie:
+		switch t.meta.Action {
+		case metapb.TransformSet:
+			req.Header.SetCookieBytesKV([]byte(name), t.value(ctx))
+		case metapb.TransformAppend:
+			// request cookies are unique by name, append only set the missing cookie
+			if len(req.Header.Cookie(name)) == 0 {
+				req.Header.SetCookieBytesKV([]byte(name), t.value(ctx))
+			}
+		case metapb.TransformRemove:
+			req.Header.DelCookie(name)
+		case metapb.TransformRename:
+			if value := req.Header.Cookie(name); len(value) > 0 {
+				req.Header.SetCookieBytesKV([]byte(t.meta.Value), append([]byte(nil), value...))
+				req.Header.DelCookie(name)
+			}
+		}
+	}
+}
+
+func (t *transformation) applyResponse(res *fasthttp.Response, ctx *expr.Ctx) {
+	name := t.meta.Name
+	switch t.meta.Action {
+	case metapb.TransformSet:
+		res.Header.SetBytesV(name, t.value(ctx))
+	case metapb.TransformAppend:
+		res.Header.AddBytesV(name, t.value(ctx))
+	case metapb.TransformRemove:
+		res.Header.Del(name)
+	case metapb.TransformRename:
+		if value := res.Header.Peek(name); len(value) > 0 {
+			res.Header.SetBytesV(t.meta.Value, append([]byte(nil), value...))
+			res.Header.Del(name)
+		}
+	}
+}
+
+// TransformFilter apply the transformations of the api and the dispatch node
+type TransformFilter struct {
+	filter.BaseFilter
+}
+
+func newTransformFilter() filter.Filter {
+	return &TransformFilter{}
+}
+
+// Init init filter
+func (f *TransformFilter) Init(cfg string) error {
+	return nil
+}
+
+// Name return name of this filter
+func (f *TransformFilter) Name() string {
+	return FilterTransform
+}
+
+// Pre execute before proxy
+func (f *TransformFilter) Pre(c filter.Context) (statusCode int, err error) {
+	dn := c.(*proxyContext).result
+	for _, t := range dn.node.transformations {
+		if t.isRequest() {
+			t.applyRequest(c.ForwardRequest(), dn.exprCtx)
+		}
+	}
+
+	return f.BaseFilter.Pre(c)
+}
+
+// Post execute after proxy
+func (f *TransformFilter) Post(c filter.Context) (statusCode int, err error) {
+	dn := c.(*proxyContext).result
+	for _, t := range dn.node.transformations {
+		if !t.isRequest() {
+			t.applyResponse(c.Response(), dn.exprCtx)
+		}
+	}
+
+	return f.BaseFilter.Post(c)
+}
@@ -0,0 +1,137 @@
+package proxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/expr"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+// headerValues returns all the values of the header name, joined by ','
+func headerValues(visit func(func(k, v []byte)), name string) string {
+	var values []string
+	visit(func(k, v []byte) {
+		if strings.EqualFold(string(k), name) {
+			values = append(values, string(v))
+		}
+	})
+	return strings.Join(values, ",")
+}
+
+func newTransformation(target metapb.TransformTarget, action metapb.TransformAction, name, value string) *transformation {
+	return newTransformations([]*metapb.Transformation{
+		{Target: target, Action: action, Name: name, Value: value},
+	})[0]
+}
+
+func TestTransformRequest(t *testing.T) {
+	origin := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(origin)
+	origin.SetRequestURI("http://gw/a")
+	origin.Header.SetCookie("uid", "u1")
+	ctx := &expr.Ctx{Origin: origin}
+
+	header := func(name string) func(*fasthttp.Request) string {
+		return func(req *fasthttp.Request) string {
+			return headerValues(req.Header.VisitAll, name)
+		}
+	}
+	query := func(req *fasthttp.Request) string {
+		return string(req.URI().QueryString())
+	}
+	cookie := func(name string) func(*fasthttp.Request) string {
+		return func(req *fasthttp.Request) string {
+			return string(req.Header.Cookie(name))
+		}
+	}
+
+	cases := []struct {
+		name   string
+		t      *transformation
+		uri    string
+		header string
+		cookie string
+		get    func(*fasthttp.Request) string
+		expect string
+	}{
+		{"set header", newTransformation(metapb.RequestHeader, metapb.TransformSet, "X-A", "$(origin.cookie.uid)"), "/a", "old", "", header("X-A"), "u1"},
+		{"set missing header", newTransformation(metapb.RequestHeader, metapb.TransformSet, "X-A", "v"), "/a", "", "", header("X-A"), "v"},
+		{"append header", newTransformation(metapb.RequestHeader, metapb.TransformAppend, "X-A", "$(origin.cookie.uid)"), "/a", "old", "", header("X-A"), "old,u1"},
+		{"remove header", newTransformation(metapb.RequestHeader, metapb.TransformRemove, "X-A", ""), "/a", "old", "", header("X-A"), ""},
+		{"rename header", newTransformation(metapb.RequestHeader, metapb.TransformRename, "X-A", "X-B"), "/a", "old", "", header("X-B"), "old"},
+		{"rename header removes the old", newTransformation(metapb.RequestHeader, metapb.TransformRename, "X-A", "X-B"), "/a", "old", "", header("X-A"), ""},
+		{"rename missing header", newTransformation(metapb.RequestHeader, metapb.TransformRename, "X-A", "X-B"), "/a", "", "", header("X-B"), ""},
+		{"set query", newTransformation(metapb.RequestQuery, metapb.TransformSet, "a", "$(origin.cookie.uid)"), "/a?a=1&b=2", "", "", query, "a=u1&b=2"},
+		{"append query", newTransformation(metapb.RequestQuery, metapb.TransformAppend, "a", "$(origin.cookie.uid)"), "/a?a=1", "", "", query, "a=1&a=u1"},
+		{"remove query", newTransformation(metapb.RequestQuery, metapb.TransformRemove, "a", ""), "/a?a=1&b=2", "", "", query, "b=2"},
+		{"remove the last query", newTransformation(metapb.RequestQuery, metapb.TransformRemove, "a", ""), "/a?a=1", "", "", query, ""},
+		{"rename query", newTransformation(metapb.RequestQuery, metapb.TransformRename, "a", "c"), "/a?a=1&b=2&a=3", "", "", query, "b=2&c=1&c=3"},
+		{"rename missing query", newTransformation(metapb.RequestQuery, metapb.TransformRename, "a", "c"), "/a?b=2", "", "", query, "b=2"},
+		{"set cookie", newTransformation(metapb.RequestCookie, metapb.TransformSet, "sid", "$(origin.cookie.uid)"), "/a", "", "old", cookie("sid"), "u1"},
+		{"append cookie keeps the exist", newTransformation(metapb.RequestCookie, metapb.TransformAppend, "sid", "$(origin.cookie.uid)"), "/a", "", "old", cookie("sid"), "old"},
+		{"append missing cookie", newTransformation(metapb.RequestCookie, metapb.TransformAppend, "sid", "$(origin.cookie.uid)"), "/a", "", "", cookie("sid"), "u1"},
+		{"remove cookie", newTransformation(metapb.RequestCookie, metapb.TransformRemove, "sid", ""), "/a", "", "old", cookie("sid"), ""},
+		{"rename cookie", newTransformation(metapb.RequestCookie, metapb.TransformRename, "sid", "session"), "/a", "", "old", cookie("session"), "old"},
+		{"rename cookie removes the old", newTransformation(metapb.RequestCookie, metapb.TransformRename, "sid", "session"), "/a", "", "old", cookie("sid"), ""},
+	}
+
+	for _, c := range cases {
+		req := fasthttp.AcquireRequest()
+		req.SetRequestURI("http://backend" + c.uri)
+		if c.header != "" {
+			req.Header.Set("X-A", c.header)
+		}
+		if c.cookie != "" {
+			req.Header.SetCookie("sid", c.cookie)
+		}
+
+		if !c.t.isRequest() {
+			t.Errorf("%s: expect request transformation", c.name)
+		}
+		c.t.applyRequest(req, ctx)
+		if value := c.get(req); value != c.expect {
+			t.Errorf("%s: expect %q, but %q", c.name, c.expect, value)
+		}
+		fasthttp.ReleaseRequest(req)
+	}
+}
+
+func TestTransformResponse(t *testing.T) {
+	origin := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(origin)
+	origin.SetRequestURI("http://gw/a?id=1")
+	ctx := &expr.Ctx{Origin: origin}
+
+	cases := []struct {
+		name   string
+		t      *transformation
+		header string
+		get    string
+		expect string
+	}{
+		{"set header", newTransformation(metapb.ResponseHeader, metapb.TransformSet, "X-A", "$(origin.query.id)"), "old", "X-A", "1"},
+		{"append header", newTransformation(metapb.ResponseHeader, metapb.TransformAppend, "X-A", "$(origin.query.id)"), "old", "X-A", "old,1"},
+		{"remove header", newTransformation(metapb.ResponseHeader, metapb.TransformRemove, "X-A", ""), "old", "X-A", ""},
+		{"rename header", newTransformation(metapb.ResponseHeader, metapb.TransformRename, "X-A", "X-B"), "old", "X-B", "old"},
+		{"rename header removes the old", newTransformation(metapb.ResponseHeader, metapb.TransformRename, "X-A", "X-B"), "old", "X-A", ""},
+		{"rename missing header", newTransformation(metapb.ResponseHeader, metapb.TransformRename, "X-A", "X-B"), "", "X-B", ""},
+	}
+
+	for _, c := range cases {
+		res := fasthttp.AcquireResponse()
+		if c.header != "" {
+			res.Header.Set("X-A", c.header)
+		}
+
+		if c.t.isRequest() {
+			t.Errorf("%s: expect response transformation", c.name)
+		}
+		c.t.applyResponse(res, ctx)
+		if value := headerValues(res.Header.VisitAll, c.get); value != c.expect {
+			t.Errorf("%s: expect %q, but %q", c.name, c.expect, value)
+		}
+		fasthttp.ReleaseResponse(res)
+	}
+}
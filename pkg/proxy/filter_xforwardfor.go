@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/fagongzi/util/hack"
 )
 
@@ -14,10 +15,14 @@ var (
 // XForwardForFilter XForwardForFilter
 type XForwardForFilter struct {
 	filter.BaseFilter
+
+	trustedProxies *util.IPTrie
 }
 
-func newXForwardForFilter() filter.Filter {
-	return &XForwardForFilter{}
+func newXForwardForFilter(trustedProxies *util.IPTrie) filter.Filter {
+	return &XForwardForFilter{
+		trustedProxies: trustedProxies,
+	}
 }
 
 // Init init filter
@@ -30,10 +35,11 @@ func (f *XForwardForFilter) Name() string {
 	return FilterXForward
 }
 
-// Pre execute before proxy
+// Pre execute before proxy, the remote ip is appended to the X-Forwarded-For header,
+// the header from the client which is not a trusted proxy is dropped
 func (f *XForwardForFilter) Pre(c filter.Context) (statusCode int, err error) {
 	prevForward := c.OriginRequest().Request.Header.PeekBytes(headerName)
-	if len(prevForward) == 0 {
+	if len(prevForward) == 0 || !f.trustedProxies.Contains(c.OriginRequest().RemoteIP()) {
 		c.ForwardRequest().Header.SetBytesKV(headerName, hack.StringToSlice(c.OriginRequest().RemoteIP().String()))
 	} else {
 		var buf bytes.Buffer
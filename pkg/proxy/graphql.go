@@ -0,0 +1,747 @@
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// graphQLField is a field of the selection set, the args are resolved by the variables
+type graphQLField struct {
+	alias      string
+	name       string
+	args       []graphQLArg
+	selections []*graphQLField
+}
+
+type graphQLArg struct {
+	name  string
+	value interface{}
+}
+
+// graphQLVariable is a variable reference in the args, resolved before execution
+type graphQLVariable string
+
+type graphQLVariableDefinition struct {
+	name         string
+	required     bool
+	defaultValue interface{}
+	hasDefault   bool
+}
+
+type graphQLOperation struct {
+	name       string
+	variables  []graphQLVariableDefinition
+	selections []*graphQLField
+}
+
+func (f *graphQLField) key() string {
+	if f.alias != "" {
+		return f.alias
+	}
+
+	return f.name
+}
+
+func (f *graphQLField) arg(name string) (interface{}, bool) {
+	for _, arg := range f.args {
+		if arg.name == name {
+			return arg.value, true
+		}
+	}
+
+	return nil, false
+}
+
+// graphQLDepth returns the max depth of the selections
+func graphQLDepth(selections []*graphQLField) int {
+	max := 0
+	for _, f := range selections {
+		if depth := graphQLDepth(f.selections) + 1; depth > max {
+			max = depth
+		}
+	}
+
+	return max
+}
+
+// graphQLComplexity returns the count of all the selected fields
+func graphQLComplexity(selections []*graphQLField) int {
+	n := 0
+	for _, f := range selections {
+		n += graphQLComplexity(f.selections) + 1
+	}
+
+	return n
+}
+
+// parseGraphQL parse the query document, and returns the operation to execute.
+// Only the query operations without fragments and directives are supported. The
+// selection sets nested deeper than the maxDepth are rejected while parsing.
+func parseGraphQL(query string, operationName string, maxDepth int) (*graphQLOperation, error) {
+	if maxDepth <= 0 || maxDepth > graphQLMaxNesting {
+		maxDepth = graphQLMaxNesting
+	}
+
+	p := &graphQLParser{input: query, maxDepth: maxDepth}
+	p.next()
+
+	var ops []*graphQLOperation
+	for p.token != graphQLEOF {
+		op, err := p.parseOperation()
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, op)
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("missing graphql operation")
+	}
+
+	if operationName == "" {
+		if len(ops) > 1 {
+			return nil, fmt.Errorf("missing operation name with multiple operations")
+		}
+
+		return ops[0], nil
+	}
+
+	for _, op := range ops {
+		if op.name == operationName {
+			return op, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown operation named %s", operationName)
+}
+
+// resolveVariables replace the variable references in the args by the values
+func (op *graphQLOperation) resolveVariables(values map[string]interface{}) error {
+	vars := make(map[string]interface{}, len(op.variables))
+	for _, def := range op.variables {
+		value, ok := values[def.name]
+		if !ok {
+			if def.hasDefault {
+				value = def.defaultValue
+			} else if def.required {
+				return fmt.Errorf("variable $%s is required", def.name)
+			}
+		}
+
+		vars[def.name] = value
+	}
+
+	var resolve func([]*graphQLField) error
+	resolve = func(selections []*graphQLField) error {
+		for _, f := range selections {
+			for i := range f.args {
+				value, err := resolveGraphQLValue(f.args[i].value, vars)
+				if err != nil {
+					return err
+				}
+				f.args[i].value = value
+			}
+
+			if err := resolve(f.selections); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return resolve(op.selections)
+}
+
+func resolveGraphQLValue(value interface{}, vars map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case graphQLVariable:
+		value, ok := vars[string(v)]
+		if !ok {
+			return nil, fmt.Errorf("variable $%s is not defined", v)
+		}
+		return value, nil
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i, item := range v {
+			value, err := resolveGraphQLValue(item, vars)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			value, err := resolveGraphQLValue(item, vars)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = value
+		}
+		return values, nil
+	default:
+		return value, nil
+	}
+}
+
+type graphQLToken int
+
+const (
+	graphQLEOF = graphQLToken(iota)
+	graphQLPunctuator
+	graphQLName
+	graphQLInt
+	graphQLFloat
+	graphQLString
+)
+
+// graphQLMaxNesting is the hard limit of the nested selection sets, lists, objects
+// and types, the parser is recursive, so the nesting must be limited to avoid
+// exhausting the stack by a malicious query
+const graphQLMaxNesting = 64
+
+type graphQLParser struct {
+	input    string
+	pos      int
+	token    graphQLToken
+	value    string
+	err      error
+	maxDepth int
+	// depth is the depth of the current selection set
+	depth int
+	// nesting is the nesting of the current value or type
+	nesting int
+}
+
+// nest increase the nesting of the values and the types, the caller must call the
+// returned func after the value is parsed
+func (p *graphQLParser) nest() (func(), error) {
+	p.nesting++
+	unnest := func() { p.nesting-- }
+	if p.nesting > graphQLMaxNesting {
+		return unnest, p.errorf("exceeds the max nesting %d", graphQLMaxNesting)
+	}
+
+	return unnest, nil
+}
+
+func (p *graphQLParser) errorf(format string, args ...interface{}) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	return fmt.Errorf("graphql syntax error at %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// next scans the next token, the commas and comments are ignored
+func (p *graphQLParser) next() {
+	if p.err != nil {
+		p.token = graphQLEOF
+		return
+	}
+
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			p.pos++
+		} else if c == '#' {
+			for p.pos < len(p.input) && p.input[p.pos] != '\n' {
+				p.pos++
+			}
+		} else {
+			break
+		}
+	}
+
+	if p.pos >= len(p.input) {
+		p.token, p.value = graphQLEOF, ""
+		return
+	}
+
+	start := p.pos
+	c := p.input[p.pos]
+	switch {
+	case strings.HasPrefix(p.input[p.pos:], "..."):
+		p.pos += 3
+		p.token, p.value = graphQLPunctuator, "..."
+	case strings.IndexByte("!$()[]{}:=@|&", c) >= 0:
+		p.pos++
+		p.token, p.value = graphQLPunctuator, string(c)
+	case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		for p.pos < len(p.input) && isGraphQLNameChar(p.input[p.pos]) {
+			p.pos++
+		}
+		p.token, p.value = graphQLName, p.input[start:p.pos]
+	case c == '-' || (c >= '0' && c <= '9'):
+		p.scanNumber()
+	case c == '"':
+		p.scanString()
+	default:
+		p.err = p.errorf("unexpected character %q", c)
+		p.token = graphQLEOF
+	}
+}
+
+func (p *graphQLParser) scanNumber() {
+	start := p.pos
+	p.token = graphQLInt
+	if p.input[p.pos] == '-' {
+		p.pos++
+	}
+
+	digits := func() int {
+		n := 0
+		for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+			p.pos++
+			n++
+		}
+		return n
+	}
+
+	if digits() == 0 {
+		p.err = p.errorf("invalid number")
+		p.token = graphQLEOF
+		return
+	}
+
+	if p.pos < len(p.input) && p.input[p.pos] == '.' {
+		p.pos++
+		p.token = graphQLFloat
+		if digits() == 0 {
+			p.err = p.errorf("invalid number")
+			p.token = graphQLEOF
+			return
+		}
+	}
+
+	if p.pos < len(p.input) && (p.input[p.pos] == 'e' || p.input[p.pos] == 'E') {
+		p.pos++
+		p.token = graphQLFloat
+		if p.pos < len(p.input) && (p.input[p.pos] == '+' || p.input[p.pos] == '-') {
+			p.pos++
+		}
+		if digits() == 0 {
+			p.err = p.errorf("invalid number")
+			p.token = graphQLEOF
+			return
+		}
+	}
+
+	p.value = p.input[start:p.pos]
+}
+
+func (p *graphQLParser) scanString() {
+	if strings.HasPrefix(p.input[p.pos:], `"""`) {
+		p.err = p.errorf("block string is not supported")
+		p.token = graphQLEOF
+		return
+	}
+
+	var buf strings.Builder
+	p.pos++
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			p.token, p.value = graphQLString, buf.String()
+			return
+		case '\n', '\r':
+			p.pos = len(p.input)
+		case '\\':
+			if p.pos+1 >= len(p.input) {
+				p.pos = len(p.input)
+				break
+			}
+
+			p.pos += 2
+			switch e := p.input[p.pos-1]; e {
+			case '"', '\\', '/':
+				buf.WriteByte(e)
+			case 'b':
+				buf.WriteByte('\b')
+			case 'f':
+				buf.WriteByte('\f')
+			case 'n':
+				buf.WriteByte('\n')
+			case 'r':
+				buf.WriteByte('\r')
+			case 't':
+				buf.WriteByte('\t')
+			case 'u':
+				if p.pos+4 > len(p.input) {
+					p.pos = len(p.input)
+					break
+				}
+
+				r, err := strconv.ParseUint(p.input[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					p.err = p.errorf("invalid unicode escape")
+					p.token = graphQLEOF
+					return
+				}
+				buf.WriteRune(rune(r))
+				p.pos += 4
+			default:
+				p.err = p.errorf("invalid escape \\%c", e)
+				p.token = graphQLEOF
+				return
+			}
+		default:
+			r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+			buf.WriteRune(r)
+			p.pos += size
+		}
+	}
+
+	p.err = p.errorf("unterminated string")
+	p.token = graphQLEOF
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func (p *graphQLParser) is(token graphQLToken, value string) bool {
+	return p.token == token && p.value == value
+}
+
+func (p *graphQLParser) expect(value string) error {
+	if !p.is(graphQLPunctuator, value) {
+		return p.errorf("expect %s", value)
+	}
+
+	p.next()
+	return nil
+}
+
+func (p *graphQLParser) name() (string, error) {
+	if p.token != graphQLName {
+		return "", p.errorf("expect name")
+	}
+
+	value := p.value
+	p.next()
+	return value, nil
+}
+
+func (p *graphQLParser) parseOperation() (*graphQLOperation, error) {
+	op := &graphQLOperation{}
+	if p.is(graphQLPunctuator, "{") {
+		selections, err := p.parseSelections()
+		if err != nil {
+			return nil, err
+		}
+
+		op.selections = selections
+		return op, nil
+	}
+
+	if p.token != graphQLName {
+		return nil, p.errorf("expect operation")
+	}
+
+	switch p.value {
+	case "query":
+	case "mutation", "subscription", "fragment":
+		return nil, fmt.Errorf("graphql %s is not supported", p.value)
+	default:
+		return nil, p.errorf("unknown operation %s", p.value)
+	}
+	p.next()
+
+	if p.token == graphQLName {
+		op.name = p.value
+		p.next()
+	}
+
+	if p.is(graphQLPunctuator, "(") {
+		p.next()
+		for !p.is(graphQLPunctuator, ")") {
+			def, err := p.parseVariableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			op.variables = append(op.variables, def)
+		}
+		p.next()
+	}
+
+	if p.is(graphQLPunctuator, "@") {
+		return nil, fmt.Errorf("graphql directives are not supported")
+	}
+
+	selections, err := p.parseSelections()
+	if err != nil {
+		return nil, err
+	}
+
+	op.selections = selections
+	return op, nil
+}
+
+func (p *graphQLParser) parseVariableDefinition() (graphQLVariableDefinition, error) {
+	def := graphQLVariableDefinition{}
+	if err := p.expect("$"); err != nil {
+		return def, err
+	}
+
+	name, err := p.name()
+	if err != nil {
+		return def, err
+	}
+	def.name = name
+
+	if err := p.expect(":"); err != nil {
+		return def, err
+	}
+
+	required, err := p.parseType()
+	if err != nil {
+		return def, err
+	}
+	def.required = required
+
+	if p.is(graphQLPunctuator, "=") {
+		p.next()
+		value, err := p.parseValue(true)
+		if err != nil {
+			return def, err
+		}
+		def.defaultValue = value
+		def.hasDefault = true
+	}
+
+	return def, nil
+}
+
+// parseType skip the type of the variable, and returns true if it is non-null
+func (p *graphQLParser) parseType() (bool, error) {
+	unnest, err := p.nest()
+	defer unnest()
+	if err != nil {
+		return false, err
+	}
+
+	if p.is(graphQLPunctuator, "[") {
+		p.next()
+		if _, err := p.parseType(); err != nil {
+			return false, err
+		}
+
+		if err := p.expect("]"); err != nil {
+			return false, err
+		}
+	} else if _, err := p.name(); err != nil {
+		return false, err
+	}
+
+	if p.is(graphQLPunctuator, "!") {
+		p.next()
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (p *graphQLParser) parseSelections() ([]*graphQLField, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > p.maxDepth {
+		return nil, fmt.Errorf("query depth exceeds the max depth %d", p.maxDepth)
+	}
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var selections []*graphQLField
+	for !p.is(graphQLPunctuator, "}") {
+		if p.is(graphQLPunctuator, "...") {
+			return nil, fmt.Errorf("graphql fragments are not supported")
+		}
+
+		f, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, f)
+	}
+	p.next()
+
+	if len(selections) == 0 {
+		return nil, p.errorf("empty selection set")
+	}
+
+	return mergeGraphQLFields(selections)
+}
+
+// mergeGraphQLFields merge the fields with the same response key, the fields must have
+// the same name and args, otherwise the response has the duplicate keys
+func mergeGraphQLFields(selections []*graphQLField) ([]*graphQLField, error) {
+	merged := make([]*graphQLField, 0, len(selections))
+	keys := make(map[string]*graphQLField, len(selections))
+	for _, f := range selections {
+		prev, ok := keys[f.key()]
+		if !ok {
+			keys[f.key()] = f
+			merged = append(merged, f)
+			continue
+		}
+
+		if prev.name != f.name ||
+			!reflect.DeepEqual(prev.args, f.args) ||
+			(len(prev.selections) == 0) != (len(f.selections) == 0) {
+			return nil, fmt.Errorf("fields %s conflict, use the different aliases", f.key())
+		}
+
+		if len(f.selections) > 0 {
+			values, err := mergeGraphQLFields(append(prev.selections[:len(prev.selections):len(prev.selections)], f.selections...))
+			if err != nil {
+				return nil, err
+			}
+			prev.selections = values
+		}
+	}
+
+	return merged, nil
+}
+
+func (p *graphQLParser) parseField() (*graphQLField, error) {
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+
+	f := &graphQLField{name: name}
+	if p.is(graphQLPunctuator, ":") {
+		p.next()
+		f.alias = name
+		if f.name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.is(graphQLPunctuator, "(") {
+		p.next()
+		for !p.is(graphQLPunctuator, ")") {
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+
+			value, err := p.parseValue(false)
+			if err != nil {
+				return nil, err
+			}
+			f.args = append(f.args, graphQLArg{name: name, value: value})
+		}
+		p.next()
+	}
+
+	if p.is(graphQLPunctuator, "@") {
+		return nil, fmt.Errorf("graphql directives are not supported")
+	}
+
+	if p.is(graphQLPunctuator, "{") {
+		if f.selections, err = p.parseSelections(); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// parseValue parse a value, the enum value is parsed as a string
+func (p *graphQLParser) parseValue(constant bool) (interface{}, error) {
+	unnest, err := p.nest()
+	defer unnest()
+	if err != nil {
+		return nil, err
+	}
+
+	value := p.value
+	switch p.token {
+	case graphQLInt, graphQLFloat:
+		p.next()
+		return json.Number(value), nil
+	case graphQLString:
+		p.next()
+		return value, nil
+	case graphQLName:
+		p.next()
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		return value, nil
+	case graphQLPunctuator:
+		switch value {
+		case "$":
+			if constant {
+				return nil, p.errorf("unexpected variable")
+			}
+
+			p.next()
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			return graphQLVariable(name), nil
+		case "[":
+			p.next()
+			values := []interface{}{}
+			for !p.is(graphQLPunctuator, "]") {
+				item, err := p.parseValue(constant)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, item)
+			}
+			p.next()
+			return values, nil
+		case "{":
+			p.next()
+			values := make(map[string]interface{})
+			for !p.is(graphQLPunctuator, "}") {
+				name, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+
+				item, err := p.parseValue(constant)
+				if err != nil {
+					return nil, err
+				}
+				values[name] = item
+			}
+			p.next()
+			return values, nil
+		}
+	}
+
+	return nil, p.errorf("expect value")
+}
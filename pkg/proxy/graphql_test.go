@@ -0,0 +1,359 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+// formatGraphQLFields returns the selections like: a:user(id:1){id,name}
+func formatGraphQLFields(selections []*graphQLField) string {
+	var values []string
+	for _, f := range selections {
+		value := f.name
+		if f.alias != "" {
+			value = f.alias + ":" + value
+		}
+
+		if len(f.args) > 0 {
+			var args []string
+			for _, arg := range f.args {
+				args = append(args, arg.name+":"+formatGraphQLValue(arg.value))
+			}
+			value += "(" + strings.Join(args, ",") + ")"
+		}
+
+		if len(f.selections) > 0 {
+			value += "{" + formatGraphQLFields(f.selections) + "}"
+		}
+		values = append(values, value)
+	}
+
+	return strings.Join(values, ",")
+}
+
+func formatGraphQLValue(value interface{}) string {
+	switch v := value.(type) {
+	case graphQLVariable:
+		return "$" + string(v)
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			values = append(values, formatGraphQLValue(item))
+		}
+		return "[" + strings.Join(values, ",") + "]"
+	case map[string]interface{}:
+		var values []string
+		for key, item := range v {
+			values = append(values, key+":"+formatGraphQLValue(item))
+		}
+		sort.Strings(values)
+		return "{" + strings.Join(values, ",") + "}"
+	case string:
+		return fmt.Sprintf("%q", v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func TestParseGraphQL(t *testing.T) {
+	cases := []struct {
+		query         string
+		operationName string
+		expect        string
+	}{
+		{`{ user(id: 1) { id name } }`, "", `user(id:1){id,name}`},
+		{`query Q($id: ID!, $n: Int = 10) { u: user(id: $id) { id } }`, "", `u:user(id:$id){id}`},
+		{"# comment\n{ a, b }", "", `a,b`},
+		{`query A { a } query B { b }`, "B", `b`},
+		{`{ user(name: "a\"bA\n") { id } }`, "", `user(name:"a\"bA\n"){id}`},
+		{`{ users(ids: [1, 2.5, -3e2], filter: {status: UP, deleted: false, tag: null}) { id } }`, "",
+			`users(ids:[1,2.5,-3e2],filter:{deleted:false,status:"UP",tag:<nil>}){id}`},
+		{`{ user(id: 1) { id } user(id: 1) { name id } }`, "", `user(id:1){id,name}`},
+		{`{ a a n: a }`, "", `a,n:a`},
+		{`{ user(id: 1) { address { city } } user(id: 1) { address { zip } } }`, "", `user(id:1){address{city,zip}}`},
+	}
+
+	for _, c := range cases {
+		op, err := parseGraphQL(c.query, c.operationName, 0)
+		if err != nil {
+			t.Errorf("%s: expect no error, but %+v", c.query, err)
+			continue
+		}
+
+		if value := formatGraphQLFields(op.selections); value != c.expect {
+			t.Errorf("%s: expect %s, but %s", c.query, c.expect, value)
+		}
+	}
+}
+
+func TestParseGraphQLMalformed(t *testing.T) {
+	cases := []struct {
+		query         string
+		operationName string
+	}{
+		{``, ""},
+		{`{`, ""},
+		{`{ }`, ""},
+		{`{ a `, ""},
+		{`{ a(id: ) }`, ""},
+		{`{ a(id: "x) }`, ""},
+		{`{ a(id: """x""") }`, ""},
+		{`{ a(id: "\q") }`, ""},
+		{`{ a(id: "\u00zz") }`, ""},
+		{`{ a(id: 1.) }`, ""},
+		{`{ a(id: -) }`, ""},
+		{`{ a(id: [1, 2) }`, ""},
+		{`{ a(id: {k 1}) }`, ""},
+		{`{ a ; }`, ""},
+		{`{ ...F }`, ""},
+		{`fragment F on User { id }`, ""},
+		{`mutation { a }`, ""},
+		{`subscription { a }`, ""},
+		{`select { a }`, ""},
+		{`{ a @skip(if: true) }`, ""},
+		{`query @skip(if: true) { a }`, ""},
+		{`query ($id: ID = $x) { a }`, ""},
+		{`query ($id: [ID) { a }`, ""},
+		{`query (id: ID) { a }`, ""},
+		{`query A { a } query B { b }`, ""},
+		{`query A { a }`, "B"},
+		{`{ a: user(id: 1) a: user(id: 2) }`, ""},
+		{`{ a: user a: account }`, ""},
+		{`{ user { id } user }`, ""},
+		{`{ user { n: name n: id } }`, ""},
+		{`{ user { address { city } } user { address { c: zip c: city } } }`, ""},
+	}
+
+	for _, c := range cases {
+		if _, err := parseGraphQL(c.query, c.operationName, 0); err == nil {
+			t.Errorf("%s: expect error", c.query)
+		}
+	}
+}
+
+func TestParseGraphQLDepth(t *testing.T) {
+	nested := func(n int) string {
+		return strings.Repeat("{ a ", n) + strings.Repeat("} ", n)
+	}
+
+	if _, err := parseGraphQL(nested(3), "", 3); err != nil {
+		t.Errorf("expect no error within the max depth, but %+v", err)
+	}
+	if _, err := parseGraphQL(nested(4), "", 3); err == nil {
+		t.Errorf("expect error over the max depth")
+	}
+
+	// the deeply nested input is rejected by the hard limit without the stack overflow
+	n := 100000
+	cases := []string{
+		nested(n),
+		"{ a(v: " + strings.Repeat("[", n) + strings.Repeat("]", n) + ") }",
+		"{ a(v: " + strings.Repeat("{k: ", n) + "1" + strings.Repeat("}", n) + ") }",
+		"query ($v: " + strings.Repeat("[", n) + "ID" + strings.Repeat("]", n) + ") { a }",
+	}
+	for _, query := range cases {
+		if _, err := parseGraphQL(query, "", 1000); err == nil {
+			t.Errorf("%s: expect error over the max nesting", query[:20])
+		}
+	}
+
+	query := "{ a(v: " + strings.Repeat("[", graphQLMaxNesting-1) + strings.Repeat("]", graphQLMaxNesting-1) + ") }"
+	if _, err := parseGraphQL(query, "", 0); err != nil {
+		t.Errorf("expect no error within the max nesting, but %+v", err)
+	}
+}
+
+func TestResolveGraphQLVariables(t *testing.T) {
+	op, err := parseGraphQL(`query ($id: ID!, $n: Int = 10, $o: String) {
+		user(id: $id, n: $n, o: $o, list: [$id], obj: {k: $id}) { id }
+	}`, "", 0)
+	if err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+
+	if err := op.resolveVariables(map[string]interface{}{"id": "1"}); err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+
+	expect := `user(id:"1",n:10,o:<nil>,list:["1"],obj:{k:"1"}){id}`
+	if value := formatGraphQLFields(op.selections); value != expect {
+		t.Errorf("expect %s, but %s", expect, value)
+	}
+
+	op, _ = parseGraphQL(`query ($id: ID!) { user(id: $id) { id } }`, "", 0)
+	if err := op.resolveVariables(nil); err == nil {
+		t.Errorf("expect error for the missing required variable")
+	}
+
+	op, _ = parseGraphQL(`{ user(id: $id) { id } }`, "", 0)
+	if err := op.resolveVariables(map[string]interface{}{"id": "1"}); err == nil {
+		t.Errorf("expect error for the undefined variable")
+	}
+
+	op, _ = parseGraphQL(`query ($id: ID) { user { friends(id: $x) } }`, "", 0)
+	if err := op.resolveVariables(nil); err == nil {
+		t.Errorf("expect error for the undefined variable of the nested field")
+	}
+}
+
+func TestCheckGraphQL(t *testing.T) {
+	p := &Proxy{cfg: &Cfg{Option: &Option{LimitGraphQLDepth: 2, LimitGraphQLComplexity: 3}}}
+	schema := map[string]*apiRuntime{"user": nil}
+
+	cases := []struct {
+		query string
+		valid bool
+	}{
+		{`{ user { id } }`, true},
+		{`{ user(id: 1) { id name } }`, true},
+		{`{ user { address { city } } }`, false},
+		{`{ user { id name email } }`, false},
+		{`{ a: user { id } b: user { id } }`, false},
+		{`{ account { id } }`, false},
+		{`{ user { friends(first: 1) } }`, false},
+	}
+
+	for _, c := range cases {
+		op, err := parseGraphQL(c.query, "", 0)
+		if err != nil {
+			t.Fatalf("%s: expect no error, but %+v", c.query, err)
+		}
+
+		err = p.checkGraphQL(op, schema)
+		if c.valid && err != nil {
+			t.Errorf("%s: expect valid, but %+v", c.query, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("%s: expect invalid", c.query)
+		}
+	}
+
+	// the default limits
+	p.cfg.Option.LimitGraphQLDepth = 0
+	p.cfg.Option.LimitGraphQLComplexity = 0
+	query := "{ user " + strings.Repeat("{ a ", defaultGraphQLMaxDepth) + strings.Repeat("} ", defaultGraphQLMaxDepth) + "}"
+	op, err := parseGraphQL(query, "", 0)
+	if err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+	if err := p.checkGraphQL(op, schema); err == nil {
+		t.Errorf("expect the default max depth")
+	}
+
+	var fields []string
+	for i := 0; i < defaultGraphQLMaxComplexity; i++ {
+		fields = append(fields, fmt.Sprintf("f%d", i))
+	}
+	op, err = parseGraphQL("{ user { "+strings.Join(fields, " ")+" } }", "", 0)
+	if err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+	if err := p.checkGraphQL(op, schema); err == nil {
+		t.Errorf("expect the default max complexity")
+	}
+}
+
+func TestProjectGraphQL(t *testing.T) {
+	cases := []struct {
+		query  string
+		value  string
+		expect string
+		err    bool
+	}{
+		{`{ user { id n: name } }`, `{"id":1,"name":"a","email":"b"}`, `{"id":1,"n":"a"}`, false},
+		{`{ user { id } }`, `[{"id":1,"name":"a"},{"id":2}]`, `[{"id":1},{"id":2}]`, false},
+		{`{ user { id address { city } } }`, `{"id":1,"address":null}`, `{"id":1,"address":null}`, false},
+		{`{ user { id missing } }`, `{"id":1}`, `{"id":1,"missing":null}`, false},
+		{`{ user { tags } }`, `{"tags":["a","b"],"x":1}`, `{"tags":["a","b"]}`, false},
+		{`{ user { address } }`, `{"address":{"city":"<a>"}}`, `{"address":{"city":"<a>"}}`, false},
+		{`{ user { id } }`, `1`, ``, true},
+		{`{ user { address { city } } }`, `{"address":"a"}`, ``, true},
+		{`{ user { id } }`, `[{"id":1},2]`, ``, true},
+	}
+
+	for _, c := range cases {
+		op, err := parseGraphQL(c.query, "", 0)
+		if err != nil {
+			t.Fatalf("%s: expect no error, but %+v", c.query, err)
+		}
+
+		var value interface{}
+		if err := unmarshalGraphQLJSON([]byte(c.value), &value); err != nil {
+			t.Fatalf("%s: expect no error, but %+v", c.value, err)
+		}
+
+		f := op.selections[0]
+		var buf bytes.Buffer
+		projectErr := projectGraphQL(&buf, value, f.selections, []interface{}{f.key()})
+		if c.err {
+			if projectErr == nil {
+				t.Errorf("%s %s: expect error", c.query, c.value)
+			}
+			continue
+		}
+
+		if projectErr != nil {
+			t.Errorf("%s %s: expect no error, but %+v", c.query, c.value, projectErr)
+			continue
+		}
+		if buf.String() != c.expect {
+			t.Errorf("%s %s: expect %s, but %s", c.query, c.value, c.expect, buf.String())
+		}
+	}
+
+	// the error path has the list index
+	op, _ := parseGraphQL(`{ user { address { city } } }`, "", 0)
+	var value interface{}
+	unmarshalGraphQLJSON([]byte(`[{"address":{"city":"a"}},{"address":1}]`), &value)
+	projectErr := projectGraphQL(&bytes.Buffer{}, value, op.selections[0].selections, []interface{}{"user"})
+	if projectErr == nil {
+		t.Fatalf("expect error")
+	}
+	path, _ := json.Marshal(projectErr.Path)
+	if string(path) != `["user",1,"address"]` {
+		t.Errorf("expect error path [\"user\",1,\"address\"], but %s", path)
+	}
+}
+
+func TestGraphQLSchema(t *testing.T) {
+	p := newTestProxy(t, &Option{}, nil)
+	defer p.GracefulStop()
+
+	newAPI := func(id uint64, field string) *metapb.API {
+		api := newTestAPI(fmt.Sprintf("/api%d", id))
+		api.ID = id
+		api.GraphQLField = field
+		return api
+	}
+
+	r := p.dispatcher
+	r.addAPI(newAPI(2, "user"))
+	r.addAPI(newAPI(3, "order"))
+	r.addAPI(newAPI(4, ""))
+	if len(r.graphQLSchema) != 2 || r.graphQLSchema["user"].meta.ID != 2 || r.graphQLSchema["order"].meta.ID != 3 {
+		t.Fatalf("expect the schema built by the apis, but %+v", r.graphQLSchema)
+	}
+
+	// the duplicate field is resolved by the api with the smallest id
+	r.addAPI(newAPI(1, "user"))
+	if r.graphQLSchema["user"].meta.ID != 1 {
+		t.Errorf("expect the field resolved by the api 1, but %d", r.graphQLSchema["user"].meta.ID)
+	}
+
+	down := newAPI(1, "user")
+	down.Status = metapb.Down
+	r.updateAPI(down)
+	if r.graphQLSchema["user"].meta.ID != 2 {
+		t.Errorf("expect the field of the down api is removed, but %d", r.graphQLSchema["user"].meta.ID)
+	}
+
+	r.removeAPI(3)
+	if _, ok := r.graphQLSchema["order"]; ok {
+		t.Errorf("expect the field of the removed api is removed")
+	}
+}
@@ -32,11 +32,65 @@ var (
 			Help:      "Bucketed histogram of api response time duration",
 			Buckets:   prometheus.ExponentialBuckets(0.0005, 2.0, 20),
 		}, []string{"name"})
+
+	sseStreamGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "sse_streams_open",
+			Help:      "Number of open server-sent events streams.",
+		}, []string{"name"})
+
+	webSocketConnGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "websocket_connections_open",
+			Help:      "Number of open websocket connections.",
+		}, []string{"name"})
+
+	webSocketMessageCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "websocket_message_total",
+			Help:      "Total number of websocket messages.",
+		}, []string{"name", "direction"})
+
+	webSocketBytesCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "websocket_bytes_total",
+			Help:      "Total bytes of websocket messages.",
+		}, []string{"name", "direction"})
+
+	shadowCompareCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "shadow_compare_total",
+			Help:      "Total number of copy routing response comparisons.",
+		}, []string{"name", "result"})
+
+	cacheCoalescedCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "gateway",
+			Subsystem: "proxy",
+			Name:      "cache_coalesced_total",
+			Help:      "Total number of requests coalesced on cache misses.",
+		}, []string{"name", "result"})
 )
 
 func init() {
 	prometheus.Register(apiRequestCounterVec)
 	prometheus.Register(apiResponseHistogramVec)
+	prometheus.Register(sseStreamGaugeVec)
+	prometheus.Register(webSocketConnGaugeVec)
+	prometheus.Register(webSocketMessageCounterVec)
+	prometheus.Register(webSocketBytesCounterVec)
+	prometheus.Register(shadowCompareCounterVec)
+	prometheus.Register(cacheCoalescedCounterVec)
 }
 
 func (p *Proxy) postRequest(api *apiRuntime, dispatches []*dispatchNode, startAt time.Time) {
@@ -90,3 +144,32 @@ func observeAPIResponse(name string, startAt time.Time) {
 	now := time.Now()
 	apiResponseHistogramVec.WithLabelValues(name).Observe(now.Sub(startAt).Seconds())
 }
+
+func incrSSEStream(name string) {
+	sseStreamGaugeVec.WithLabelValues(name).Inc()
+}
+
+func decrSSEStream(name string) {
+	sseStreamGaugeVec.WithLabelValues(name).Dec()
+}
+
+func incrWebSocketConn(name string) {
+	webSocketConnGaugeVec.WithLabelValues(name).Inc()
+}
+
+func decrWebSocketConn(name string) {
+	webSocketConnGaugeVec.WithLabelValues(name).Dec()
+}
+
+func incrWebSocketMessage(name, direction string, bytes int64) {
+	webSocketMessageCounterVec.WithLabelValues(name, direction).Inc()
+	webSocketBytesCounterVec.WithLabelValues(name, direction).Add(float64(bytes))
+}
+
+func incrShadowCompare(name, result string) {
+	shadowCompareCounterVec.WithLabelValues(name, result).Inc()
+}
+
+func incrCacheCoalesced(name, result string) {
+	cacheCoalescedCounterVec.WithLabelValues(name, result).Inc()
+}
@@ -4,13 +4,21 @@ import (
 	"sync"
 
 	"github.com/buger/jsonparser"
+	"github.com/fagongzi/gateway/pkg/expr"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/log"
 	"github.com/fagongzi/util/hack"
 )
 
 type multiContext struct {
 	sync.RWMutex
-	data []byte
+	data       []byte
+	dispatches []*dispatchNode
+	pending    []int32
+	dispatchFn func(*dispatchNode)
+	// the completed nodes are sent by the dispatch workers, the dependents
+	// are dispatched by the request goroutine
+	completeC chan *dispatchNode
 }
 
 func (c *multiContext) reset() {
@@ -19,6 +27,79 @@ func (c *multiContext) reset() {
 
 func (c *multiContext) init() {
 	c.data = emptyObject
+	c.dispatches = nil
+	c.pending = c.pending[:0]
+	c.dispatchFn = nil
+}
+
+// run dispatch the nodes without depends, the others are dispatched once
+// all their depends are completed, it returns after all nodes are completed
+func (c *multiContext) run(dispatches []*dispatchNode, dispatchFn func(*dispatchNode)) {
+	c.dispatches = dispatches
+	c.dispatchFn = dispatchFn
+	if cap(c.completeC) < len(dispatches) {
+		c.completeC = make(chan *dispatchNode, len(dispatches))
+	}
+
+	var roots []*dispatchNode
+	for _, dn := range dispatches {
+		c.pending = append(c.pending, int32(len(dn.node.depends)))
+		if len(dn.node.depends) == 0 {
+			roots = append(roots, dn)
+		}
+	}
+
+	for _, dn := range roots {
+		c.dispatch(dn)
+	}
+
+	for completed := 0; completed < len(dispatches); completed++ {
+		dn := <-c.completeC
+		for _, idx := range dn.node.dependents {
+			c.pending[idx]--
+			if c.pending[idx] != 0 {
+				continue
+			}
+
+			next := c.dispatches[idx]
+			if dep := c.failedDepend(next); dep != nil {
+				next.skip(dep)
+				continue
+			}
+			c.dispatch(next)
+		}
+	}
+}
+
+// failedDepend returns the failed depend of the node which is required
+func (c *multiContext) failedDepend(dn *dispatchNode) *dispatchNode {
+	for _, idx := range dn.node.depends {
+		dep := c.dispatches[idx]
+		if dep.isFailed() && dep.node.meta.FailurePolicy == metapb.FailureRequired {
+			return dep
+		}
+	}
+
+	return nil
+}
+
+func (c *multiContext) dispatch(dn *dispatchNode) {
+	// the nodes are executed concurrently, every node has its own depend data
+	c.RLock()
+	dn.exprCtx = &expr.Ctx{
+		Origin: dn.exprCtx.Origin,
+		Params: dn.exprCtx.Params,
+		Depend: c.data,
+	}
+	c.RUnlock()
+
+	c.dispatchFn(dn)
+}
+
+// completeNode is called by the dispatch workers, it never blocks,
+// the channel has a slot for every node
+func (c *multiContext) completeNode(dn *dispatchNode) {
+	c.completeC <- dn
 }
 
 func (c *multiContext) completePart(attr string, data []byte) {
@@ -0,0 +1,209 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/expr"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+func newDependsAPI(nodes ...*metapb.DispatchNode) *apiRuntime {
+	for _, node := range nodes {
+		node.ClusterID = 1
+	}
+
+	return newAPIRuntime(&metapb.API{
+		ID:    1,
+		Name:  "multi",
+		Nodes: nodes,
+	}, nil, 0)
+}
+
+func newMultiDispatches(api *apiRuntime, multiCtx *multiContext) []*dispatchNode {
+	dispatches := make([]*dispatchNode, len(api.nodes))
+	for idx, node := range api.nodes {
+		dispatches[idx] = &dispatchNode{
+			idx:      idx,
+			api:      api,
+			node:     node,
+			multiCtx: multiCtx,
+			exprCtx:  &expr.Ctx{},
+		}
+	}
+	return dispatches
+}
+
+func TestInitDepends(t *testing.T) {
+	api := newDependsAPI(
+		&metapb.DispatchNode{AttrName: "user"},
+		&metapb.DispatchNode{AttrName: "account", URLRewrite: "/accounts/$(depend.user.id)"},
+		&metapb.DispatchNode{AttrName: "order", BodyTemplate: `{"user":$(depend.user),"account":$(depend.account)}`},
+		&metapb.DispatchNode{AttrName: "items", URLRewrite: "/items/$(item.id)", ForEach: &metapb.ForEach{Path: "order.items"}},
+		&metapb.DispatchNode{AttrName: "stat", BatchIndex: 1},
+	)
+
+	expects := []struct {
+		depends    []int
+		dependents []int
+	}{
+		{nil, []int{1, 2, 4}},
+		{[]int{0}, []int{2, 4}},
+		{[]int{0, 1}, []int{3, 4}},
+		{[]int{2}, []int{4}},
+		{[]int{0, 1, 2, 3}, nil},
+	}
+
+	for idx, expect := range expects {
+		node := api.nodes[idx]
+		if !equalInts(node.depends, expect.depends) {
+			t.Errorf("node %d expect depends %+v, but %+v", idx, expect.depends, node.depends)
+		}
+		if !equalInts(node.dependents, expect.dependents) {
+			t.Errorf("node %d expect dependents %+v, but %+v", idx, expect.dependents, node.dependents)
+		}
+	}
+}
+
+func TestInitCyclicDepends(t *testing.T) {
+	api := newDependsAPI(
+		&metapb.DispatchNode{AttrName: "user", URLRewrite: "/users/$(depend.account.id)"},
+		&metapb.DispatchNode{AttrName: "account", URLRewrite: "/accounts/$(depend.user.id)"},
+		&metapb.DispatchNode{AttrName: "order", BatchIndex: 1},
+	)
+
+	// only the batch index is used
+	for idx, expect := range [][]int{nil, nil, {0, 1}} {
+		if !equalInts(api.nodes[idx].depends, expect) {
+			t.Errorf("node %d expect depends %+v, but %+v", idx, expect, api.nodes[idx].depends)
+		}
+	}
+}
+
+func TestMultiContextDispatchOrder(t *testing.T) {
+	api := newDependsAPI(
+		&metapb.DispatchNode{AttrName: "order", URLRewrite: "/orders/$(depend.account.id)"},
+		&metapb.DispatchNode{AttrName: "account", URLRewrite: "/accounts/$(depend.user.id)"},
+		&metapb.DispatchNode{AttrName: "user"},
+		&metapb.DispatchNode{AttrName: "stat"},
+	)
+
+	multiCtx := acquireMultiContext()
+	multiCtx.init()
+	defer releaseMultiContext(multiCtx)
+
+	var order []string
+	multiCtx.run(newMultiDispatches(api, multiCtx), func(dn *dispatchNode) {
+		order = append(order, dn.node.meta.AttrName)
+		dn.res = fasthttp.AcquireResponse()
+		dn.res.SetBodyString(`{"id":1}`)
+		dn.maybeDone()
+	})
+
+	expect := []string{"user", "stat", "account", "order"}
+	if len(order) != len(expect) {
+		t.Fatalf("expect %+v, but %+v", expect, order)
+	}
+	for idx := range expect {
+		if order[idx] != expect[idx] {
+			t.Fatalf("expect %+v, but %+v", expect, order)
+		}
+	}
+
+	if value := multiCtx.getAttr("order", "id"); value != "1" {
+		t.Errorf("expect order result, but %s", value)
+	}
+}
+
+func TestMultiContextSkipFailedDepend(t *testing.T) {
+	api := newDependsAPI(
+		&metapb.DispatchNode{AttrName: "user"},
+		&metapb.DispatchNode{AttrName: "account", URLRewrite: "/accounts/$(depend.user.id)"},
+		&metapb.DispatchNode{AttrName: "order", URLRewrite: "/orders/$(depend.account.id)"},
+		&metapb.DispatchNode{AttrName: "stat", FailurePolicy: metapb.FailureOptional},
+		&metapb.DispatchNode{AttrName: "report", URLRewrite: "/reports/$(depend.stat.id)"},
+	)
+
+	multiCtx := acquireMultiContext()
+	multiCtx.init()
+	defer releaseMultiContext(multiCtx)
+
+	dispatches := newMultiDispatches(api, multiCtx)
+	dispatched := make(map[string]bool)
+	multiCtx.run(dispatches, func(dn *dispatchNode) {
+		dispatched[dn.node.meta.AttrName] = true
+		switch dn.node.meta.AttrName {
+		case "user", "stat":
+			dn.code = fasthttp.StatusServiceUnavailable
+		}
+		dn.maybeDone()
+	})
+
+	for attr, expect := range map[string]bool{"user": true, "account": false, "order": false, "stat": true, "report": true} {
+		if dispatched[attr] != expect {
+			t.Errorf("%s expect dispatched %v", attr, expect)
+		}
+	}
+
+	for _, idx := range []int{1, 2} {
+		if dispatches[idx].err != ErrDependFailed || dispatches[idx].code != fasthttp.StatusServiceUnavailable {
+			t.Errorf("node %d expect depend failed, but %+v %d", idx, dispatches[idx].err, dispatches[idx].code)
+		}
+	}
+}
+
+func TestMultiContextNotBlockWorker(t *testing.T) {
+	var nodes []*metapb.DispatchNode
+	nodes = append(nodes, &metapb.DispatchNode{AttrName: "n0"})
+	for i := 1; i < 8; i++ {
+		nodes = append(nodes, &metapb.DispatchNode{AttrName: "n" + string(rune('0'+i)), BatchIndex: int32(i)})
+	}
+	api := newDependsAPI(nodes...)
+
+	// a worker with a full queue, it deadlocks if it sends the dependents to its queue
+	queue := make(chan *dispatchNode)
+	stopC := make(chan struct{})
+	defer close(stopC)
+	go func() {
+		for {
+			select {
+			case <-stopC:
+				return
+			case dn := <-queue:
+				dn.maybeDone()
+			}
+		}
+	}()
+
+	multiCtx := acquireMultiContext()
+	multiCtx.init()
+	defer releaseMultiContext(multiCtx)
+
+	doneC := make(chan struct{})
+	go func() {
+		multiCtx.run(newMultiDispatches(api, multiCtx), func(dn *dispatchNode) {
+			queue <- dn
+		})
+		close(doneC)
+	}()
+
+	select {
+	case <-doneC:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("dispatch blocked")
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
@@ -12,7 +12,6 @@ var (
 	contextPool      sync.Pool
 	dispatchNodePool  sync.Pool
 	multiContextPool sync.Pool
-	wgPool           sync.Pool
 	exprCtxPool      sync.Pool
 	bytesPool        = goetty.NewSyncPool(2, 1024*1024*5, 2)
 
@@ -21,21 +20,6 @@ var (
 	emptyDispathNode = dispatchNode{}
 )
 
-func acquireWG() *sync.WaitGroup {
-	v := wgPool.Get()
-	if v == nil {
-		return &sync.WaitGroup{}
-	}
-
-	return v.(*sync.WaitGroup)
-}
-
-func releaseWG(value *sync.WaitGroup) {
-	if value != nil {
-		wgPool.Put(value)
-	}
-}
-
 func acquireMultiContext() *multiContext {
 	v := multiContextPool.Get()
 	if v == nil {
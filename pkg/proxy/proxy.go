@@ -20,6 +20,7 @@ import (
 	"github.com/fagongzi/util/hack"
 	"github.com/fagongzi/util/task"
 	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
 )
 
 var (
@@ -29,6 +30,7 @@ var (
 	MultiResultsRemoveHeaders = []string{
 		"Content-Length",
 		"Content-Type",
+		"Content-Encoding",
 		"Date",
 	}
 )
@@ -50,17 +52,33 @@ type Proxy struct {
 	dispatches               []chan *dispatchNode
 	copies                   []chan *copyReq
 
-	cfg         *Cfg
-	filtersMap  map[string]filter.Filter
-	filters     []filter.Filter
-	client      *util.FastHTTPClient
-	dispatcher  *dispatcher
-	rpcListener net.Listener
+	cfg           *Cfg
+	filtersMap    map[string]filter.Filter
+	filters       []filter.Filter
+	client        *util.FastHTTPClient
+	grpcTransport *http2.Transport
+	dispatcher    *dispatcher
+	rpcListener   net.Listener
+	cacheStorage  util.CacheStorage
+	// the real client ip is resolved from the forwarded headers of the trusted proxies
+	trustedProxies *util.IPTrie
 
 	jsEngine    *plugin.Engine
 	gcJSEngines []*plugin.Engine
 
+	wsConns        sync.Map // api id -> *int64
+	wsSessions     sync.Map // *wsSession -> struct{}
+	shadowSamplers sync.Map // routing id -> *shadowSampler
+
+	listeners []upgradeListener
+	conns     sync.Map // net.Conn -> fasthttp.ConnState
+	shutdowns []func()
+	inflight  int64
+	copying   int64
+
 	runner   *task.Runner
+	ready    int32
+	draining int32
 	stopped  int32
 	stopC    chan struct{}
 	stopOnce sync.Once
@@ -83,6 +101,7 @@ func NewProxy(cfg *Cfg) *Proxy {
 
 	p := &Proxy{
 		client:        util.NewFastHTTPClientOption(globalHTTPOptions),
+		grpcTransport: newGRPCTransport(),
 		cfg:           cfg,
 		filtersMap:    make(map[string]filter.Filter),
 		stopC:         make(chan struct{}),
@@ -106,11 +125,30 @@ func (p *Proxy) init() {
 			err)
 	}
 
+	p.trustedProxies, err = util.NewIPTrieFromValues(p.cfg.Option.TrustedProxies)
+	if err != nil {
+		log.Fatalf("init trusted proxies failed, errors:\n%+v",
+			err)
+	}
+
+	// the PROXY protocol header is only accepted from the trusted proxies
+	if p.cfg.Option.EnableProxyProtocol && p.trustedProxies.Len() == 0 {
+		log.Fatalf("init proxy protocol failed, the trusted proxies are required")
+	}
+
+	p.cacheStorage, err = util.NewCacheStorage(p.cfg.AddrCache, p.cfg.Namespace+"/",
+		p.cfg.Option.LimitBytesCaching, p.dispatcher.tw)
+	if err != nil {
+		log.Fatalf("init cache storage failed, errors:\n%+v",
+			err)
+	}
+
 	p.initFilters()
 
 	err = p.dispatcher.store.RegistryProxy(&metapb.Proxy{
-		Addr:    p.cfg.Addr,
-		AddrRPC: p.cfg.AddrRPC,
+		Addr:         p.cfg.Addr,
+		AddrRPC:      p.cfg.AddrRPC,
+		CacheStorage: util.CacheStorageID(p.cfg.AddrCache, p.cfg.Namespace+"/"),
 	}, p.cfg.TTLProxy)
 	if err != nil {
 		log.Fatalf("init route table failed, errors:\n%+v",
@@ -203,6 +241,7 @@ func (p *Proxy) readyToCopy() {
 				case req := <-c:
 					if req != nil {
 						p.doCopy(req)
+						atomic.AddInt64(&p.copying, -1)
 					}
 				}
 			}
@@ -228,6 +267,47 @@ func (p *Proxy) ServeFastHTTP(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	// the drain waits for the in-flight requests, the streaming response bodies
+	// written after the handler returned are waited by the server shutdown
+	atomic.AddInt64(&p.inflight, 1)
+	defer atomic.AddInt64(&p.inflight, -1)
+
+	// close the keepalive connection after the response is written
+	if p.isDraining() {
+		ctx.SetConnectionClose()
+	}
+
+	util.SetClientIP(ctx, p.trustedProxies)
+
+	if p.cfg.Option.EnableGRPC && isGRPCWeb(ctx) {
+		p.serveGRPCWeb(ctx, requestTag)
+		return
+	}
+
+	if p.cfg.Option.EnableRequestDecompression {
+		code, err := p.decompressRequest(ctx)
+		if err != nil {
+			ctx.SetStatusCode(code)
+			log.Warnf("%s: decompress request failed with error %s, return with %d",
+				requestTag,
+				err,
+				code)
+			return
+		}
+	}
+
+	if p.isGraphQL(ctx) {
+		p.serveGraphQL(ctx, requestTag)
+		return
+	}
+
+	if isCORSPreflight(ctx) {
+		if api := p.dispatcher.preflightAPI(&ctx.Request); api != nil {
+			p.servePreflight(ctx, api, requestTag)
+			return
+		}
+	}
+
 	startAt := time.Now()
 	api, dispatches, exprCtx := p.dispatcher.dispatch(ctx, requestTag)
 	if len(dispatches) == 0 &&
@@ -253,73 +333,53 @@ func (p *Proxy) ServeFastHTTP(ctx *fasthttp.RequestCtx) {
 	rd.init(requestTag, api, dispatches)
 
 	var multiCtx *multiContext
-	var wg *sync.WaitGroup
-	lastBatch := int32(0)
 	num := len(dispatches)
 
 	if num > 1 {
-		wg = acquireWG()
 		multiCtx = acquireMultiContext()
 		multiCtx.init()
 	}
 
-	for idx, dn := range dispatches {
-		// wait last batch complete
-		if wg != nil && lastBatch < dn.node.meta.BatchIndex {
-			wg.Wait()
-			wg = nil
-			lastBatch = dn.node.meta.BatchIndex
-			if num-idx > 1 {
-				wg = &sync.WaitGroup{}
-			}
-		}
-
-		if wg != nil {
-			dn.wg = wg
-			wg.Add(1)
-		}
-
-		if nil != multiCtx {
-			exprCtx.Depend = multiCtx.data
-		}
+	for _, dn := range dispatches {
 		dn.multiCtx = multiCtx
 		dn.requestTag = requestTag
 		dn.rd = rd
 		dn.ctx = ctx
-		if dn.copyTo != nil {
-			log.Infof("%s: dispatch node %d copy to %s",
-				requestTag,
-				idx,
-				dn.copyTo.meta.Addr)
-
-			p.copies[getIndex(&p.copyIndex, p.cfg.Option.LimitCountCopyWorker)] <- &copyReq{
-				origin:     copyRequest(&ctx.Request),
-				to:         dn.copyTo.clone(),
-				api:        dn.api.clone(),
-				node:       dn.node.clone(),
-				idx:        idx,
-				params:     exprCtx.CopyParams(),
-				requestTag: requestTag,
-			}
+		if dn.copyTo != nil && dn.copyRouting.Compare == nil && len(dn.node.depends) == 0 {
+			p.copy(dn)
 		}
+	}
 
-		if wg != nil {
+	if num > 1 {
+		// every node is dispatched as soon as its depends are completed,
+		// the dispatch workers never send the dependents
+		multiCtx.run(dispatches, func(dn *dispatchNode) {
 			p.dispatches[getIndex(&p.dispatchIndex, p.cfg.Option.LimitCountDispatchWorker)] <- dn
-		} else {
-			p.doProxy(dn, nil)
-		}
+		})
+	} else if num == 1 {
+		p.doProxy(dispatches[0], nil)
 	}
 
-	// wait last batch complete
-	if wg != nil {
-		wg.Wait()
-		releaseWG(wg)
+	// the copy with compare need the primary response, and the copy of the node
+	// with depends need the results of the depends
+	for _, dn := range dispatches {
+		if dn.copyTo != nil && (dn.copyRouting.Compare != nil || len(dn.node.depends) > 0) {
+			p.copy(dn)
+		}
 	}
 
 	rd.render(ctx, multiCtx)
 	releaseRender(rd)
 	releaseMultiContext(multiCtx)
 
+	if api.cors != nil {
+		api.cors.apply(ctx)
+	}
+
+	if p.cfg.Option.EnableCompression {
+		p.compressResponse(ctx, requestTag)
+	}
+
 	incrRequest(api.meta.Name)
 	p.postRequest(api, dispatches, startAt)
 	releaseExprCtx(exprCtx)
@@ -343,6 +403,9 @@ func (p *Proxy) doCopy(req *copyReq) {
 		req.to.meta.Addr)
 
 	res, err := p.client.Do(req.origin, svr.meta.Addr, nil)
+	if req.routing != nil {
+		p.compareShadow(req, res, err)
+	}
 	if err != nil {
 		log.Errorf("%s: dispatch node %d copy to %s with error %s",
 			req.requestTag,
@@ -369,6 +432,11 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 		return
 	}
 
+	if dn.node.meta.ForEach != nil && !dn.element {
+		p.doForEach(dn)
+		return
+	}
+
 	ctx := dn.ctx
 	svr := dn.dest
 	if nil == svr {
@@ -435,11 +503,24 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 		return
 	}
 
+	// the forward request is rewritten after the pre filters, so the filters
+	// see the request of the client
+	dn.node.rewriteRequest(forwardReq, dn.exprCtx)
+
+	// the body of the streaming request is piped after the pre filters,
+	// and it can not be sent again by the retries
+	streamed := setStreamingRequestBody(c)
+
 	var res *fasthttp.Response
 
 	if value := c.GetAttr(filter.AttrUsingCachingValue); nil != value { // hit cache
 		res = fasthttp.AcquireResponse()
-		filter.ReadCachedValueTo(value.(*goetty.ByteBuf), res)
+		switch v := value.(type) {
+		case []byte:
+			filter.ReadCachedBytesTo(v, res)
+		case *goetty.ByteBuf:
+			filter.ReadCachedValueTo(v, res)
+		}
 		log.Infof("%s: dispatch node %d using cache",
 			dn.requestTag,
 			dn.idx)
@@ -470,7 +551,15 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 				dn.idx,
 				times)
 
-			if !dn.api.isWebSocket() {
+			if call := c.GetAttr(grpcCallKey); call != nil {
+				res, err = call.(grpcCallFunc)(c, svr)
+			} else if dn.api.isStreaming() || dn.api.isSSE() {
+				dn.setHost(forwardReq)
+				res, dn.stream, err = p.client.DoStream(forwardReq, svr.meta.Addr, dn.httpOption())
+			} else if dn.api.canStream() && acceptEventStream(forwardReq) {
+				dn.setHost(forwardReq)
+				res, dn.stream, err = p.client.DoStreamIf(forwardReq, svr.meta.Addr, dn.httpOption(), isEventStream)
+			} else if !dn.api.isWebSocket() {
 				dn.setHost(forwardReq)
 				res, err = p.client.Do(forwardReq, svr.meta.Addr, dn.httpOption())
 			} else {
@@ -485,8 +574,8 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 				break
 			}
 
-			// skip no retry strategy
-			if !dn.hasRetryStrategy() {
+			// skip no retry strategy or the request body is streamed
+			if !dn.hasRetryStrategy() || streamed {
 				break
 			}
 
@@ -511,6 +600,10 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 			}
 
 			fasthttp.ReleaseResponse(res)
+			if dn.stream != nil {
+				dn.stream.Close()
+				dn.stream = nil
+			}
 			// update selectServer params : change fasthttp.Request to fasthttp.RequestCtx
 			p.dispatcher.selectServer(ctx, dn, dn.requestTag)
 			svr = dn.dest
@@ -518,6 +611,7 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 				dn.err = ErrNoServer
 				dn.code = fasthttp.StatusServiceUnavailable
 				dn.maybeDone()
+				releaseContext(c)
 
 				log.Infof("%s: dispatch node %d has no server, return with 503",
 					dn.requestTag,
@@ -546,14 +640,35 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 
 		p.doPostErrFilters(c, resCode, err, filters...)
 
-		dn.err = err
-		dn.code = resCode
-		dn.maybeDone()
-		releaseContext(c)
-		return
+		// the stale cached response is used if the backend server is failed
+		value := c.GetAttr(cachingStaleKey)
+		if nil == value {
+			dn.err = err
+			dn.code = resCode
+			dn.maybeDone()
+			releaseContext(c)
+			return
+		}
+
+		log.Warnf("%s: dispatch node %d using stale cache for error",
+			dn.requestTag,
+			dn.idx)
+		if res != nil {
+			fasthttp.ReleaseResponse(res)
+		}
+		res = value.(*fasthttp.Response)
+		dn.res = res
 	}
 
-	if log.DebugEnabled() {
+	if dn.stream != nil && (dn.api.isSSE() || isEventStream(&res.Header)) {
+		dn.sse = true
+		dn.stream.SetTimeout(p.sseIdleTimeout(dn.api))
+		log.Infof("%s: dispatch node %d return server-sent events stream",
+			dn.requestTag,
+			dn.idx)
+	}
+
+	if log.DebugEnabled() && dn.stream == nil {
 		log.Debugf("%s: dispatch node %d return by %s with code %d, body <%s>",
 			dn.requestTag,
 			dn.idx,
@@ -562,6 +677,24 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 			hack.SliceToString(res.Body()))
 	}
 
+	// the body must be decompressed before the cache filter and the headers filter
+	err = dn.decompressResponse(p.cfg.Option.LimitBytesBody)
+	if nil != err {
+		log.Errorf("%s: dispatch node %d decompress response failed with error %s",
+			dn.requestTag,
+			dn.idx,
+			err)
+
+		dn.err = err
+		dn.code = fasthttp.StatusBadGateway
+		dn.maybeDone()
+		releaseContext(c)
+		return
+	}
+
+	// the response of revalidation is replaced by the cached response before the post filters
+	refreshNotModified(c)
+
 	// post filters
 	filterName, code, err = p.doPostFilters(dn.requestTag, c, filters...)
 	if nil != err {
@@ -578,6 +711,17 @@ func (p *Proxy) doProxy(dn *dispatchNode, adjustH func(*proxyContext)) {
 		return
 	}
 
+	err = dn.decodeResponse()
+	if nil != err {
+		log.Errorf("%s: dispatch node %d decode response failed with error %s",
+			dn.requestTag,
+			dn.idx,
+			err)
+
+		dn.err = err
+		dn.code = fasthttp.StatusBadGateway
+	}
+
 	dn.maybeDone()
 	releaseContext(c)
 }
@@ -0,0 +1,123 @@
+package proxy
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	drainCheckInterval = time.Millisecond * 100
+)
+
+func (p *Proxy) addShutdown(fn func()) {
+	p.Lock()
+	p.shutdowns = append(p.shutdowns, fn)
+	p.Unlock()
+}
+
+// onConnState track the fasthttp connections, the idle keepalive connections
+// are closed when the proxy is draining
+func (p *Proxy) onConnState(conn net.Conn, state fasthttp.ConnState) {
+	switch state {
+	case fasthttp.StateClosed, fasthttp.StateHijacked:
+		p.conns.Delete(conn)
+	default:
+		p.conns.Store(conn, state)
+	}
+}
+
+func (p *Proxy) closeIdleConns() {
+	p.conns.Range(func(key, value interface{}) bool {
+		state := value.(fasthttp.ConnState)
+		if state == fasthttp.StateIdle || state == fasthttp.StateNew {
+			key.(net.Conn).Close()
+			p.conns.Delete(key)
+		}
+		return true
+	})
+}
+
+// drain deregister the proxy from the store, fail the readiness, stop accepting
+// new connections, and wait for the in-flight requests and copy requests until
+// the limit-timeout-graceful-stop.
+func (p *Proxy) drain(deregister bool) {
+	if !atomic.CompareAndSwapInt32(&p.draining, 0, 1) {
+		return
+	}
+
+	if deregister {
+		err := p.dispatcher.store.RemoveProxy(p.cfg.Addr)
+		if err != nil {
+			log.Errorf("stop: deregister proxy %s failed with %+v",
+				p.cfg.Addr,
+				err)
+		}
+	}
+
+	// the websocket connections never complete
+	p.closeWebSockets()
+
+	done := p.shutdownServers()
+	deadline := time.NewTimer(p.cfg.Option.LimitTimeoutGracefulStop)
+	defer deadline.Stop()
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		p.closeIdleConns()
+		if p.drained(done) {
+			log.Infof("stop: gateway proxy drained")
+			return
+		}
+
+		select {
+		case <-deadline.C:
+			log.Warnf("stop: drain timeout, %d requests and %d copy requests are not completed",
+				atomic.LoadInt64(&p.inflight),
+				atomic.LoadInt64(&p.copying))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// shutdownServers close the listeners, the returned chan is closed after all the
+// connections of the servers are closed
+func (p *Proxy) shutdownServers() chan struct{} {
+	p.RLock()
+	shutdowns := p.shutdowns
+	p.RUnlock()
+
+	done := make(chan struct{})
+	wg := &sync.WaitGroup{}
+	for _, fn := range shutdowns {
+		wg.Add(1)
+		go func(fn func()) {
+			defer wg.Done()
+			fn()
+		}(fn)
+	}
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	return done
+}
+
+func (p *Proxy) drained(done chan struct{}) bool {
+	select {
+	case <-done:
+	default:
+		return false
+	}
+
+	return atomic.LoadInt64(&p.inflight) == 0 &&
+		atomic.LoadInt64(&p.copying) == 0
+}
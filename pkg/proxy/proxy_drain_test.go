@@ -0,0 +1,101 @@
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newBlockedProxy returns a proxy with a backend server which blocks the requests
+// until the release func is called
+func newBlockedProxy(t *testing.T, opt *Option) (*Proxy, *httptest.Server, func()) {
+	var once sync.Once
+	c := make(chan struct{})
+	backend := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		<-c
+		rw.Write([]byte("OK"))
+	}))
+
+	release := func() {
+		once.Do(func() { close(c) })
+	}
+	return newTestProxy(t, opt, newTestAPI("/blocked"), testServerAddr(backend.URL)), backend, release
+}
+
+// startBlockedRequest send a request to the proxy, and waits for it in flight
+func startBlockedRequest(t *testing.T, p *Proxy) chan int {
+	code := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + p.cfg.Addr + "/blocked")
+		if err != nil {
+			code <- 0
+			return
+		}
+		resp.Body.Close()
+		code <- resp.StatusCode
+	}()
+
+	if !waitFor(time.Second, func() bool { return atomic.LoadInt64(&p.inflight) == 1 }) {
+		t.Fatalf("expect the request is in flight")
+	}
+	return code
+}
+
+func TestDrainWaitsInflightRequests(t *testing.T) {
+	p, backend, release := newBlockedProxy(t, &Option{LimitTimeoutGracefulStop: time.Second * 5})
+	defer backend.Close()
+	defer p.GracefulStop()
+	defer release()
+
+	code := startBlockedRequest(t, p)
+
+	drained := make(chan struct{})
+	go func() {
+		p.drain(false)
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		t.Fatalf("expect the drain waits for the in-flight request")
+	case <-time.After(drainCheckInterval * 3):
+	}
+	if p.IsReady() {
+		t.Errorf("expect not ready while draining")
+	}
+
+	release()
+	select {
+	case <-drained:
+	case <-time.After(time.Second * 3):
+		t.Fatalf("expect the drain completed after the in-flight request")
+	}
+
+	if value := <-code; value != http.StatusOK {
+		t.Errorf("expect the in-flight request succeed, but %d", value)
+	}
+	if value := atomic.LoadInt64(&p.inflight); value != 0 {
+		t.Errorf("expect no in-flight request, but %d", value)
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	p, backend, release := newBlockedProxy(t, &Option{LimitTimeoutGracefulStop: time.Millisecond * 300})
+	defer backend.Close()
+	defer p.GracefulStop()
+	defer release()
+
+	startBlockedRequest(t, p)
+
+	startAt := time.Now()
+	p.drain(false)
+	if cost := time.Since(startAt); cost < time.Millisecond*300 || cost > time.Second*2 {
+		t.Errorf("expect the drain returned after the graceful stop timeout, but %s", cost)
+	}
+	if value := atomic.LoadInt64(&p.inflight); value != 1 {
+		t.Errorf("expect the request is still in flight, but %d", value)
+	}
+}
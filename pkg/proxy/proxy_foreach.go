@@ -0,0 +1,118 @@
+package proxy
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/buger/jsonparser"
+	"github.com/fagongzi/gateway/pkg/expr"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	defaultForEachConcurrency = 4
+	defaultForEachMaxElements = 100
+)
+
+var (
+	null = []byte("null")
+)
+
+// forEachElements returns the elements of the array in the depend data
+func (dn *dispatchNode) forEachElements() [][]byte {
+	maxElements := int(dn.node.meta.ForEach.MaxElements)
+	if maxElements == 0 {
+		maxElements = defaultForEachMaxElements
+	}
+
+	var values [][]byte
+	jsonparser.ArrayEach(dn.exprCtx.Depend, func(value []byte, vt jsonparser.ValueType, offset int, err error) {
+		if err == nil && len(values) < maxElements {
+			values = append(values, value)
+		}
+	}, dn.node.forEachPath...)
+
+	return values
+}
+
+// doForEach dispatch one request per element, the results are assembled into
+// a json array in the order of the elements
+func (p *Proxy) doForEach(dn *dispatchNode) {
+	values := dn.forEachElements()
+	concurrency := int(dn.node.meta.ForEach.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultForEachConcurrency
+	}
+
+	log.Debugf("%s: dispatch node %d for each %d elements",
+		dn.requestTag,
+		dn.idx,
+		len(values))
+
+	elements := make([]*dispatchNode, len(values))
+	limit := make(chan struct{}, concurrency)
+	wg := &sync.WaitGroup{}
+	for idx, value := range values {
+		element := acquireDispathNode()
+		element.element = true
+		element.rd = dn.rd
+		element.ctx = dn.ctx
+		element.requestTag = dn.requestTag
+		element.idx = dn.idx
+		element.api = dn.api
+		element.node = dn.node
+		// every element selects a server of the cluster by the load balance
+		element.dest = p.dispatcher.selectServerFromCluster(dn.ctx, dn.cluster)
+		element.cluster = dn.cluster
+		element.exprCtx = &expr.Ctx{
+			Origin: dn.exprCtx.Origin,
+			Params: dn.exprCtx.Params,
+			Depend: dn.exprCtx.Depend,
+			Item:   value,
+		}
+		elements[idx] = element
+
+		wg.Add(1)
+		limit <- struct{}{}
+		go func() {
+			p.doProxy(element, nil)
+			<-limit
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	buf := bytes.NewBuffer(nil)
+	buf.WriteByte('[')
+	for idx, element := range elements {
+		if idx > 0 {
+			buf.WriteByte(',')
+		}
+
+		// the first failed element fails the dispatch node
+		if element.hasError() {
+			if dn.err == nil && dn.code == 0 {
+				dn.err = element.err
+				dn.code = element.code
+				if dn.code == 0 && element.res != nil {
+					dn.code = element.res.StatusCode()
+				}
+			}
+			buf.Write(null)
+		} else if body := element.getResponseBody(); len(body) > 0 {
+			buf.Write(body)
+		} else {
+			buf.Write(null)
+		}
+
+		element.release()
+		releaseDispathNode(element)
+	}
+	buf.WriteByte(']')
+
+	dn.res = fasthttp.AcquireResponse()
+	dn.res.Header.SetContentType(MultiResultsContentType)
+	dn.res.SetBody(buf.Bytes())
+	dn.maybeDone()
+}
@@ -0,0 +1,161 @@
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/expr"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+type forEachBackend struct {
+	*httptest.Server
+	hits    int64
+	current *int64
+	max     *int64
+}
+
+// newForEachBackends returns the backend servers which return the item of the id,
+// the item of the failed id returns 500. The later items are returned earlier.
+func newForEachBackends(n int, failed int) []*forEachBackend {
+	var current, max int64
+	var backends []*forEachBackend
+	for i := 0; i < n; i++ {
+		b := &forEachBackend{current: &current, max: &max}
+		b.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			atomic.AddInt64(&b.hits, 1)
+			value := atomic.AddInt64(b.current, 1)
+			defer atomic.AddInt64(b.current, -1)
+			for {
+				old := atomic.LoadInt64(b.max)
+				if value <= old || atomic.CompareAndSwapInt64(b.max, old, value) {
+					break
+				}
+			}
+
+			id, _ := strconv.Atoi(strings.TrimPrefix(req.URL.Path, "/items/"))
+			time.Sleep(time.Millisecond * time.Duration(50-id*5))
+			if id == failed {
+				rw.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			rw.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(rw, `{"id":%d}`, id)
+		}))
+		backends = append(backends, b)
+	}
+
+	return backends
+}
+
+// doForEachNode dispatch the for each node with the items
+func doForEachNode(t *testing.T, forEach *metapb.ForEach, backends []*forEachBackend, items int) *dispatchNode {
+	forEach.Path = "orders.items"
+	api := newTestAPI("/orders")
+	api.Nodes[0].AttrName = "items"
+	api.Nodes[0].URLRewrite = "/items/$(item.id)"
+	api.Nodes[0].ForEach = forEach
+
+	var addrs []string
+	for _, b := range backends {
+		addrs = append(addrs, testServerAddr(b.URL))
+	}
+	p := newTestProxy(t, &Option{}, api, addrs...)
+	defer p.GracefulStop()
+
+	var values []string
+	for i := 0; i < items; i++ {
+		values = append(values, fmt.Sprintf(`{"id":%d}`, i))
+	}
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.SetRequestURI("http://gw/orders")
+	// the uri is parsed by the dispatcher before the elements are sent concurrently
+	req.URI()
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(req, nil, nil)
+
+	dn := &dispatchNode{
+		ctx:        ctx,
+		requestTag: "test",
+		api:        p.dispatcher.apis[1],
+		node:       p.dispatcher.apis[1].nodes[0],
+		cluster:    1,
+		exprCtx: &expr.Ctx{
+			Origin: &ctx.Request,
+			Depend: []byte(`{"orders":{"items":[` + strings.Join(values, ",") + `]}}`),
+		},
+	}
+	p.doProxy(dn, nil)
+	return dn
+}
+
+func TestForEachOrderAndConcurrency(t *testing.T) {
+	backends := newForEachBackends(2, -1)
+	for _, b := range backends {
+		defer b.Close()
+	}
+
+	dn := doForEachNode(t, &metapb.ForEach{Concurrency: 3}, backends, 10)
+	defer dn.release()
+
+	if dn.hasError() {
+		t.Fatalf("expect no error, but %d %+v", dn.code, dn.err)
+	}
+	expect := `[{"id":0},{"id":1},{"id":2},{"id":3},{"id":4},{"id":5},{"id":6},{"id":7},{"id":8},{"id":9}]`
+	if value := string(dn.res.Body()); value != expect {
+		t.Errorf("expect the results in the order of the elements, but %s", value)
+	}
+
+	if value := atomic.LoadInt64(backends[0].max); value > 3 || value < 2 {
+		t.Errorf("expect at most 3 concurrent requests, but %d", value)
+	}
+
+	// the elements are sent to the servers by the round robin
+	for idx, b := range backends {
+		if value := atomic.LoadInt64(&b.hits); value != 5 {
+			t.Errorf("expect 5 requests of the server %d, but %d", idx, value)
+		}
+	}
+}
+
+func TestForEachMaxElements(t *testing.T) {
+	backends := newForEachBackends(1, -1)
+	defer backends[0].Close()
+
+	dn := doForEachNode(t, &metapb.ForEach{MaxElements: 4}, backends, 10)
+	defer dn.release()
+
+	expect := `[{"id":0},{"id":1},{"id":2},{"id":3}]`
+	if value := string(dn.res.Body()); value != expect {
+		t.Errorf("expect the first 4 elements, but %s", value)
+	}
+	if value := atomic.LoadInt64(&backends[0].hits); value != 4 {
+		t.Errorf("expect 4 requests, but %d", value)
+	}
+}
+
+func TestForEachFailedElement(t *testing.T) {
+	backends := newForEachBackends(1, 2)
+	defer backends[0].Close()
+
+	dn := doForEachNode(t, &metapb.ForEach{}, backends, 4)
+	defer dn.release()
+
+	if !dn.hasError() || dn.code != fasthttp.StatusInternalServerError {
+		t.Errorf("expect the failed element fails the node, but %d %+v", dn.code, dn.err)
+	}
+
+	expect := `[{"id":0},{"id":1},null,{"id":3}]`
+	if value := string(dn.res.Body()); value != expect {
+		t.Errorf("expect null of the failed element, but %s", value)
+	}
+}
@@ -0,0 +1,455 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	graphQLCallKey = "__graphql_call"
+
+	graphQLContentType = "application/graphql"
+
+	defaultGraphQLMaxDepth      = 10
+	defaultGraphQLMaxComplexity = 100
+)
+
+var (
+	errGraphQLMissingQuery = errors.New("missing graphql query")
+)
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type graphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+type graphQLResult struct {
+	field *graphQLField
+	data  []byte
+	err   *graphQLError
+}
+
+func (p *Proxy) isGraphQL(ctx *fasthttp.RequestCtx) bool {
+	return p.cfg.Option.GraphQLPath != "" &&
+		ctx.UserValue(graphQLCallKey) == nil &&
+		hack.SliceToString(ctx.Path()) == p.cfg.Option.GraphQLPath
+}
+
+// newGraphQLSchema returns the fields of the query type, every field is resolved by a
+// api. The duplicate fields are rejected by the store, if the duplicate fields exist,
+// the api with the smallest id is used.
+func newGraphQLSchema(apis map[uint64]*apiRuntime) map[string]*apiRuntime {
+	schema := make(map[string]*apiRuntime)
+	for _, api := range apis {
+		if !api.isUp() || api.meta.GraphQLField == "" {
+			continue
+		}
+
+		if prev, ok := schema[api.meta.GraphQLField]; ok {
+			log.Warnf("graphql field %s is resolved by the api <%d> and <%d>",
+				api.meta.GraphQLField,
+				prev.meta.ID,
+				api.meta.ID)
+			if prev.meta.ID < api.meta.ID {
+				continue
+			}
+		}
+		schema[api.meta.GraphQLField] = api
+	}
+
+	return schema
+}
+
+// serveGraphQL execute the graphql query, every root field is resolved by dispatching
+// a request to the api in parallel, and the response is projected to the selected fields.
+func (p *Proxy) serveGraphQL(ctx *fasthttp.RequestCtx, requestTag string) {
+	req, err := parseGraphQLRequest(ctx)
+	if err != nil {
+		writeGraphQLErrors(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	schema := p.dispatcher.graphQLSchema
+	op, err := parseGraphQL(req.Query, req.OperationName, p.graphQLMaxDepth())
+	if err == nil {
+		err = op.resolveVariables(req.Variables)
+	}
+	if err == nil {
+		err = p.checkGraphQL(op, schema)
+	}
+	if err != nil {
+		log.Infof("%s: invalid graphql query, errors:\n%+v",
+			requestTag,
+			err)
+		writeGraphQLErrors(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	results := make([]*graphQLResult, len(op.selections))
+	var wg sync.WaitGroup
+	for i, f := range op.selections {
+		results[i] = &graphQLResult{field: f}
+		api := schema[f.name]
+
+		// the requests are built before dispatching, avoid concurrent reading the origin request
+		subCtx, err := newGraphQLRequestCtx(ctx, api.meta, f)
+		if err != nil {
+			results[i].err = &graphQLError{Message: err.Error(), Path: []interface{}{f.key()}}
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *graphQLResult, api *apiRuntime, subCtx *fasthttp.RequestCtx) {
+			defer wg.Done()
+			p.resolveGraphQLField(subCtx, requestTag, api, result)
+		}(results[i], api, subCtx)
+	}
+	wg.Wait()
+
+	var buf bytes.Buffer
+	var errs []*graphQLError
+	buf.WriteString(`{"data":{`)
+	for i, result := range results {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		writeJSONValue(&buf, result.field.key())
+		buf.WriteByte(':')
+		if result.err != nil {
+			buf.WriteString("null")
+			errs = append(errs, result.err)
+			continue
+		}
+		buf.Write(result.data)
+	}
+	buf.WriteByte('}')
+
+	if len(errs) > 0 {
+		buf.WriteString(`,"errors":`)
+		writeJSONValue(&buf, errs)
+	}
+	buf.WriteByte('}')
+
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.SetContentType("application/json; charset=utf-8")
+	ctx.SetBody(buf.Bytes())
+
+	if p.cfg.Option.EnableCompression {
+		p.compressResponse(ctx, requestTag)
+	}
+}
+
+func (p *Proxy) graphQLMaxDepth() int {
+	if p.cfg.Option.LimitGraphQLDepth <= 0 {
+		return defaultGraphQLMaxDepth
+	}
+
+	return p.cfg.Option.LimitGraphQLDepth
+}
+
+// checkGraphQL check the fields and the limits of depth and complexity before execution
+func (p *Proxy) checkGraphQL(op *graphQLOperation, schema map[string]*apiRuntime) error {
+	maxDepth := p.graphQLMaxDepth()
+
+	maxComplexity := p.cfg.Option.LimitGraphQLComplexity
+	if maxComplexity <= 0 {
+		maxComplexity = defaultGraphQLMaxComplexity
+	}
+
+	if depth := graphQLDepth(op.selections); depth > maxDepth {
+		return fmt.Errorf("query depth %d exceeds the max depth %d", depth, maxDepth)
+	}
+
+	if complexity := graphQLComplexity(op.selections); complexity > maxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the max complexity %d", complexity, maxComplexity)
+	}
+
+	for _, f := range op.selections {
+		if _, ok := schema[f.name]; !ok {
+			return fmt.Errorf("cannot query field %s on type Query", f.name)
+		}
+
+		var check func([]*graphQLField) error
+		check = func(selections []*graphQLField) error {
+			for _, child := range selections {
+				if len(child.args) > 0 {
+					return fmt.Errorf("arguments are only supported on the fields of type Query, field %s", child.name)
+				}
+
+				if err := check(child.selections); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if err := check(f.selections); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveGraphQLField dispatch the request built by the field args to the api, the request
+// is handled as a normal request with the filters, the nodes and the render template.
+func (p *Proxy) resolveGraphQLField(subCtx *fasthttp.RequestCtx, requestTag string, api *apiRuntime, result *graphQLResult) {
+	f := result.field
+	path := []interface{}{f.key()}
+
+	p.ServeFastHTTP(subCtx)
+
+	code := subCtx.Response.StatusCode()
+	if code >= fasthttp.StatusBadRequest {
+		log.Warnf("%s: graphql field %s resolved by api %s with status code %d",
+			requestTag,
+			f.name,
+			api.meta.Name,
+			code)
+		result.err = &graphQLError{
+			Message: fmt.Sprintf("field %s failed with status code %d", f.name, code),
+			Path:    path,
+		}
+		return
+	}
+
+	body := subCtx.Response.Body()
+	if len(bytes.TrimSpace(body)) == 0 {
+		result.data = []byte("null")
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		result.err = &graphQLError{
+			Message: fmt.Sprintf("field %s is not a json response", f.name),
+			Path:    path,
+		}
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := projectGraphQL(&buf, value, f.selections, path); err != nil {
+		result.err = err
+		return
+	}
+	result.data = buf.Bytes()
+}
+
+// newGraphQLRequestCtx build the request to the api with the headers of the origin request,
+// the args named by the URLPattern are set to the path, the other args are sent by the query
+// string, or by the json body if the method of the api has a body.
+func newGraphQLRequestCtx(ctx *fasthttp.RequestCtx, api *metapb.API, f *graphQLField) (*fasthttp.RequestCtx, error) {
+	used := make(map[string]bool)
+	segments := strings.Split(api.URLPattern, "/")
+	for i, segment := range segments {
+		if segment == "*" {
+			return nil, fmt.Errorf("field %s can not be resolved by the url pattern %s", f.name, api.URLPattern)
+		}
+
+		if !strings.HasPrefix(segment, "(") {
+			continue
+		}
+
+		idx := strings.LastIndex(segment, "):")
+		if idx < 0 {
+			return nil, fmt.Errorf("field %s can not be resolved by the url pattern %s with unnamed arg", f.name, api.URLPattern)
+		}
+
+		name := segment[idx+2:]
+		value, ok := f.arg(name)
+		if !ok || value == nil {
+			return nil, fmt.Errorf("field %s missing argument %s", f.name, name)
+		}
+
+		segments[i] = url.PathEscape(graphQLArgString(value))
+		used[name] = true
+	}
+
+	method := api.Method
+	if method == "" || method == "*" {
+		method = "GET"
+	}
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+
+	ctx.Request.Header.CopyTo(&req.Header)
+	req.Header.SetMethod(method)
+	// the response is parsed by the gateway, and the request body is rebuilt
+	req.Header.Del(contentEncodingHeader)
+	req.Header.Del(acceptEncodingHeader)
+	req.Header.SetContentLength(0)
+	if api.Domain != "" {
+		req.Header.SetHost(api.Domain)
+	} else {
+		req.Header.SetHostBytes(ctx.Host())
+	}
+
+	var args fasthttp.Args
+	body := make(map[string]interface{})
+	withBody := method != "GET" && method != "HEAD" && method != "DELETE"
+	for _, arg := range f.args {
+		if used[arg.name] {
+			continue
+		}
+
+		if withBody {
+			body[arg.name] = arg.value
+		} else if arg.value != nil {
+			args.Add(arg.name, graphQLArgString(arg.value))
+		}
+	}
+
+	uri := strings.Join(segments, "/")
+	if args.Len() > 0 {
+		uri = uri + "?" + args.String()
+	}
+	req.SetRequestURI(uri)
+
+	if withBody {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.SetContentType("application/json")
+		req.SetBody(data)
+	}
+
+	subCtx := &fasthttp.RequestCtx{}
+	subCtx.Init(req, ctx.RemoteAddr(), nil)
+	subCtx.SetUserValue(graphQLCallKey, true)
+	return subCtx, nil
+}
+
+// projectGraphQL write the selected fields of the value, the lists are projected item by item
+func projectGraphQL(buf *bytes.Buffer, value interface{}, selections []*graphQLField, path []interface{}) *graphQLError {
+	if len(selections) == 0 || value == nil {
+		writeJSONValue(buf, value)
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			itemPath := append(append([]interface{}{}, path...), i)
+			if err := projectGraphQL(buf, item, selections, itemPath); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		buf.WriteByte('{')
+		for i, f := range selections {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			writeJSONValue(buf, f.key())
+			buf.WriteByte(':')
+			fieldPath := append(append([]interface{}{}, path...), f.key())
+			if err := projectGraphQL(buf, v[f.name], f.selections, fieldPath); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return &graphQLError{
+			Message: fmt.Sprintf("field %v is not an object", path[len(path)-1]),
+			Path:    path,
+		}
+	}
+
+	return nil
+}
+
+func parseGraphQLRequest(ctx *fasthttp.RequestCtx) (*graphQLRequest, error) {
+	req := &graphQLRequest{}
+	switch {
+	case ctx.IsGet():
+		args := ctx.QueryArgs()
+		req.Query = string(args.Peek("query"))
+		req.OperationName = string(args.Peek("operationName"))
+		if value := args.Peek("variables"); len(value) > 0 {
+			if err := unmarshalGraphQLJSON(value, &req.Variables); err != nil {
+				return nil, err
+			}
+		}
+	case ctx.IsPost():
+		if bytes.HasPrefix(ctx.Request.Header.ContentType(), []byte(graphQLContentType)) {
+			req.Query = string(ctx.PostBody())
+		} else if err := unmarshalGraphQLJSON(ctx.PostBody(), req); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("graphql method %s not allowed", ctx.Method())
+	}
+
+	if req.Query == "" {
+		return nil, errGraphQLMissingQuery
+	}
+
+	return req, nil
+}
+
+func unmarshalGraphQLJSON(data []byte, value interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+func graphQLArgString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+func writeGraphQLErrors(ctx *fasthttp.RequestCtx, code int, err error) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"errors":`)
+	writeJSONValue(&buf, []*graphQLError{&graphQLError{Message: err.Error()}})
+	buf.WriteByte('}')
+
+	ctx.SetStatusCode(code)
+	ctx.SetContentType("application/json; charset=utf-8")
+	ctx.SetBody(buf.Bytes())
+}
+
+func writeJSONValue(buf *bytes.Buffer, value interface{}) {
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value)
+	// the encoder always appends a newline
+	buf.Truncate(buf.Len() - 1)
+}
@@ -0,0 +1,467 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+)
+
+const (
+	grpcCallKey = "__grpc_call"
+
+	grpcContentType        = "application/grpc"
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+
+	grpcTrailerFlag = byte(0x80)
+)
+
+var (
+	errNotGRPCServer     = errors.New("not a grpc server")
+	errGRPCBodyConsumed  = errors.New("grpc request body is consumed, can not retry")
+	errGRPCFrameTooLarge = errors.New("grpc frame is too large")
+
+	grpcSkipHeaders = map[string]bool{
+		"Host":              true,
+		"Connection":        true,
+		"Content-Length":    true,
+		"Keep-Alive":        true,
+		"Te":                true,
+		"Trailer":           true,
+		"Transfer-Encoding": true,
+		"Upgrade":           true,
+	}
+)
+
+// grpcCallFunc call the grpc backend server, and write the response to the client.
+// Nothing is written to the client if the returned response has a error status code.
+type grpcCallFunc func(c *proxyContext, svr *serverRuntime) (*fasthttp.Response, error)
+
+func newGRPCTransport() *http2.Transport {
+	return &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return net.DialTimeout(network, addr, time.Second*10)
+		},
+	}
+}
+
+func isGRPCWeb(ctx *fasthttp.RequestCtx) bool {
+	return bytes.HasPrefix(ctx.Request.Header.ContentType(), []byte(grpcWebContentType))
+}
+
+// ServeGRPC native grpc (HTTP/2) reverse handler by http
+func (p *Proxy) ServeGRPC(rw http.ResponseWriter, req *http.Request) {
+	requestTag := fmt.Sprintf("[%s]%s", req.Method, req.RequestURI)
+	if p.isStopped() {
+		writeGRPCError(rw.Header(), fasthttp.StatusServiceUnavailable, "proxy is stopped")
+		rw.WriteHeader(fasthttp.StatusOK)
+		return
+	}
+
+	atomic.AddInt64(&p.inflight, 1)
+	defer atomic.AddInt64(&p.inflight, -1)
+
+	ctx := p.newRequestCtx(req)
+	called := false
+	p.doGRPC(ctx, requestTag, func(c *proxyContext, svr *serverRuntime) (*fasthttp.Response, error) {
+		// the request body is a stream, it can only be sent once
+		if called {
+			resp := fasthttp.AcquireResponse()
+			resp.SetStatusCode(fasthttp.StatusBadGateway)
+			return resp, errGRPCBodyConsumed
+		}
+		called = true
+
+		resp, rsp, err := p.grpcCall(c, svr, req.Body, req.ContentLength, "")
+		if err != nil {
+			return resp, err
+		}
+		defer rsp.Body.Close()
+
+		if rsp.StatusCode >= fasthttp.StatusBadRequest {
+			return resp, nil
+		}
+
+		h := rw.Header()
+		copyGRPCHeaders(h, rsp.Header)
+		rw.WriteHeader(rsp.StatusCode)
+
+		flusher, _ := rw.(http.Flusher)
+		err = copyGRPCBody(rsp.Body, func(data []byte) error {
+			if _, err := rw.Write(data); err != nil {
+				return err
+			}
+
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		})
+		if err != nil {
+			log.Errorf("%s: copy grpc response failed with error %s",
+				requestTag,
+				err)
+		}
+
+		for k, vs := range rsp.Trailer {
+			h[http2.TrailerPrefix+k] = vs
+		}
+		return resp, nil
+	}, func(code int, msg string) {
+		h := rw.Header()
+		h.Set("Content-Type", grpcContentType)
+		writeGRPCError(h, code, msg)
+		rw.WriteHeader(fasthttp.StatusOK)
+	})
+}
+
+// serveGRPCWeb serve the grpc-web request, translate to native grpc request
+func (p *Proxy) serveGRPCWeb(ctx *fasthttp.RequestCtx, requestTag string) {
+	contentType := string(ctx.Request.Header.ContentType())
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+
+	body := ctx.Request.Body()
+	if text {
+		data, err := decodeGRPCWebText(body)
+		if err != nil {
+			writeGRPCWebError(ctx, contentType, fasthttp.StatusBadRequest, err.Error())
+			return
+		}
+		body = data
+	}
+
+	p.doGRPC(ctx, requestTag, func(c *proxyContext, svr *serverRuntime) (*fasthttp.Response, error) {
+		resp, rsp, err := p.grpcCall(c, svr, bytes.NewReader(body), int64(len(body)),
+			grpcContentType+grpcSubType(contentType))
+		if err != nil {
+			return resp, err
+		}
+
+		if rsp.StatusCode >= fasthttp.StatusBadRequest {
+			rsp.Body.Close()
+			return resp, nil
+		}
+
+		// the headers filter copy the response header to the client
+		resp.Header.SetContentType(contentType)
+		resp.Header.SetContentLength(-1)
+		resp.Header.CopyTo(&ctx.Response.Header)
+
+		// the body is written after the handler returned, flush every frame to the client
+		ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer rsp.Body.Close()
+
+			write := func(data []byte) error {
+				if text {
+					data = hack.StringToSlice(base64.StdEncoding.EncodeToString(data))
+				}
+
+				if _, err := w.Write(data); err != nil {
+					return err
+				}
+				return w.Flush()
+			}
+
+			// every base64 chunk of the grpc-web-text must be a complete frame
+			var err error
+			if text {
+				err = copyGRPCFrames(rsp.Body, p.cfg.Option.LimitBytesBody, write)
+			} else {
+				err = copyGRPCBody(rsp.Body, write)
+			}
+			if err != nil {
+				log.Errorf("%s: copy grpc-web response failed with error %s",
+					requestTag,
+					err)
+				return
+			}
+
+			if len(rsp.Trailer) > 0 {
+				write(encodeGRPCWebTrailer(rsp.Trailer))
+			}
+		})
+		return resp, nil
+	}, func(code int, msg string) {
+		writeGRPCWebError(ctx, contentType, code, msg)
+	})
+}
+
+// doGRPC dispatch the grpc request by the path, the grpc request use the same
+// filters with the http request. The onError func is called if the backend
+// response is not written to the client.
+func (p *Proxy) doGRPC(ctx *fasthttp.RequestCtx, requestTag string, call grpcCallFunc, onError func(int, string)) {
+	startAt := time.Now()
+	api, dispatches, exprCtx := p.dispatcher.dispatch(ctx, requestTag)
+	defer releaseExprCtx(exprCtx)
+
+	if len(dispatches) != 1 {
+		log.Infof("%s: grpc not match or dispatch to multi backend server, return with 404",
+			requestTag)
+		onError(fasthttp.StatusNotFound, "method not found")
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		return
+	}
+
+	dn := dispatches[0]
+	dn.ctx = ctx
+	dn.requestTag = requestTag
+	p.doProxy(dn, func(c *proxyContext) {
+		c.SetAttr(grpcCallKey, call)
+	})
+
+	if dn.hasError() {
+		code := dn.code
+		if code == 0 {
+			code = fasthttp.StatusInternalServerError
+		}
+
+		// the error may contain the address of the backend server, it is logged
+		// by the proxy
+		onError(code, http.StatusText(code))
+	}
+
+	dn.release()
+	incrRequest(api.meta.Name)
+	p.postRequest(api, dispatches, startAt)
+}
+
+// grpcCall send the request to the grpc backend server, the returned response has
+// the status code and headers of the backend response, the caller must close the body
+// of the backend response if there is no error.
+func (p *Proxy) grpcCall(c *proxyContext, svr *serverRuntime, body io.Reader, size int64, contentType string) (*fasthttp.Response, *http.Response, error) {
+	resp := fasthttp.AcquireResponse()
+	if svr.meta.Protocol != metapb.Grpc {
+		resp.SetStatusCode(fasthttp.StatusBadGateway)
+		return resp, nil, errNotGRPCServer
+	}
+
+	out, err := http.NewRequest(http.MethodPost,
+		fmt.Sprintf("http://%s%s", svr.meta.Addr, c.forwardReq.RequestURI()),
+		body)
+	if err != nil {
+		resp.SetStatusCode(fasthttp.StatusBadRequest)
+		return resp, nil, err
+	}
+
+	out.ContentLength = size
+	c.forwardReq.Header.VisitAll(func(k, v []byte) {
+		key := http.CanonicalHeaderKey(string(k))
+		if grpcSkipHeaders[key] {
+			return
+		}
+		out.Header.Add(key, string(v))
+	})
+	// the te header is removed by the headers filter, but it is required by grpc
+	out.Header.Set("Te", "trailers")
+	if contentType != "" {
+		out.Header.Set("Content-Type", contentType)
+	}
+
+	rsp, err := p.grpcTransport.RoundTrip(out)
+	if err != nil {
+		resp.SetStatusCode(fasthttp.StatusBadGateway)
+		return resp, nil, err
+	}
+
+	resp.SetStatusCode(rsp.StatusCode)
+	resp.Header.SetContentType(rsp.Header.Get("Content-Type"))
+	for k, vs := range rsp.Header {
+		if grpcSkipHeaders[k] || k == "Content-Type" {
+			continue
+		}
+
+		for _, v := range vs {
+			resp.Header.Add(k, v)
+		}
+	}
+
+	return resp, rsp, nil
+}
+
+func copyGRPCHeaders(dst, src http.Header) {
+	for k, vs := range src {
+		if grpcSkipHeaders[k] {
+			continue
+		}
+
+		for _, v := range vs {
+			dst.Add(k, v)
+		}
+	}
+}
+
+func copyGRPCBody(src io.Reader, write func([]byte) error) error {
+	buf := wsCopyBufPool.Get().([]byte)
+	defer wsCopyBufPool.Put(buf)
+
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if werr := write(buf[:n]); werr != nil {
+				return werr
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// copyGRPCFrames read the grpc frames from the src, the write func is called with
+// every complete frame. The frame is limited by the max bytes if the max is positive.
+func copyGRPCFrames(src io.Reader, max int, write func([]byte) error) error {
+	r := bufio.NewReader(src)
+	header := make([]byte, 5)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		size := binary.BigEndian.Uint32(header[1:5])
+		if max > 0 && uint64(size) > uint64(max) {
+			return errGRPCFrameTooLarge
+		}
+
+		frame := make([]byte, 5+int(size))
+		copy(frame, header)
+		if _, err := io.ReadFull(r, frame[5:]); err != nil {
+			return err
+		}
+
+		if err := write(frame); err != nil {
+			return err
+		}
+	}
+}
+
+// decodeGRPCWebText decode the grpc-web-text body, the body may be the concatenation
+// of the base64 encoded frames, every one of them is padded separately.
+func decodeGRPCWebText(body []byte) ([]byte, error) {
+	var data []byte
+	for len(body) > 0 {
+		end := len(body)
+		if idx := bytes.IndexByte(body, '='); idx >= 0 {
+			end = idx + 1
+			if end < len(body) && body[end] == '=' {
+				end++
+			}
+		}
+
+		buf := make([]byte, base64.StdEncoding.DecodedLen(end))
+		n, err := base64.StdEncoding.Decode(buf, body[:end])
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, buf[:n]...)
+		body = body[end:]
+	}
+
+	return data, nil
+}
+
+// encodeGRPCWebTrailer encode the trailers as a grpc-web trailer frame
+func encodeGRPCWebTrailer(trailer http.Header) []byte {
+	var buf bytes.Buffer
+	for k, vs := range trailer {
+		for _, v := range vs {
+			buf.WriteString(strings.ToLower(k))
+			buf.WriteString(": ")
+			buf.WriteString(v)
+			buf.WriteString("\r\n")
+		}
+	}
+
+	frame := make([]byte, 5+buf.Len())
+	frame[0] = grpcTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:5], uint32(buf.Len()))
+	copy(frame[5:], buf.Bytes())
+	return frame
+}
+
+func writeGRPCError(h http.Header, code int, msg string) {
+	h.Set("Grpc-Status", strconv.Itoa(grpcStatus(code)))
+	h.Set("Grpc-Message", encodeGRPCMessage(msg))
+}
+
+func writeGRPCWebError(ctx *fasthttp.RequestCtx, contentType string, code int, msg string) {
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.Response.Header.SetContentType(contentType)
+	ctx.Response.Header.Set("Grpc-Status", strconv.Itoa(grpcStatus(code)))
+	ctx.Response.Header.Set("Grpc-Message", encodeGRPCMessage(msg))
+}
+
+// encodeGRPCMessage percent-encode the grpc message as the grpc http2 spec,
+// the bytes out of the printable ascii and the '%' are encoded
+func encodeGRPCMessage(msg string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			buf.WriteByte(c)
+			continue
+		}
+
+		fmt.Fprintf(&buf, "%%%02X", c)
+	}
+
+	return buf.String()
+}
+
+// grpcSubType returns the sub type of the grpc-web content type, e.g. +proto
+func grpcSubType(contentType string) string {
+	if idx := strings.IndexByte(contentType, '+'); idx >= 0 {
+		return contentType[idx:]
+	}
+
+	return ""
+}
+
+// grpcStatus returns the grpc status code mapped from http status code
+func grpcStatus(httpCode int) int {
+	switch httpCode {
+	case fasthttp.StatusBadRequest:
+		return 13 // Internal
+	case fasthttp.StatusUnauthorized:
+		return 16 // Unauthenticated
+	case fasthttp.StatusForbidden:
+		return 7 // PermissionDenied
+	case fasthttp.StatusNotFound:
+		return 12 // Unimplemented
+	case fasthttp.StatusTooManyRequests,
+		fasthttp.StatusBadGateway,
+		fasthttp.StatusServiceUnavailable,
+		fasthttp.StatusGatewayTimeout:
+		return 14 // Unavailable
+	default:
+		return 2 // Unknown
+	}
+}
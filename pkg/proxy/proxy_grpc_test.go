@@ -0,0 +1,284 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func grpcFrame(flag byte, data []byte) []byte {
+	frame := make([]byte, 5+len(data))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(data)))
+	copy(frame[5:], data)
+	return frame
+}
+
+// grpcWebTextChunks split the grpc-web-text body by the base64 paddings
+func grpcWebTextChunks(body []byte) [][]byte {
+	var chunks [][]byte
+	for len(body) > 0 {
+		end := len(body)
+		if idx := bytes.IndexByte(body, '='); idx >= 0 {
+			end = idx + 1
+			if end < len(body) && body[end] == '=' {
+				end++
+			}
+		}
+
+		chunks = append(chunks, body[:end])
+		body = body[end:]
+	}
+
+	return chunks
+}
+
+func TestEncodeGRPCMessage(t *testing.T) {
+	cases := []struct {
+		msg    string
+		expect string
+	}{
+		{"no server", "no server"},
+		{"100% done", "100%25 done"},
+		{"服务", "%E6%9C%8D%E5%8A%A1"},
+		{"a\r\nb", "a%0D%0Ab"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if value := encodeGRPCMessage(c.msg); value != c.expect {
+			t.Errorf("encode %q expect %q, but %q", c.msg, c.expect, value)
+		}
+	}
+}
+
+func TestEncodeGRPCWebTrailer(t *testing.T) {
+	trailer := http.Header{}
+	trailer.Set("Grpc-Status", "0")
+	trailer.Add("X-Values", "a")
+	trailer.Add("X-Values", "b")
+
+	frame := encodeGRPCWebTrailer(trailer)
+	if frame[0] != grpcTrailerFlag {
+		t.Errorf("expect the trailer flag, but %x", frame[0])
+	}
+	if size := binary.BigEndian.Uint32(frame[1:5]); int(size) != len(frame)-5 {
+		t.Errorf("expect the frame length %d, but %d", len(frame)-5, size)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(frame[5:]), "\r\n"), "\r\n")
+	expect := map[string]bool{"grpc-status: 0": true, "x-values: a": true, "x-values: b": true}
+	if len(lines) != len(expect) {
+		t.Errorf("expect %d trailer lines, but %q", len(expect), lines)
+	}
+	for _, line := range lines {
+		if !expect[line] {
+			t.Errorf("unexpected trailer line %q", line)
+		}
+	}
+
+	frame = encodeGRPCWebTrailer(http.Header{})
+	if !bytes.Equal(frame, []byte{grpcTrailerFlag, 0, 0, 0, 0}) {
+		t.Errorf("expect a empty trailer frame, but %x", frame)
+	}
+}
+
+func TestGRPCStatus(t *testing.T) {
+	cases := map[int]int{
+		fasthttp.StatusBadRequest:          13,
+		fasthttp.StatusUnauthorized:        16,
+		fasthttp.StatusForbidden:           7,
+		fasthttp.StatusNotFound:            12,
+		fasthttp.StatusTooManyRequests:     14,
+		fasthttp.StatusBadGateway:          14,
+		fasthttp.StatusServiceUnavailable:  14,
+		fasthttp.StatusGatewayTimeout:      14,
+		fasthttp.StatusInternalServerError: 2,
+		fasthttp.StatusOK:                  2,
+	}
+
+	for code, expect := range cases {
+		if value := grpcStatus(code); value != expect {
+			t.Errorf("http status %d expect grpc status %d, but %d", code, expect, value)
+		}
+	}
+}
+
+func TestDecodeGRPCWebText(t *testing.T) {
+	frames := [][]byte{grpcFrame(0, []byte("hello")), grpcFrame(0, []byte("world!")), grpcFrame(0, []byte("abcd"))}
+
+	var body []byte
+	for _, frame := range frames {
+		body = append(body, base64.StdEncoding.EncodeToString(frame)...)
+	}
+
+	value, err := decodeGRPCWebText(body)
+	if err != nil {
+		t.Fatalf("decode failed with %+v", err)
+	}
+	if !bytes.Equal(value, bytes.Join(frames, nil)) {
+		t.Errorf("expect the concatenated frames, but %x", value)
+	}
+
+	if _, err := decodeGRPCWebText([]byte("a=b")); err == nil {
+		t.Errorf("expect error with the invalid base64")
+	}
+}
+
+func TestCopyGRPCFrames(t *testing.T) {
+	frames := [][]byte{grpcFrame(0, []byte("hello")), grpcFrame(0, bytes.Repeat([]byte("a"), 10000)), grpcFrame(grpcTrailerFlag, nil)}
+
+	var values [][]byte
+	// read by 1 byte, the frames are still complete
+	err := copyGRPCFrames(iotest.OneByteReader(bytes.NewReader(bytes.Join(frames, nil))), 0, func(data []byte) error {
+		values = append(values, append([]byte(nil), data...))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("copy failed with %+v", err)
+	}
+	if len(values) != len(frames) {
+		t.Fatalf("expect %d frames, but %d", len(frames), len(values))
+	}
+	for i := range frames {
+		if !bytes.Equal(values[i], frames[i]) {
+			t.Errorf("expect the frame %d is complete", i)
+		}
+	}
+
+	err = copyGRPCFrames(bytes.NewReader(frames[1]), 1024, func([]byte) error { return nil })
+	if err != errGRPCFrameTooLarge {
+		t.Errorf("expect the frame is too large, but %+v", err)
+	}
+
+	err = copyGRPCFrames(bytes.NewReader(frames[1][:100]), 0, func([]byte) error { return nil })
+	if err == nil {
+		t.Errorf("expect error with the incomplete frame")
+	}
+}
+
+func TestServeGRPCWebText(t *testing.T) {
+	var contentType string
+	var received []byte
+	// the large frame is written by parts, the proxy reads it by multi reads
+	large := grpcFrame(0, bytes.Repeat([]byte("a"), 10001))
+	backend := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		contentType = req.Header.Get("Content-Type")
+		received, _ = ioutil.ReadAll(req.Body)
+
+		rw.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+		rw.Header().Set("Content-Type", grpcContentType)
+		rw.WriteHeader(http.StatusOK)
+		rw.Write(received)
+		rw.(http.Flusher).Flush()
+		for i := 0; i < len(large); i += 1000 {
+			end := i + 1000
+			if end > len(large) {
+				end = len(large)
+			}
+			rw.Write(large[i:end])
+			rw.(http.Flusher).Flush()
+		}
+		rw.Header().Set("Grpc-Status", "0")
+		rw.Header().Set("Grpc-Message", "ok")
+	}), &http2.Server{}))
+	defer backend.Close()
+
+	p := newTestProxyWithServers(t, &Option{EnableGRPC: true}, newTestAPI("/test.Echo/Call"),
+		&metapb.Server{Addr: testServerAddr(backend.URL), Protocol: metapb.Grpc})
+	defer p.GracefulStop()
+
+	// the request frames are encoded separately
+	frames := [][]byte{grpcFrame(0, []byte("hello")), grpcFrame(0, []byte("world!"))}
+	var body []byte
+	for _, frame := range frames {
+		body = append(body, base64.StdEncoding.EncodeToString(frame)...)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "http://"+p.cfg.Addr+"/test.Echo/Call", bytes.NewReader(body))
+	req.Header.Set("Content-Type", grpcWebTextContentType+"+proto")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed with %+v", err)
+	}
+	value, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("read response failed with %+v", err)
+	}
+
+	if contentType != grpcContentType+"+proto" {
+		t.Errorf("expect the grpc content type with the sub type, but %s", contentType)
+	}
+	if !bytes.Equal(received, bytes.Join(frames, nil)) {
+		t.Errorf("expect the backend received the decoded frames, but %x", received)
+	}
+	if value := resp.Header.Get("Content-Type"); value != grpcWebTextContentType+"+proto" {
+		t.Errorf("expect the grpc-web-text content type, but %s", value)
+	}
+
+	// every base64 chunk is a complete frame
+	var values [][]byte
+	for _, chunk := range grpcWebTextChunks(value) {
+		frame, err := base64.StdEncoding.DecodeString(string(chunk))
+		if err != nil {
+			t.Fatalf("decode chunk failed with %+v", err)
+		}
+		if len(frame) < 5 || int(binary.BigEndian.Uint32(frame[1:5])) != len(frame)-5 {
+			t.Fatalf("expect a complete frame of the chunk, but %d bytes", len(frame))
+		}
+		values = append(values, frame)
+	}
+
+	expect := append(frames, large)
+	if len(values) != len(expect)+1 {
+		t.Fatalf("expect %d frames with the trailer frame, but %d", len(expect)+1, len(values))
+	}
+	for i := range expect {
+		if !bytes.Equal(values[i], expect[i]) {
+			t.Errorf("expect the frame %d is the backend frame", i)
+		}
+	}
+
+	trailer := values[len(values)-1]
+	if trailer[0] != grpcTrailerFlag ||
+		!strings.Contains(string(trailer[5:]), "grpc-status: 0\r\n") ||
+		!strings.Contains(string(trailer[5:]), "grpc-message: ok\r\n") {
+		t.Errorf("expect the trailer frame, but %q", trailer)
+	}
+}
+
+func TestServeGRPCWebError(t *testing.T) {
+	// the server is not a grpc server
+	p := newTestProxy(t, &Option{EnableGRPC: true}, newTestAPI("/test.Echo/Call"), "127.0.0.1:1")
+	defer p.GracefulStop()
+
+	req, _ := http.NewRequest(http.MethodPost, "http://"+p.cfg.Addr+"/test.Echo/Call", bytes.NewReader(grpcFrame(0, nil)))
+	req.Header.Set("Content-Type", grpcWebContentType)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed with %+v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expect the grpc error with 200, but %d", resp.StatusCode)
+	}
+	if value := resp.Header.Get("Grpc-Status"); value != "2" {
+		t.Errorf("expect the unknown grpc status, but %s", value)
+	}
+	if value := resp.Header.Get("Grpc-Message"); value != "Internal Server Error" {
+		t.Errorf("expect the status text as the grpc message, but %s", value)
+	}
+}
@@ -0,0 +1,86 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net"
+
+	"github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/log"
+	"github.com/valyala/fasthttp"
+)
+
+// startRPCWithListener serve the manager requests sent by the api server, e.g. purge cache.
+// The requests are not authenticated, the rpc addr must be only accessible by the api server.
+func (p *Proxy) startRPCWithListener(l net.Listener) {
+	log.Infof("start rpc at %s", p.cfg.AddrRPC)
+
+	p.rpcListener = l
+	p.addShutdown(func() {
+		p.stopRPC()
+	})
+
+	s := &fasthttp.Server{
+		Handler: p.serveRPC,
+	}
+	err := s.Serve(l)
+	if err != nil && !p.isDraining() {
+		log.Fatalf("start rpc failed with %+v", err)
+	}
+}
+
+func (p *Proxy) serveRPC(ctx *fasthttp.RequestCtx) {
+	if !ctx.IsPost() || string(ctx.Path()) != rpcpb.PurgeCachePath {
+		ctx.SetStatusCode(fasthttp.StatusNotFound)
+		return
+	}
+
+	result := rpcpb.PurgeCacheResult{
+		Proxy: p.cfg.Addr,
+	}
+
+	req := &rpcpb.PurgeCacheReq{}
+	err := json.Unmarshal(ctx.PostBody(), req)
+	if err == nil {
+		err = pb.ValidatePurgeCache(req)
+	}
+
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		result.Error = err.Error()
+	} else {
+		n, err := p.purgeCache(req)
+		if err != nil {
+			log.Errorf("rpc: purge cache %+v failed, errors:%+v", req, err)
+			ctx.SetStatusCode(fasthttp.StatusInternalServerError)
+			result.Error = err.Error()
+		}
+		result.Removed = int64(n)
+
+		log.Infof("rpc: purge cache %+v, %d removed", req, n)
+	}
+
+	data, _ := json.Marshal(&result)
+	ctx.SetContentType("application/json")
+	ctx.SetBody(data)
+}
+
+func (p *Proxy) purgeCache(req *rpcpb.PurgeCacheReq) (int, error) {
+	switch {
+	case req.API > 0:
+		return p.cacheStorage.RemoveByTag(apiCachingTag(req.API))
+	case req.Key != "":
+		// the variants of the key are stored with the key as the prefix
+		n, err := p.cacheStorage.Remove(req.Key)
+		if err != nil {
+			return n, err
+		}
+
+		variants, err := p.cacheStorage.RemoveByPrefix(req.Key + cachingVariantSep)
+		return n + variants, err
+	case req.Tag != "":
+		return p.cacheStorage.RemoveByTag(req.Tag)
+	default:
+		return p.cacheStorage.RemoveByPrefix(req.Prefix)
+	}
+}
@@ -0,0 +1,105 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/goetty"
+	"github.com/valyala/fasthttp"
+)
+
+// newRPCProxy returns a proxy serving the rpc with the local cache storage
+func newRPCProxy(t *testing.T) (*Proxy, string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed with %+v", err)
+	}
+
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Second))
+	p := &Proxy{
+		cfg:          &Cfg{Addr: "proxy", Option: &Option{}},
+		cacheStorage: util.NewLocalCacheStorage(0, tw),
+	}
+	go fasthttp.Serve(l, p.serveRPC)
+
+	return p, "http://" + l.Addr().String(), func() {
+		l.Close()
+		tw.Stop()
+	}
+}
+
+func purgeRPC(t *testing.T, url, body string) (int, rpcpb.PurgeCacheResult) {
+	var result rpcpb.PurgeCacheResult
+	rsp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("purge failed with %+v", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusNotFound {
+		if err := json.NewDecoder(rsp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode purge result failed with %+v", err)
+		}
+	}
+	return rsp.StatusCode, result
+}
+
+func TestServePurgeCacheRPC(t *testing.T) {
+	p, addr, stop := newRPCProxy(t)
+	defer stop()
+
+	values := []struct {
+		key  string
+		tags []string
+	}{
+		{"/users/1", []string{apiCachingTag(1), "user"}},
+		{"/users/1" + cachingVariantSep + "accept=json", []string{apiCachingTag(1), "user"}},
+		{"/users/2", []string{apiCachingTag(1), "user"}},
+		{"/orders/1", []string{apiCachingTag(2)}},
+		{"/orders/2", []string{apiCachingTag(2)}},
+		{"/items/1", []string{apiCachingTag(3)}},
+	}
+	for _, v := range values {
+		p.cacheStorage.Set(v.key, []byte("value"), 0, v.tags...)
+	}
+
+	cases := []struct {
+		body    string
+		removed int64
+		removes []string
+	}{
+		{`{"key":"/users/1"}`, 2, []string{"/users/1", "/users/1" + cachingVariantSep + "accept=json"}},
+		{`{"tag":"user"}`, 1, []string{"/users/2"}},
+		{`{"prefix":"/orders/"}`, 2, []string{"/orders/1", "/orders/2"}},
+		{`{"api":3}`, 1, []string{"/items/1"}},
+	}
+
+	for _, c := range cases {
+		code, result := purgeRPC(t, addr+rpcpb.PurgeCachePath, c.body)
+		if code != http.StatusOK || result.Error != "" || result.Proxy != "proxy" || result.Removed != c.removed {
+			t.Errorf("%s: expect %d removed, but %d %+v", c.body, c.removed, code, result)
+		}
+
+		for _, key := range c.removes {
+			if value, _ := p.cacheStorage.Get(key); value != nil {
+				t.Errorf("%s: expect %q removed", c.body, key)
+			}
+		}
+	}
+
+	for _, body := range []string{`{"api":1,"tag":"user"}`, `{}`, `{`} {
+		if code, result := purgeRPC(t, addr+rpcpb.PurgeCachePath, body); code != http.StatusBadRequest || result.Error == "" {
+			t.Errorf("%s: expect bad request, but %d %+v", body, code, result)
+		}
+	}
+
+	if code, _ := purgeRPC(t, addr+"/other", `{"api":1}`); code != http.StatusNotFound {
+		t.Errorf("expect not found of the other path, but %d", code)
+	}
+}
@@ -0,0 +1,297 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/hack"
+	"github.com/juju/ratelimit"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	defaultShadowMaxSamples = 100
+	maxShadowDiffs          = 32
+	// the max writes of the diff samples per second of a routing on every proxy,
+	// so a bad shadow deployment can not flood the store
+	maxShadowSampleWrites = 1
+	maxShadowSampleBurst  = 10
+
+	shadowMatch    = "match"
+	shadowMismatch = "mismatch"
+	shadowError    = "error"
+)
+
+// shadowSampler limits the writes of the diff samples of a copy routing, the
+// samples are written to the slots in turn
+type shadowSampler struct {
+	slot    uint64
+	limiter *ratelimit.Bucket
+}
+
+func newShadowSampler() *shadowSampler {
+	return &shadowSampler{
+		limiter: ratelimit.NewBucketWithQuantum(time.Second, maxShadowSampleBurst, maxShadowSampleWrites),
+	}
+}
+
+// shadowResponse is the primary response of a copy routing with compare, the
+// response of the dispatch node is released after render, so keep a copy
+type shadowResponse struct {
+	code     int
+	headers  []string
+	body     []byte
+	skipBody bool
+}
+
+func newShadowResponse(dn *dispatchNode) *shadowResponse {
+	sr := &shadowResponse{
+		code: dn.code,
+	}
+
+	if dn.res == nil {
+		sr.skipBody = true
+		sr.headers = make([]string, len(dn.copyRouting.Compare.Headers))
+		return sr
+	}
+
+	sr.code = dn.res.StatusCode()
+	sr.headers = shadowHeaders(&dn.res.Header, dn.copyRouting.Compare)
+	if dn.stream != nil {
+		sr.skipBody = true
+	} else {
+		sr.body = append([]byte(nil), dn.res.Body()...)
+	}
+
+	return sr
+}
+
+func shadowHeaders(header *fasthttp.ResponseHeader, compare *metapb.ShadowCompare) []string {
+	values := make([]string, len(compare.Headers))
+	for idx, name := range compare.Headers {
+		values[idx] = string(header.Peek(name))
+	}
+
+	return values
+}
+
+// copy send the request to the copy server, if the copy routing need compare,
+// it must be called after the primary response is completed
+func (p *Proxy) copy(dn *dispatchNode) {
+	// the for each dispatch node has no single request to copy
+	if dn.node.meta.ForEach != nil {
+		return
+	}
+
+	log.Infof("%s: dispatch node %d copy to %s",
+		dn.requestTag,
+		dn.idx,
+		dn.copyTo.meta.Addr)
+
+	req := &copyReq{
+		origin:     copyRequest(&dn.ctx.Request),
+		to:         dn.copyTo.clone(),
+		api:        dn.api.clone(),
+		node:       dn.node.clone(),
+		idx:        dn.idx,
+		params:     dn.exprCtx.CopyParams(),
+		depend:     append([]byte(nil), dn.exprCtx.Depend...),
+		requestTag: dn.requestTag,
+	}
+	if dn.copyRouting != nil && dn.copyRouting.Compare != nil {
+		req.routing = dn.copyRouting
+		req.primary = newShadowResponse(dn)
+	}
+
+	atomic.AddInt64(&p.copying, 1)
+	p.copies[getIndex(&p.copyIndex, p.cfg.Option.LimitCountCopyWorker)] <- req
+}
+
+func (p *Proxy) compareShadow(req *copyReq, res *fasthttp.Response, err error) {
+	name := req.routing.Name
+	if err != nil {
+		incrShadowCompare(name, shadowError)
+		return
+	}
+
+	diffs := diffShadow(req.routing.Compare, req.primary, res)
+	if len(diffs) == 0 {
+		incrShadowCompare(name, shadowMatch)
+		return
+	}
+
+	incrShadowCompare(name, shadowMismatch)
+	log.Debugf("%s: copy routing %s mismatch, %v",
+		req.requestTag,
+		name,
+		diffs)
+
+	if rand.Intn(100) >= int(req.routing.Compare.SampleRate) {
+		return
+	}
+
+	maxSamples := uint64(req.routing.Compare.MaxSamples)
+	if maxSamples == 0 {
+		maxSamples = defaultShadowMaxSamples
+	}
+
+	value, ok := p.shadowSamplers.Load(req.routing.ID)
+	if !ok {
+		value, _ = p.shadowSamplers.LoadOrStore(req.routing.ID, newShadowSampler())
+	}
+	sampler := value.(*shadowSampler)
+	if sampler.limiter.TakeAvailable(1) == 0 {
+		log.Debugf("%s: copy routing %s diff sample is skipped by the rate limit",
+			req.requestTag,
+			name)
+		return
+	}
+
+	slot := atomic.AddUint64(&sampler.slot, 1) % maxSamples
+	err = p.dispatcher.store.PutShadowDiff(&metapb.ShadowDiff{
+		RoutingID: req.routing.ID,
+		At:        time.Now().UnixNano(),
+		Method:    string(req.origin.Header.Method()),
+		URI:       string(req.origin.RequestURI()),
+		Proxy:     p.cfg.Addr,
+		Diffs:     diffs,
+	}, slot)
+	if err != nil {
+		log.Errorf("%s: save diff of copy routing %s failed, errors:\n%+v",
+			req.requestTag,
+			name,
+			err)
+	}
+}
+
+// diffShadow returns the differences of the copy response and the primary response
+func diffShadow(compare *metapb.ShadowCompare, primary *shadowResponse, res *fasthttp.Response) []string {
+	var diffs []string
+	if primary.code != res.StatusCode() {
+		diffs = append(diffs, fmt.Sprintf("status: %d != %d", primary.code, res.StatusCode()))
+	}
+
+	headers := shadowHeaders(&res.Header, compare)
+	for idx, name := range compare.Headers {
+		if primary.headers[idx] != headers[idx] {
+			diffs = append(diffs, fmt.Sprintf("header %s: %q != %q", name, primary.headers[idx], headers[idx]))
+		}
+	}
+
+	if primary.skipBody {
+		return diffs
+	}
+
+	return diffBody(compare, primary.body, res.Body(), diffs)
+}
+
+func diffBody(compare *metapb.ShadowCompare, primary, shadow []byte, diffs []string) []string {
+	var a, b interface{}
+	if json.Unmarshal(primary, &a) != nil || json.Unmarshal(shadow, &b) != nil {
+		if !bytes.Equal(primary, shadow) {
+			diffs = append(diffs, fmt.Sprintf("body: %d bytes != %d bytes", len(primary), len(shadow)))
+		}
+		return diffs
+	}
+
+	d := &jsonDiffer{
+		diffs: diffs,
+	}
+	for _, path := range compare.IgnoredPaths {
+		d.ignored = append(d.ignored, strings.Split(path, "."))
+	}
+	d.diff(nil, a, b)
+	return d.diffs
+}
+
+type jsonDiffer struct {
+	ignored [][]string
+	diffs   []string
+}
+
+func (d *jsonDiffer) diff(path []string, a, b interface{}) {
+	if len(d.diffs) >= maxShadowDiffs || d.isIgnored(path) {
+		return
+	}
+
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			d.add(path, a, b)
+			return
+		}
+
+		keys := make([]string, 0, len(av)+len(bv))
+		for key := range av {
+			keys = append(keys, key)
+		}
+		for key := range bv {
+			if _, ok := av[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			d.diff(append(path, key), av[key], bv[key])
+		}
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			d.add(path, a, b)
+			return
+		}
+
+		for idx := range av {
+			d.diff(append(path, strconv.Itoa(idx)), av[idx], bv[idx])
+		}
+	default:
+		if !reflect.DeepEqual(a, b) {
+			d.add(path, a, b)
+		}
+	}
+}
+
+// isIgnored returns true if the path is matched by a ignored path, * matches any
+// key or index
+func (d *jsonDiffer) isIgnored(path []string) bool {
+	for _, ignored := range d.ignored {
+		if len(ignored) != len(path) {
+			continue
+		}
+
+		matched := true
+		for idx, value := range ignored {
+			if value != "*" && value != path[idx] {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *jsonDiffer) add(path []string, a, b interface{}) {
+	av, _ := json.Marshal(a)
+	bv, _ := json.Marshal(b)
+	d.diffs = append(d.diffs, fmt.Sprintf("body %s: %s != %s",
+		strings.Join(path, "."),
+		hack.SliceToString(av),
+		hack.SliceToString(bv)))
+}
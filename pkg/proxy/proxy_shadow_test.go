@@ -0,0 +1,93 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/valyala/fasthttp"
+)
+
+type shadowStore struct {
+	store.Store
+
+	slots []uint64
+}
+
+func (s *shadowStore) PutShadowDiff(diff *metapb.ShadowDiff, slot uint64) error {
+	s.slots = append(s.slots, slot)
+	return nil
+}
+
+func TestCompareShadowLimitsSamples(t *testing.T) {
+	s := &shadowStore{}
+	p := &Proxy{
+		cfg:        &Cfg{Addr: "gw"},
+		dispatcher: &dispatcher{store: s},
+	}
+
+	routing := &metapb.Routing{
+		ID:   1,
+		Name: "shadow",
+		Compare: &metapb.ShadowCompare{
+			SampleRate: 100,
+			MaxSamples: 4,
+		},
+	}
+
+	origin := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(origin)
+	origin.SetRequestURI("http://gw/a")
+
+	res := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(res)
+	res.SetStatusCode(fasthttp.StatusInternalServerError)
+
+	for i := 0; i < 100; i++ {
+		p.compareShadow(&copyReq{
+			origin:  origin,
+			routing: routing,
+			primary: &shadowResponse{code: fasthttp.StatusOK, skipBody: true},
+		}, res, nil)
+	}
+
+	if len(s.slots) != maxShadowSampleBurst {
+		t.Errorf("expect %d samples, but %d", maxShadowSampleBurst, len(s.slots))
+	}
+
+	for _, slot := range s.slots {
+		if slot >= uint64(routing.Compare.MaxSamples) {
+			t.Errorf("expect slot less than %d, but %d", routing.Compare.MaxSamples, slot)
+		}
+	}
+}
+
+func TestDiffShadow(t *testing.T) {
+	compare := &metapb.ShadowCompare{
+		Headers:      []string{"X-Version"},
+		IgnoredPaths: []string{"ts", "items.*.id"},
+	}
+
+	res := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(res)
+	res.Header.Set("X-Version", "2")
+	res.SetBody([]byte(`{"ts":2,"name":"b","items":[{"id":2,"v":1}]}`))
+
+	primary := &shadowResponse{
+		code:    fasthttp.StatusOK,
+		headers: []string{"1"},
+		body:    []byte(`{"ts":1,"name":"a","items":[{"id":1,"v":1}]}`),
+	}
+
+	diffs := diffShadow(compare, primary, res)
+	expect := []string{`header X-Version: "1" != "2"`, `body name: "a" != "b"`}
+	if len(diffs) != len(expect) {
+		t.Fatalf("expect %v, but %v", expect, diffs)
+	}
+
+	for idx := range expect {
+		if diffs[idx] != expect[idx] {
+			t.Errorf("expect %v, but %v", expect, diffs)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package proxy
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	eventStreamContentType = []byte("text/event-stream")
+	headerAccept           = "Accept"
+)
+
+// sseStream is a server-sent events body stream, which counts the open streams
+type sseStream struct {
+	*util.BodyStream
+
+	api    string
+	closed bool
+}
+
+func newSSEStream(api string, stream *util.BodyStream) *sseStream {
+	incrSSEStream(api)
+	return &sseStream{
+		BodyStream: stream,
+		api:        api,
+	}
+}
+
+func (s *sseStream) Close() error {
+	if !s.closed {
+		s.closed = true
+		decrSSEStream(s.api)
+	}
+
+	return s.BodyStream.Close()
+}
+
+func isEventStream(header *fasthttp.ResponseHeader) bool {
+	return bytes.HasPrefix(header.ContentType(), eventStreamContentType)
+}
+
+// acceptEventStream returns true if the request accepts the server-sent events. The
+// forward request is used, the Peek of the client request is not safe for the
+// concurrent dispatch nodes.
+func acceptEventStream(req *fasthttp.Request) bool {
+	return bytes.Contains(req.Header.Peek(headerAccept), eventStreamContentType)
+}
+
+func (p *Proxy) sseIdleTimeout(api *apiRuntime) time.Duration {
+	if api.isSSE() && api.meta.SSEOptions.IdleTimeout > 0 {
+		return time.Duration(api.meta.SSEOptions.IdleTimeout)
+	}
+
+	return p.cfg.Option.LimitTimeoutSSEIdle
+}
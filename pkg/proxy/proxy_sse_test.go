@@ -0,0 +1,116 @@
+package proxy
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+)
+
+const sseStreamsOpen = "gateway_proxy_sse_streams_open"
+
+// readSSEEvent returns the lines of the next event
+func readSSEEvent(r *bufio.Reader) (string, error) {
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+
+		line = strings.TrimRight(line, "\n")
+		if line == "" {
+			return strings.Join(lines, "|"), nil
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestServeSSE(t *testing.T) {
+	next := make(chan struct{})
+	lastEventID := make(chan string, 1)
+	backend := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		lastEventID <- req.Header.Get("Last-Event-ID")
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte("id: 1\ndata: a\n\n"))
+		rw.(http.Flusher).Flush()
+
+		<-next
+		rw.Write([]byte("id: 2\ndata: b\n\n"))
+		rw.(http.Flusher).Flush()
+
+		// idle until the proxy closes the stream
+		select {
+		case <-req.Context().Done():
+		case <-time.After(time.Second * 5):
+		}
+	}))
+	defer backend.Close()
+
+	api := newTestAPI("/events")
+	api.Name = "sse"
+	api.SSEOptions = &metapb.SSEOptions{IdleTimeout: int64(time.Millisecond * 300)}
+	p := newTestProxy(t, &Option{}, api, testServerAddr(backend.URL))
+	defer p.GracefulStop()
+
+	req, _ := http.NewRequest(http.MethodGet, "http://"+p.cfg.Addr+"/events", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Last-Event-ID", "42")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed with %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if value := <-lastEventID; value != "42" {
+		t.Errorf("expect the Last-Event-ID is forwarded, but %q", value)
+	}
+	if value := resp.Header.Get("Content-Type"); value != "text/event-stream" {
+		t.Errorf("expect the event stream, but %s", value)
+	}
+
+	// the first event is flushed before the backend sends the next event
+	r := bufio.NewReader(resp.Body)
+	if event, err := readSSEEvent(r); err != nil || event != "id: 1|data: a" {
+		t.Fatalf("expect the first event, but %q %+v", event, err)
+	}
+	if value := metricValue(t, sseStreamsOpen, "name", api.Name); value != 1 {
+		t.Errorf("expect 1 open stream, but %v", value)
+	}
+
+	close(next)
+	if event, err := readSSEEvent(r); err != nil || event != "id: 2|data: b" {
+		t.Fatalf("expect the second event, but %q %+v", event, err)
+	}
+
+	// the stream is closed by the idle timeout
+	startAt := time.Now()
+	ioutil.ReadAll(r)
+	if cost := time.Since(startAt); cost > time.Second*3 {
+		t.Errorf("expect the stream closed by the idle timeout, but %s", cost)
+	}
+
+	if !waitFor(time.Second, func() bool { return metricValue(t, sseStreamsOpen, "name", api.Name) == 0 }) {
+		t.Errorf("expect no open stream, but %v", metricValue(t, sseStreamsOpen, "name", api.Name))
+	}
+}
+
+func TestSSEIdleTimeout(t *testing.T) {
+	p := &Proxy{cfg: &Cfg{Option: &Option{LimitTimeoutSSEIdle: time.Minute}}}
+
+	api := newAPIRuntime(newTestAPI("/events"), nil, 0)
+	if value := p.sseIdleTimeout(api); value != time.Minute {
+		t.Errorf("expect the global idle timeout, but %s", value)
+	}
+
+	api.meta.SSEOptions = &metapb.SSEOptions{IdleTimeout: int64(time.Second)}
+	if value := p.sseIdleTimeout(api); value != time.Second {
+		t.Errorf("expect the idle timeout of the api, but %s", value)
+	}
+}
@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"context"
 	"net"
 	"net/http"
 	"sync/atomic"
@@ -9,6 +10,7 @@ import (
 	"github.com/fagongzi/log"
 	"github.com/soheilhy/cmux"
 	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
 )
 
 // Start start proxy
@@ -18,15 +20,36 @@ func (p *Proxy) Start() {
 	p.startMetrics()
 	p.startReadyTasks()
 
-	if !p.cfg.Option.EnableWebSocket {
-		go p.startHTTPS()
-		p.startHTTP()
+	// listen before serving, the connections are queued by the kernel until
+	// the servers are started
+	l := p.maybeProxyProtocol(p.mustListen(p.cfg.Addr))
+	var tlsL net.Listener
+	if p.enableHTTPS() {
+		tlsL = p.maybeProxyProtocol(p.mustListen(p.cfg.AddrHTTPS))
+	}
+
+	if p.cfg.AddrRPC != "" {
+		go p.startRPCWithListener(p.mustListen(p.cfg.AddrRPC))
+	}
+
+	atomic.StoreInt32(&p.ready, 1)
+	notifyUpgradeReady()
+
+	if !p.cfg.Option.EnableWebSocket &&
+		!p.cfg.Option.EnableGRPC &&
+		!p.cfg.Option.EnableRequestStreaming {
+		if tlsL != nil {
+			go p.startHTTPSWithListener(tlsL)
+		}
+		p.startHTTPWithListener(l)
 
 		return
 	}
 
-	go p.startHTTPSCMUX()
-	p.startHTTPCMUX()
+	if tlsL != nil {
+		go p.startHTTPSCMUX(tlsL)
+	}
+	p.startHTTPCMUX(l)
 }
 
 // Stop stop the proxy
@@ -40,6 +63,19 @@ func (p *Proxy) Stop() {
 	log.Infof("stop: gateway proxy stopped")
 }
 
+// GracefulStop deregister the proxy, stop accepting new connections, and wait for
+// the in-flight requests up to the limit-timeout-graceful-stop, then stop the proxy
+func (p *Proxy) GracefulStop() {
+	log.Infof("stop: start to drain gateway proxy")
+	p.drain(true)
+	p.Stop()
+}
+
+// IsReady returns false if the proxy is not started or is stopping
+func (p *Proxy) IsReady() bool {
+	return atomic.LoadInt32(&p.ready) == 1 && !p.isDraining() && !p.isStopped()
+}
+
 func (p *Proxy) listenToStop() {
 	<-p.stopC
 	p.doStop()
@@ -49,6 +85,7 @@ func (p *Proxy) doStop() {
 	p.stopOnce.Do(func() {
 		defer p.stopWG.Done()
 		p.setStopped()
+		p.closeWebSockets()
 		p.runner.Stop()
 	})
 }
@@ -65,6 +102,10 @@ func (p *Proxy) isStopped() bool {
 	return atomic.LoadInt32(&p.stopped) == 1
 }
 
+func (p *Proxy) isDraining() bool {
+	return atomic.LoadInt32(&p.draining) == 1
+}
+
 func (p *Proxy) startMetrics() {
 	util.StartMetricsPush(p.runner, p.cfg.Metric)
 }
@@ -75,46 +116,45 @@ func (p *Proxy) startReadyTasks() {
 	p.readyToDispatch()
 }
 
+// maybeProxyProtocol returns the listener parsing the PROXY protocol header if enabled
+func (p *Proxy) maybeProxyProtocol(l net.Listener) net.Listener {
+	if !p.cfg.Option.EnableProxyProtocol {
+		return l
+	}
+
+	return util.NewProxyProtocolListener(l, p.cfg.Option.LimitTimeoutProxyProtocol, p.trustedProxies)
+}
+
 func (p *Proxy) newHTTPServer() *fasthttp.Server {
-	return &fasthttp.Server{
+	s := &fasthttp.Server{
 		Handler:                       p.ServeFastHTTP,
 		ReadBufferSize:                p.cfg.Option.LimitBufferRead,
 		WriteBufferSize:               p.cfg.Option.LimitBufferWrite,
 		MaxRequestBodySize:            p.cfg.Option.LimitBytesBody,
 		DisableHeaderNamesNormalizing: p.cfg.Option.DisableHeaderNameNormalizing,
+		ConnState:                     p.onConnState,
 	}
+	p.addShutdown(func() {
+		s.Shutdown()
+	})
+	return s
 }
 
-func (p *Proxy) startHTTP() {
-	log.Infof("start http at %s", p.cfg.Addr)
-	s := p.newHTTPServer()
-	err := s.ListenAndServe(p.cfg.Addr)
-	if err != nil {
-		log.Fatalf("start http listeners failed with %+v", err)
+func (p *Proxy) newWebSocketServer() *http.Server {
+	s := &http.Server{
+		Handler: p,
 	}
+	p.addShutdown(func() {
+		s.Shutdown(context.Background())
+	})
+	return s
 }
 
 func (p *Proxy) startHTTPWithListener(l net.Listener) {
 	log.Infof("start http at %s", p.cfg.Addr)
 	s := p.newHTTPServer()
 	err := s.Serve(l)
-	if err != nil {
-		log.Fatalf("start http listeners failed with %+v", err)
-	}
-}
-
-func (p *Proxy) startHTTPS() {
-	if !p.enableHTTPS() {
-		return
-	}
-
-	defaultCertData, defaultKeyData := p.mustParseDefaultTLSCert()
-
-	log.Infof("start https at %s", p.cfg.AddrHTTPS)
-	s := p.newHTTPServer()
-	p.appendCertsEmbed(s, defaultCertData, defaultKeyData)
-	err := s.ListenAndServeTLS(p.cfg.AddrHTTPS, "", "")
-	if err != nil {
+	if err != nil && !p.isDraining() {
 		log.Fatalf("start http listeners failed with %+v", err)
 	}
 }
@@ -126,18 +166,16 @@ func (p *Proxy) startHTTPSWithListener(l net.Listener) {
 	s := p.newHTTPServer()
 	p.appendCertsEmbed(s, defaultCertData, defaultKeyData)
 	err := s.ServeTLS(l, "", "")
-	if err != nil {
+	if err != nil && !p.isDraining() {
 		log.Fatalf("start http listeners failed with %+v", err)
 	}
 }
 
 func (p *Proxy) startHTTPWebSocketWithListener(l net.Listener) {
 	log.Infof("start http websocket at %s", p.cfg.Addr)
-	s := &http.Server{
-		Handler: p,
-	}
+	s := p.newWebSocketServer()
 	err := s.Serve(l)
-	if err != nil {
+	if err != nil && !p.isDraining() {
 		log.Fatalf("start http websocket failed with %+v", err)
 	}
 }
@@ -146,49 +184,80 @@ func (p *Proxy) startHTTPSWebSocketWithListener(l net.Listener) {
 	defaultCertData, defaultKeyData := p.mustParseDefaultTLSCert()
 
 	log.Infof("start https websocket at %s", p.cfg.Addr)
-	s := &http.Server{
-		Handler: p,
-	}
+	s := p.newWebSocketServer()
 	p.configTLSConfig(s, defaultCertData, defaultKeyData)
 	err := s.ServeTLS(l, "", "")
-	if err != nil {
+	if err != nil && !p.isDraining() {
 		log.Fatalf("start https websocket failed with errors %+v", err)
 	}
 }
 
-func (p *Proxy) startHTTPCMUX() {
-	l, err := net.Listen("tcp", p.cfg.Addr)
-	if err != nil {
-		log.Fatalf("start http failed failed with %+v",
-			err)
+func (p *Proxy) startHTTPStreamingWithListener(l net.Listener) {
+	log.Infof("start http streaming request at %s", p.cfg.Addr)
+	s := &http.Server{
+		Handler: http.HandlerFunc(p.serveStreamingRequest),
 	}
+	p.addShutdown(func() {
+		s.Shutdown(context.Background())
+	})
+	err := s.Serve(l)
+	if err != nil && !p.isDraining() {
+		log.Fatalf("start http streaming request failed with %+v", err)
+	}
+}
+
+func (p *Proxy) startGRPCWithListener(l net.Listener) {
+	log.Infof("start grpc at %s", p.cfg.Addr)
+	s := &http2.Server{}
+	h := http.HandlerFunc(p.ServeGRPC)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if p.isDraining() {
+				return
+			}
+			log.Fatalf("start grpc failed with %+v", err)
+		}
+
+		go s.ServeConn(conn, &http2.ServeConnOpts{
+			Handler: h,
+		})
+	}
+}
+
+func (p *Proxy) startHTTPCMUX(l net.Listener) {
+	p.addShutdown(func() {
+		l.Close()
+	})
 
 	m := cmux.New(l)
-	go p.startHTTPWebSocketWithListener(m.Match(cmux.HTTP1HeaderField("Upgrade", "websocket")))
+	if p.cfg.Option.EnableGRPC {
+		go p.startGRPCWithListener(m.Match(cmux.HTTP2()))
+	}
+	if p.cfg.Option.EnableWebSocket {
+		go p.startHTTPWebSocketWithListener(m.Match(cmux.HTTP1HeaderField("Upgrade", "websocket")))
+	}
+	if p.cfg.Option.EnableRequestStreaming {
+		go p.startHTTPStreamingWithListener(m.Match(p.matchStreamingRequest))
+	}
 	go p.startHTTPWithListener(m.Match(cmux.Any()))
-	err = m.Serve()
-	if err != nil {
+	err := m.Serve()
+	if err != nil && !p.isDraining() {
 		log.Fatalf("start http failed failed with %+v",
 			err)
 	}
 }
 
-func (p *Proxy) startHTTPSCMUX() {
-	if !p.enableHTTPS() {
-		return
-	}
-
-	l, err := net.Listen("tcp", p.cfg.AddrHTTPS)
-	if err != nil {
-		log.Fatalf("start https failed failed with %+v",
-			err)
-	}
+func (p *Proxy) startHTTPSCMUX(l net.Listener) {
+	p.addShutdown(func() {
+		l.Close()
+	})
 
 	m := cmux.New(l)
 	go p.startHTTPSWithListener(m.Match(cmux.Any()))
 	go p.startHTTPSWebSocketWithListener(m.Match(cmux.HTTP1HeaderField("Upgrade", "websocket")))
-	err = m.Serve()
-	if err != nil {
+	err := m.Serve()
+	if err != nil && !p.isDraining() {
 		log.Fatalf("start https failed failed with %+v",
 			err)
 	}
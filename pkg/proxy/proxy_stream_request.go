@@ -0,0 +1,168 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/hack"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	streamingRequestKey = "__streaming_req"
+)
+
+// matchStreamingRequest returns true if the first request of the connection has a body
+// and matches a streaming api. fasthttp reads the whole request body before calling the
+// handler, so these connections are served by the net/http server, and the request body
+// is piped to the backend server.
+func (p *Proxy) matchStreamingRequest(r io.Reader) bool {
+	req, err := http.ReadRequest(bufio.NewReader(r))
+	if err != nil || req.ContentLength == 0 {
+		return false
+	}
+
+	fr := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(fr)
+	fr.Header.SetMethod(req.Method)
+	fr.SetRequestURI(req.RequestURI)
+	fr.SetHost(req.Host)
+	return p.dispatcher.isStreamingRequest(fr)
+}
+
+// isStreamingRequest returns true if the request matches a streaming api
+func (r *dispatcher) isStreamingRequest(req *fasthttp.Request) bool {
+	exprCtx := acquireExprCtx()
+	defer releaseExprCtx(exprCtx)
+
+	id, ok := r.route.Find(req.URI().Path(), hack.SliceToString(req.Header.Method()), exprCtx.AddParam)
+	if !ok {
+		return false
+	}
+
+	api, ok := r.apis[id]
+	return ok && api.matches(req) && api.isStreaming()
+}
+
+// serveStreamingRequest serves the request of the streaming api matched by matchStreamingRequest,
+// the filters only see the headers of the request.
+func (p *Proxy) serveStreamingRequest(rw http.ResponseWriter, req *http.Request) {
+	if p.isStopped() {
+		rw.WriteHeader(fasthttp.StatusServiceUnavailable)
+		return
+	}
+
+	atomic.AddInt64(&p.inflight, 1)
+	defer atomic.AddInt64(&p.inflight, -1)
+
+	// the following requests of the connection may be not the streaming requests,
+	// let the client reconnect to the fasthttp server
+	rw.Header().Set("Connection", "close")
+
+	var buf bytes.Buffer
+	buf.WriteByte(charLeft)
+	buf.Write(hack.StringToSlice(req.Method))
+	buf.WriteByte(charRight)
+	buf.Write(hack.StringToSlice(req.RequestURI))
+	requestTag := hack.SliceToString(buf.Bytes())
+
+	ctx := p.newRequestCtx(req)
+	startAt := time.Now()
+	api, dispatches, exprCtx := p.dispatcher.dispatch(ctx, requestTag)
+	defer releaseExprCtx(exprCtx)
+
+	if nil == api || !api.isStreaming() || len(dispatches) != 1 {
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		rw.WriteHeader(fasthttp.StatusNotFound)
+
+		log.Infof("%s: not match streaming api, return with 404",
+			requestTag)
+		return
+	}
+
+	rd := acquireRender()
+	rd.init(requestTag, api, dispatches)
+
+	dn := dispatches[0]
+	dn.requestTag = requestTag
+	dn.rd = rd
+	dn.ctx = ctx
+	p.doProxy(dn, func(c *proxyContext) {
+		c.SetAttr(streamingRequestKey, req)
+	})
+
+	rd.render(ctx, nil)
+	releaseRender(rd)
+
+	if api.cors != nil {
+		api.cors.apply(ctx)
+	}
+
+	incrRequest(api.meta.Name)
+	p.postRequest(api, dispatches, startAt)
+
+	err := writeStreamingResponse(rw, &ctx.Response)
+	if err != nil {
+		log.Errorf("%s: write streaming response failed with error %s",
+			requestTag,
+			err)
+	}
+	ctx.Response.Reset()
+}
+
+// setStreamingRequestBody pipes the body of the client request to the forward request,
+// it is called after the pre filters, so the filters only see the headers.
+func setStreamingRequestBody(c *proxyContext) bool {
+	// the body is built by the body template
+	value := c.GetAttr(streamingRequestKey)
+	if value == nil || c.result.node.meta.BodyTemplate != "" {
+		return false
+	}
+
+	req := value.(*http.Request)
+	// -1 means chunked
+	c.forwardReq.SetBodyStream(req.Body, int(req.ContentLength))
+	return true
+}
+
+// writeStreamingResponse writes the rendered response to the net/http response writer,
+// the body stream is flushed to the client as it arrives.
+func writeStreamingResponse(rw http.ResponseWriter, resp *fasthttp.Response) error {
+	header := rw.Header()
+	resp.Header.VisitAll(func(k, v []byte) {
+		switch string(k) {
+		case "Content-Length", "Transfer-Encoding", "Connection":
+			return
+		}
+		header.Add(string(k), string(v))
+	})
+
+	if !resp.IsBodyStream() {
+		header.Set("Content-Length", strconv.Itoa(len(resp.Body())))
+	} else if size := resp.Header.ContentLength(); size >= 0 {
+		header.Set("Content-Length", strconv.Itoa(size))
+	}
+
+	rw.WriteHeader(resp.StatusCode())
+	return resp.BodyWriteTo(&flushWriter{rw: rw})
+}
+
+type flushWriter struct {
+	rw http.ResponseWriter
+}
+
+func (w *flushWriter) Write(p []byte) (int, error) {
+	n, err := w.rw.Write(p)
+	if f, ok := w.rw.(http.Flusher); ok {
+		f.Flush()
+	}
+	return n, err
+}
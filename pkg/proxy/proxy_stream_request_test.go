@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeStreamingRequest(t *testing.T) {
+	var received int64
+	backend := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		received = req.ContentLength
+		rw.Header().Set("Content-Type", "application/octet-stream")
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write(body)
+	}))
+	defer backend.Close()
+
+	api := newTestAPI("/upload")
+	api.Streaming = true
+	p := newTestProxy(t, &Option{
+		EnableRequestStreaming: true,
+		LimitBufferRead:        1024,
+		LimitBytesBody:         1024,
+	}, api, testServerAddr(backend.URL))
+	defer p.GracefulStop()
+
+	// the body is larger than the read buffer and the body limit of the fasthttp server
+	data := bytes.Repeat([]byte("0123456789abcdef"), 64*1024)
+	for _, size := range []int64{int64(len(data)), -1} {
+		// -1 means chunked
+		var body io.Reader = bytes.NewReader(data)
+		if size < 0 {
+			body = ioutil.NopCloser(body)
+		}
+
+		req, _ := http.NewRequest(http.MethodPost, "http://"+p.cfg.Addr+"/upload", body)
+		req.ContentLength = size
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed with %+v", err)
+		}
+		value, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("read response failed with %+v", err)
+		}
+
+		if resp.StatusCode != http.StatusCreated {
+			t.Errorf("expect status %d, but %d", http.StatusCreated, resp.StatusCode)
+		}
+		if value := resp.Header.Get("Content-Type"); value != "application/octet-stream" {
+			t.Errorf("expect the content type of the backend response, but %s", value)
+		}
+		if !bytes.Equal(value, data) {
+			t.Errorf("expect the body of %d bytes, but %d bytes", len(data), len(value))
+		}
+		if received != size {
+			t.Errorf("expect the backend received the content length %d, but %d", size, received)
+		}
+	}
+}
+
+func TestServeStreamingRequestNotMatch(t *testing.T) {
+	api := newTestAPI("/upload")
+	p := newTestProxy(t, &Option{EnableRequestStreaming: true}, api)
+	defer p.GracefulStop()
+
+	if p.matchStreamingRequest(bytes.NewReader([]byte("POST /upload HTTP/1.1\r\nHost: gw\r\nContent-Length: 1\r\n\r\na"))) {
+		t.Errorf("expect the request of the not streaming api is not matched")
+	}
+
+	api.Streaming = true
+	if !p.matchStreamingRequest(bytes.NewReader([]byte("POST /upload HTTP/1.1\r\nHost: gw\r\nContent-Length: 1\r\n\r\na"))) {
+		t.Errorf("expect the request of the streaming api is matched")
+	}
+	if p.matchStreamingRequest(bytes.NewReader([]byte("GET /upload HTTP/1.1\r\nHost: gw\r\n\r\n"))) {
+		t.Errorf("expect the request without body is not matched")
+	}
+}
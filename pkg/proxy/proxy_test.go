@@ -0,0 +1,156 @@
+package proxy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/filter"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/plugin"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/gateway/pkg/util"
+	"github.com/fagongzi/util/task"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	globalHTTPOptions = util.DefaultHTTPOption()
+}
+
+type testStore struct {
+	store.Store
+}
+
+func (s *testStore) RemoveProxy(addr string) error {
+	return nil
+}
+
+// newTestProxy returns a started proxy, the api is dispatched to the servers of the
+// cluster 1, the servers are created by the addrs. The proxy is stopped by GracefulStop.
+func newTestProxy(t *testing.T, opt *Option, api *metapb.API, addrs ...string) *Proxy {
+	var servers []*metapb.Server
+	for _, addr := range addrs {
+		servers = append(servers, &metapb.Server{Addr: addr})
+	}
+
+	return newTestProxyWithServers(t, opt, api, servers...)
+}
+
+// newTestProxyWithServers returns a started proxy like newTestProxy, the ids of the
+// servers are assigned by the order
+func newTestProxyWithServers(t *testing.T, opt *Option, api *metapb.API, servers ...*metapb.Server) *Proxy {
+	if opt.LimitCountDispatchWorker == 0 {
+		opt.LimitCountDispatchWorker = 1
+	}
+	if opt.LimitCountCopyWorker == 0 {
+		opt.LimitCountCopyWorker = 1
+	}
+	if opt.LimitTimeoutGracefulStop == 0 {
+		opt.LimitTimeoutGracefulStop = time.Second
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed with %+v", err)
+	}
+
+	cfg := &Cfg{
+		Addr:   l.Addr().String(),
+		Option: opt,
+	}
+	p := &Proxy{
+		client:        util.NewFastHTTPClientOption(globalHTTPOptions),
+		grpcTransport: newGRPCTransport(),
+		cfg:           cfg,
+		filtersMap:    make(map[string]filter.Filter),
+		stopC:         make(chan struct{}),
+		runner:        task.NewRunner(),
+		copies:        make([]chan *copyReq, opt.LimitCountCopyWorker, opt.LimitCountCopyWorker),
+		dispatches:    make([]chan *dispatchNode, opt.LimitCountDispatchWorker, opt.LimitCountDispatchWorker),
+		jsEngine:      plugin.NewEngine(false, FilterJSPlugin),
+	}
+	p.dispatcher = newDispatcher(cfg, &testStore{}, p.runner, p.updateJSEngine)
+
+	p.dispatcher.addCluster(&metapb.Cluster{ID: 1, Name: "cluster", LoadBalance: metapb.RoundRobin})
+	for idx, svr := range servers {
+		svr.ID = uint64(idx + 1)
+		p.dispatcher.addServer(svr)
+		p.dispatcher.addBind(&metapb.Bind{ClusterID: 1, ServerID: svr.ID})
+	}
+	if api != nil {
+		if err := p.dispatcher.addAPI(api); err != nil {
+			t.Fatalf("add api failed with %+v", err)
+		}
+	}
+
+	go p.listenToStop()
+	p.readyToCopy()
+	p.readyToDispatch()
+	go p.startHTTPCMUX(l)
+	return p
+}
+
+// newTestAPI returns a api with a dispatch node to the cluster 1
+func newTestAPI(pattern string) *metapb.API {
+	return &metapb.API{
+		ID:         1,
+		Name:       "test",
+		URLPattern: pattern,
+		Method:     "*",
+		Status:     metapb.Up,
+		Nodes:      []*metapb.DispatchNode{{ClusterID: 1}},
+	}
+}
+
+func testServerAddr(url string) string {
+	return url[len("http://"):]
+}
+
+// metricValue returns the value of the gauge or the counter with the label pairs
+func metricValue(t *testing.T, name string, labels ...string) float64 {
+	mfs, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics failed with %+v", err)
+	}
+
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+
+		for _, m := range mf.GetMetric() {
+			matched := 0
+			for _, label := range m.GetLabel() {
+				for i := 0; i+1 < len(labels); i += 2 {
+					if label.GetName() == labels[i] && label.GetValue() == labels[i+1] {
+						matched++
+					}
+				}
+			}
+			if 2*matched != len(labels) {
+				continue
+			}
+
+			if m.GetGauge() != nil {
+				return m.GetGauge().GetValue()
+			}
+			return m.GetCounter().GetValue()
+		}
+	}
+
+	return 0
+}
+
+// waitFor waits for the condition up to the timeout, returns false if timeout
+func waitFor(timeout time.Duration, condition func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	return true
+}
@@ -0,0 +1,228 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+)
+
+const (
+	envUpgradeListeners = "GATEWAY_UPGRADE_LISTENERS"
+	envUpgradeReadyFD   = "GATEWAY_UPGRADE_READY_FD"
+
+	// the first fd of the exec.Cmd.ExtraFiles
+	upgradeFirstFD = 3
+)
+
+var (
+	errUpgradeTimeout = errors.New("wait for the new process ready timeout")
+
+	inheritedOnce      sync.Once
+	inheritedListeners = make(map[string]net.Listener)
+)
+
+// loadInheritedListeners load the listeners passed by the parent process
+func loadInheritedListeners() {
+	value := os.Getenv(envUpgradeListeners)
+	if value == "" {
+		return
+	}
+
+	for idx, addr := range strings.Split(value, ",") {
+		f := os.NewFile(uintptr(upgradeFirstFD+idx), addr)
+		l, err := net.FileListener(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("upgrade: inherit listener %s failed with %+v",
+				addr,
+				err)
+		}
+
+		inheritedListeners[addr] = l
+		log.Infof("upgrade: listener %s inherited", addr)
+	}
+}
+
+// notifyUpgradeReady notify the parent process that the listeners are ready
+func notifyUpgradeReady() {
+	value := os.Getenv(envUpgradeReadyFD)
+	if value == "" {
+		return
+	}
+
+	fd, err := strconv.Atoi(value)
+	if err != nil {
+		log.Errorf("upgrade: invalid ready fd %s", value)
+		return
+	}
+
+	f := os.NewFile(uintptr(fd), "ready")
+	_, err = f.Write([]byte{1})
+	f.Close()
+	if err != nil {
+		log.Errorf("upgrade: notify parent ready failed with %+v", err)
+	}
+
+	os.Unsetenv(envUpgradeListeners)
+	os.Unsetenv(envUpgradeReadyFD)
+}
+
+// Listen returns the listener of the addr, the listener is inherited from the
+// parent process when the proxy is started by a upgrade.
+func (p *Proxy) Listen(addr string) (net.Listener, error) {
+	inheritedOnce.Do(loadInheritedListeners)
+
+	l, ok := inheritedListeners[addr]
+	if !ok {
+		var err error
+		l, err = net.Listen("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.Lock()
+	p.listeners = append(p.listeners, upgradeListener{addr: addr, l: l})
+	p.Unlock()
+	return l, nil
+}
+
+func (p *Proxy) mustListen(addr string) net.Listener {
+	l, err := p.Listen(addr)
+	if err != nil {
+		log.Fatalf("listen at %s failed with %+v",
+			addr,
+			err)
+	}
+
+	return l
+}
+
+type upgradeListener struct {
+	addr string
+	l    net.Listener
+}
+
+// Upgrade start the new binary with the listeners of the current process, wait
+// for the new process ready, then drain and stop the current process. The current
+// process keep serving if the new process failed.
+func (p *Proxy) Upgrade() error {
+	log.Infof("upgrade: start to upgrade gateway proxy")
+
+	cmd, ready, err := p.forkUpgrade()
+	if err != nil {
+		return err
+	}
+
+	err = waitUpgradeReady(cmd, ready, p.cfg.Option.LimitTimeoutUpgrade)
+	ready.Close()
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return err
+	}
+
+	log.Infof("upgrade: new process %d is ready, start to drain", cmd.Process.Pid)
+
+	// the new process registered the same addr, keep the registration
+	p.drain(false)
+	p.Stop()
+	return nil
+}
+
+func (p *Proxy) forkUpgrade() (*exec.Cmd, *os.File, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.RLock()
+	listeners := p.listeners
+	p.RUnlock()
+
+	var addrs []string
+	var files []*os.File
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	for _, ul := range listeners {
+		tl, ok := ul.l.(*net.TCPListener)
+		if !ok {
+			return nil, nil, fmt.Errorf("listener %s can not be inherited", ul.addr)
+		}
+
+		f, err := tl.File()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		addrs = append(addrs, ul.addr)
+		files = append(files, f)
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	files = append(files, w)
+
+	cmd := exec.Command(exe, os.Args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = files
+	cmd.Env = append(upgradeEnv(),
+		fmt.Sprintf("%s=%s", envUpgradeListeners, strings.Join(addrs, ",")),
+		fmt.Sprintf("%s=%d", envUpgradeReadyFD, upgradeFirstFD+len(addrs)))
+	err = cmd.Start()
+	if err != nil {
+		r.Close()
+		return nil, nil, err
+	}
+
+	return cmd, r, nil
+}
+
+// waitUpgradeReady wait for the new process to write the ready byte, the read
+// returns error if the new process exit before ready
+func waitUpgradeReady(cmd *exec.Cmd, ready *os.File, timeout time.Duration) error {
+	errC := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 1)
+		_, err := ready.Read(buf)
+		errC <- err
+	}()
+
+	select {
+	case err := <-errC:
+		if err != nil {
+			return fmt.Errorf("new process %d exit before ready: %+v", cmd.Process.Pid, err)
+		}
+		return nil
+	case <-time.After(timeout):
+		return errUpgradeTimeout
+	}
+}
+
+func upgradeEnv() []string {
+	var env []string
+	for _, value := range os.Environ() {
+		if strings.HasPrefix(value, envUpgradeListeners+"=") ||
+			strings.HasPrefix(value, envUpgradeReadyFD+"=") {
+			continue
+		}
+		env = append(env, value)
+	}
+
+	return env
+}
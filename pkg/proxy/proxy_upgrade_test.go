@@ -0,0 +1,162 @@
+package proxy
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	envUpgradeTestMode = "GATEWAY_UPGRADE_TEST_MODE"
+
+	upgradeModeReady = "ready"
+	upgradeModeExit  = "exit"
+	upgradeModeHang  = "hang"
+)
+
+// TestUpgradeHelperProcess is the new process started by the upgrade tests, it is
+// skipped if not started by the upgrade tests.
+func TestUpgradeHelperProcess(t *testing.T) {
+	mode := os.Getenv(envUpgradeTestMode)
+	if mode == "" {
+		return
+	}
+
+	switch mode {
+	case upgradeModeExit:
+		os.Exit(1)
+	case upgradeModeHang:
+		time.Sleep(time.Minute)
+		os.Exit(1)
+	}
+
+	addr := os.Getenv(envUpgradeListeners)
+	inheritedOnce.Do(loadInheritedListeners)
+	l, ok := inheritedListeners[addr]
+	if !ok {
+		os.Exit(2)
+	}
+
+	notifyUpgradeReady()
+	if os.Getenv(envUpgradeListeners) != "" || os.Getenv(envUpgradeReadyFD) != "" {
+		os.Exit(3)
+	}
+
+	conn, err := l.Accept()
+	if err != nil {
+		os.Exit(4)
+	}
+	conn.Write([]byte("new process"))
+	conn.Close()
+	os.Exit(0)
+}
+
+// newUpgradeProxy returns a proxy with a listener, the new process is the test
+// binary which only runs the TestUpgradeHelperProcess with the mode
+func newUpgradeProxy(t *testing.T, mode string) (*Proxy, net.Listener, func()) {
+	p := &Proxy{
+		cfg: &Cfg{
+			Option: &Option{LimitTimeoutUpgrade: time.Second * 5},
+		},
+	}
+	l, err := p.Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed with %+v", err)
+	}
+
+	args := os.Args
+	os.Args = []string{args[0], "-test.run=^TestUpgradeHelperProcess$"}
+	os.Setenv(envUpgradeTestMode, mode)
+	return p, l, func() {
+		os.Args = args
+		os.Unsetenv(envUpgradeTestMode)
+		l.Close()
+	}
+}
+
+func TestUpgradeEnv(t *testing.T) {
+	os.Setenv(envUpgradeListeners, "127.0.0.1:80")
+	os.Setenv(envUpgradeReadyFD, "4")
+	defer os.Unsetenv(envUpgradeListeners)
+	defer os.Unsetenv(envUpgradeReadyFD)
+
+	env := upgradeEnv()
+	for _, value := range env {
+		if strings.HasPrefix(value, envUpgradeListeners+"=") ||
+			strings.HasPrefix(value, envUpgradeReadyFD+"=") {
+			t.Errorf("expect the upgrade env of the current process is removed, but %s", value)
+		}
+	}
+	if len(env) != len(os.Environ())-2 {
+		t.Errorf("expect the other env are kept")
+	}
+}
+
+func TestUpgradeInheritListeners(t *testing.T) {
+	p, l, reset := newUpgradeProxy(t, upgradeModeReady)
+	defer reset()
+
+	cmd, ready, err := p.forkUpgrade()
+	if err != nil {
+		t.Fatalf("fork failed with %+v", err)
+	}
+	err = waitUpgradeReady(cmd, ready, p.cfg.Option.LimitTimeoutUpgrade)
+	ready.Close()
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		t.Fatalf("expect the new process ready, but %+v", err)
+	}
+
+	// the listener is only accepted by the new process after the current process closed it
+	addr := l.Addr().String()
+	l.Close()
+	conn, err := net.DialTimeout("tcp", addr, time.Second)
+	if err != nil {
+		t.Fatalf("expect the new process listen at %s, but %+v", addr, err)
+	}
+	conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	value, _ := ioutil.ReadAll(conn)
+	conn.Close()
+	if string(value) != "new process" {
+		t.Errorf("expect the connection accepted by the new process, but %q", value)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		t.Errorf("expect the new process exit normally, but %+v", err)
+	}
+}
+
+func TestUpgradeExitBeforeReady(t *testing.T) {
+	p, _, reset := newUpgradeProxy(t, upgradeModeExit)
+	defer reset()
+
+	err := p.Upgrade()
+	if err == nil || !strings.Contains(err.Error(), "exit before ready") {
+		t.Errorf("expect the upgrade failed with the new process exit, but %+v", err)
+	}
+	if p.isDraining() {
+		t.Errorf("expect the current process keep serving")
+	}
+}
+
+func TestUpgradeReadyTimeout(t *testing.T) {
+	p, _, reset := newUpgradeProxy(t, upgradeModeHang)
+	defer reset()
+	p.cfg.Option.LimitTimeoutUpgrade = time.Millisecond * 300
+
+	startAt := time.Now()
+	err := p.Upgrade()
+	if err != errUpgradeTimeout {
+		t.Errorf("expect the upgrade timeout, but %+v", err)
+	}
+	if cost := time.Since(startAt); cost < time.Millisecond*300 || cost > time.Second*10 {
+		t.Errorf("expect the upgrade failed after the timeout, but %s", cost)
+	}
+	if p.isDraining() {
+		t.Errorf("expect the current process keep serving")
+	}
+}
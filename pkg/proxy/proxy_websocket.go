@@ -2,20 +2,31 @@ package proxy
 
 import (
 	"bytes"
+	"crypto/tls"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
-	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/util"
 	"github.com/fagongzi/log"
 	"github.com/fagongzi/util/hack"
 	"github.com/gorilla/websocket"
-	"github.com/koding/websocketproxy"
 	"github.com/valyala/fasthttp"
 )
 
 const (
 	websocketRspKey = "__ws_rsp"
+	websocketReqKey = "__ws_req"
+
+	directionUpstream   = "upstream"
+	directionDownstream = "downstream"
 )
+
 var wsHeaders = map[string]bool{
 	"Origin":                   true,
 	"Sec-WebSocket-Protocol":   true,
@@ -32,6 +43,13 @@ var wsHeaders = map[string]bool{
 	"Sec-WebSocket-Extensions": true,
 	"Sec-WebSocket-Accept":     true,
 }
+
+var wsCopyBufPool = sync.Pool{
+	New: func() interface{} {
+		return make([]byte, 4096)
+	},
+}
+
 // ServeHTTP  http reverse handler by http
 func (p *Proxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	if p.isStopped() {
@@ -39,6 +57,9 @@ func (p *Proxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	atomic.AddInt64(&p.inflight, 1)
+	defer atomic.AddInt64(&p.inflight, -1)
+
 	var buf bytes.Buffer
 	buf.WriteByte(charLeft)
 	buf.Write(hack.StringToSlice(req.Method))
@@ -51,89 +72,377 @@ func (p *Proxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	ctx := &fasthttp.RequestCtx{}
-	for k, vs := range req.Header {
-		for _, v := range vs {
-			ctx.Request.Header.Add(k, v)
-		}
-	}
-	ctx.Request.SetRequestURI(req.RequestURI)
-
+	ctx := p.newRequestCtx(req)
 	api, dispatches, exprCtx := p.dispatcher.dispatch(ctx, requestTag)
+	defer releaseExprCtx(exprCtx)
+
 	if len(dispatches) <= 0 &&
 		(nil == api || api.meta.DefaultValue == nil) {
 		rw.WriteHeader(fasthttp.StatusNotFound)
-		releaseExprCtx(exprCtx)
 		return
 	}
 
 	if len(dispatches) != 1 {
-		log.Fatalf("websocket not support dispatch to multi backend server")
+		log.Errorf("%s: websocket not support dispatch to multi backend server, return with 500",
+			requestTag)
+		rw.WriteHeader(fasthttp.StatusInternalServerError)
+		for _, dn := range dispatches {
+			releaseDispathNode(dn)
+		}
+		return
 	}
 
 	if !api.isWebSocket() {
-		log.Fatalf("normal http request must use fasthttp")
+		log.Errorf("%s: api %s is not a websocket api, return with 400",
+			requestTag,
+			api.meta.Name)
+		rw.WriteHeader(fasthttp.StatusBadRequest)
+		releaseDispathNode(dispatches[0])
+		return
 	}
 
-	dispatches[0].ctx = ctx
-	p.doProxy(dispatches[0], func(c *proxyContext) {
+	dn := dispatches[0]
+	dn.ctx = ctx
+	dn.requestTag = requestTag
+	p.doProxy(dn, func(c *proxyContext) {
 		c.SetAttr(websocketRspKey, rw)
+		c.SetAttr(websocketReqKey, req)
 	})
-	dispatches[0].release()
-	releaseExprCtx(exprCtx)
+
+	// the websocket connection is not upgraded, the response is not written
+	if dn.hasError() {
+		code := dn.code
+		if code == 0 {
+			code = fasthttp.StatusInternalServerError
+		}
+		rw.WriteHeader(code)
+	}
+
+	dn.release()
+	releaseDispathNode(dn)
 }
 
+// newRequestCtx returns a fasthttp request ctx with the header of the http request,
+// the request body is not copied.
+func (p *Proxy) newRequestCtx(req *http.Request) *fasthttp.RequestCtx {
+	fr := fasthttp.AcquireRequest()
+	for k, vs := range req.Header {
+		for _, v := range vs {
+			fr.Header.Add(k, v)
+		}
+	}
+	fr.Header.SetMethod(req.Method)
+	fr.SetRequestURI(req.RequestURI)
+	fr.SetHost(req.Host)
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(fr, parseRemoteAddr(req.RemoteAddr), nil)
+	fasthttp.ReleaseRequest(fr)
+	util.SetClientIP(ctx, p.trustedProxies)
+	return ctx
+}
+
+// onWebsocket dial the backend server, upgrade the client connection, and proxy the
+// messages until one of the connections is closed. The returned response has the
+// status code of the handshake, nothing is written to the client if it is not 101.
 func (p *Proxy) onWebsocket(c *proxyContext, addr string) (*fasthttp.Response, error) {
 	resp := fasthttp.AcquireResponse()
+	rw := c.GetAttr(websocketRspKey).(http.ResponseWriter)
+	req := c.GetAttr(websocketReqKey).(*http.Request)
+	api := c.result.api
+	opts := api.webSocketOptions()
 
-	var r http.Request
-	r.Method = "GET"
-	r.Proto = "HTTP/1.1"
-	r.ProtoMajor = 1
-	r.ProtoMinor = 1
-	r.RequestURI = string(c.forwardReq.RequestURI())
-	r.Host = string(c.forwardReq.Host())
+	if !p.acquireWebSocket(api.id, opts.MaxConnections) {
+		log.Warnf("%s: dispatch node %d websocket connections over the max %d",
+			c.result.requestTag,
+			c.result.idx,
+			opts.MaxConnections)
+		resp.SetStatusCode(fasthttp.StatusServiceUnavailable)
+		return resp, nil
+	}
+	defer p.releaseWebSocket(api.id)
+
+	scheme, origin := "ws", fmt.Sprintf("http://%s", addr)
+	if opts.Secure {
+		scheme, origin = "wss", fmt.Sprintf("https://%s", addr)
+	}
+	if opts.Origin != "" {
+		origin = opts.Origin
+	}
 
 	hdr := make(http.Header)
 	c.forwardReq.Header.VisitAll(func(k, v []byte) {
 		sk := string(k)
-		sv := string(v)
-		switch sk {
-		case "Transfer-Encoding":
-			r.TransferEncoding = append(r.TransferEncoding, sv)
-		default:
-			hdr.Set(sk, sv)
+		if _, ok := wsHeaders[sk]; ok {
+			return
 		}
+		hdr.Add(sk, string(v))
 	})
-	r.Header = hdr
-	r.URL, _ = url.ParseRequestURI(r.RequestURI)
-
-	wp := &websocketproxy.WebsocketProxy{
-		Upgrader: &websocket.Upgrader{
-			ReadBufferSize:  c.result.httpOption().ReadBufferSize,
-			WriteBufferSize: c.result.httpOption().WriteBufferSize,
-			CheckOrigin: func(r *http.Request) bool {
-				return true
-			},
-		},
-		Director: func(incoming *http.Request, out http.Header) {
-			out.Set("Origin", fmt.Sprintf("http://%s", addr))
-			for key, vals := range incoming.Header {
-				if _, ok := wsHeaders[key]; ok {
-					continue
-				}
-				for _, val := range vals {
-					out.Set(key, val)
+	hdr.Set("Origin", origin)
+	if value := req.Header.Get("Sec-WebSocket-Protocol"); value != "" {
+		hdr.Set("Sec-WebSocket-Protocol", value)
+	}
+	if value := req.Header.Get("Cookie"); value != "" {
+		hdr.Set("Cookie", value)
+	}
 
-				}
-			}
+	option := c.result.httpOption()
+	dialer := &websocket.Dialer{
+		HandshakeTimeout: option.ReadTimeout,
+		ReadBufferSize:   option.ReadBufferSize,
+		WriteBufferSize:  option.WriteBufferSize,
+	}
+	if opts.Secure {
+		dialer.TLSClientConfig = &tls.Config{ServerName: hack.SliceToString(c.forwardReq.Host())}
+	}
+
+	backendURL := fmt.Sprintf("%s://%s%s", scheme, addr, c.forwardReq.RequestURI())
+	backend, backendRsp, err := dialer.Dial(backendURL, hdr)
+	if err != nil {
+		if backendRsp != nil {
+			log.Errorf("%s: dispatch node %d dial websocket %s failed with code %d",
+				c.result.requestTag,
+				c.result.idx,
+				backendURL,
+				backendRsp.StatusCode)
+			resp.SetStatusCode(backendRsp.StatusCode)
+			return resp, nil
+		}
+
+		resp.SetStatusCode(fasthttp.StatusBadGateway)
+		return resp, err
+	}
+
+	upgradeHeader := make(http.Header)
+	if value := backendRsp.Header.Get("Sec-Websocket-Protocol"); value != "" {
+		upgradeHeader.Set("Sec-Websocket-Protocol", value)
+	}
+	if value := backendRsp.Header.Get("Set-Cookie"); value != "" {
+		upgradeHeader.Set("Set-Cookie", value)
+	}
+
+	upgradeCode := fasthttp.StatusSwitchingProtocols
+	upgrader := &websocket.Upgrader{
+		ReadBufferSize:  option.ReadBufferSize,
+		WriteBufferSize: option.WriteBufferSize,
+		CheckOrigin: func(r *http.Request) bool {
+			return true
 		},
-		Backend: func(r *http.Request) *url.URL {
-			u, _ := url.Parse(fmt.Sprintf("ws://%s%s", addr, r.RequestURI))
-			return u
+		Error: func(w http.ResponseWriter, r *http.Request, status int, reason error) {
+			upgradeCode = status
 		},
 	}
 
-	wp.ServeHTTP(c.GetAttr(websocketRspKey).(http.ResponseWriter), &r)
+	client, err := upgrader.Upgrade(rw, req, upgradeHeader)
+	if err != nil {
+		backend.Close()
+		log.Errorf("%s: dispatch node %d upgrade websocket failed with error %s",
+			c.result.requestTag,
+			c.result.idx,
+			err)
+		resp.SetStatusCode(upgradeCode)
+		return resp, nil
+	}
+
+	s := newWSSession(api.meta.Name, opts, client, backend)
+	p.wsSessions.Store(s, struct{}{})
+	s.serve()
+	p.wsSessions.Delete(s)
+
+	log.Infof("%s: dispatch node %d websocket closed",
+		c.result.requestTag,
+		c.result.idx)
+	resp.SetStatusCode(fasthttp.StatusSwitchingProtocols)
 	return resp, nil
 }
+
+func (p *Proxy) acquireWebSocket(api uint64, max int64) bool {
+	value, _ := p.wsConns.LoadOrStore(api, new(int64))
+	count := value.(*int64)
+	if atomic.AddInt64(count, 1) > max && max > 0 {
+		atomic.AddInt64(count, -1)
+		return false
+	}
+
+	return true
+}
+
+func (p *Proxy) releaseWebSocket(api uint64) {
+	if value, ok := p.wsConns.Load(api); ok {
+		atomic.AddInt64(value.(*int64), -1)
+	}
+}
+
+// closeWebSockets send close message to all websocket connections
+func (p *Proxy) closeWebSockets() {
+	p.wsSessions.Range(func(key, value interface{}) bool {
+		key.(*wsSession).close(websocket.CloseGoingAway, "proxy stopped")
+		return true
+	})
+}
+
+type wsSession struct {
+	sync.Once
+
+	api          string
+	client       *websocket.Conn
+	backend      *websocket.Conn
+	idleTimeout  time.Duration
+	pingInterval time.Duration
+	pongTimeout  time.Duration
+	lastActive   int64
+	stopC        chan struct{}
+}
+
+func newWSSession(api string, opts *metapb.WebSocketOptions, client, backend *websocket.Conn) *wsSession {
+	s := &wsSession{
+		api:          api,
+		client:       client,
+		backend:      backend,
+		idleTimeout:  time.Duration(opts.IdleTimeout),
+		pingInterval: time.Duration(opts.PingInterval),
+		pongTimeout:  time.Duration(opts.PongTimeout),
+		lastActive:   time.Now().UnixNano(),
+		stopC:        make(chan struct{}),
+	}
+
+	if s.pingInterval > 0 && s.pongTimeout <= 0 {
+		s.pongTimeout = s.pingInterval
+	}
+
+	if opts.MaxMessageSize > 0 {
+		client.SetReadLimit(opts.MaxMessageSize)
+		backend.SetReadLimit(opts.MaxMessageSize)
+	}
+
+	return s
+}
+
+func (s *wsSession) serve() {
+	incrWebSocketConn(s.api)
+	defer decrWebSocketConn(s.api)
+
+	if s.pingInterval > 0 {
+		s.client.SetReadDeadline(time.Now().Add(s.pingInterval + s.pongTimeout))
+		s.client.SetPongHandler(func(string) error {
+			return s.client.SetReadDeadline(time.Now().Add(s.pingInterval + s.pongTimeout))
+		})
+	}
+
+	go s.keepalive()
+
+	errC := make(chan error, 2)
+	go func() {
+		errC <- s.pump(s.backend, s.client, directionUpstream)
+	}()
+	go func() {
+		errC <- s.pump(s.client, s.backend, directionDownstream)
+	}()
+
+	err := <-errC
+	code, text := closeCode(err)
+	s.close(code, text)
+	<-errC
+}
+
+// keepalive send ping to the client, and close the idle connections
+func (s *wsSession) keepalive() {
+	interval := s.pingInterval
+	if interval <= 0 || (s.idleTimeout > 0 && s.idleTimeout < interval) {
+		interval = s.idleTimeout
+	}
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopC:
+			return
+		case now := <-ticker.C:
+			if s.idleTimeout > 0 &&
+				now.Sub(time.Unix(0, atomic.LoadInt64(&s.lastActive))) > s.idleTimeout {
+				s.close(websocket.CloseGoingAway, "idle timeout")
+				return
+			}
+
+			if s.pingInterval > 0 {
+				err := s.client.WriteControl(websocket.PingMessage, nil, now.Add(s.pongTimeout))
+				if err != nil {
+					s.close(websocket.CloseGoingAway, "ping failed")
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *wsSession) pump(dst, src *websocket.Conn, direction string) error {
+	buf := wsCopyBufPool.Get().([]byte)
+	defer wsCopyBufPool.Put(buf)
+
+	for {
+		mt, r, err := src.NextReader()
+		if err != nil {
+			return err
+		}
+
+		w, err := dst.NextWriter(mt)
+		if err != nil {
+			return err
+		}
+
+		n, err := io.CopyBuffer(w, r, buf)
+		if err != nil {
+			return err
+		}
+
+		if err = w.Close(); err != nil {
+			return err
+		}
+
+		atomic.StoreInt64(&s.lastActive, time.Now().UnixNano())
+		incrWebSocketMessage(s.api, direction, n)
+	}
+}
+
+// close send close message to both sides, and close the connections
+func (s *wsSession) close(code int, text string) {
+	s.Do(func() {
+		close(s.stopC)
+
+		deadline := time.Now().Add(time.Second)
+		msg := websocket.FormatCloseMessage(code, text)
+		s.client.WriteControl(websocket.CloseMessage, msg, deadline)
+		s.backend.WriteControl(websocket.CloseMessage, msg, deadline)
+		s.client.Close()
+		s.backend.Close()
+	})
+}
+
+func closeCode(err error) (int, string) {
+	if ce, ok := err.(*websocket.CloseError); ok &&
+		ce.Code != websocket.CloseNoStatusReceived &&
+		ce.Code != websocket.CloseAbnormalClosure &&
+		ce.Code != websocket.CloseTLSHandshake {
+		return ce.Code, ce.Text
+	}
+
+	if err == websocket.ErrReadLimit {
+		return websocket.CloseMessageTooBig, ""
+	}
+
+	return websocket.CloseGoingAway, ""
+}
+
+func parseRemoteAddr(addr string) net.Addr {
+	value, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		return &net.TCPAddr{}
+	}
+
+	return value
+}
@@ -0,0 +1,187 @@
+package proxy
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	webSocketConnsOpen     = "gateway_proxy_websocket_connections_open"
+	webSocketMessagesTotal = "gateway_proxy_websocket_message_total"
+)
+
+// newWebSocketEcho returns a websocket backend server which echo the messages
+func newWebSocketEcho() *httptest.Server {
+	upgrader := &websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			mt, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(mt, data); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func newWebSocketProxy(t *testing.T, name string, opts *metapb.WebSocketOptions) (*Proxy, *httptest.Server) {
+	backend := newWebSocketEcho()
+	api := newTestAPI("/ws")
+	api.Name = name
+	api.WebSocketOptions = opts
+	return newTestProxy(t, &Option{EnableWebSocket: true}, api, testServerAddr(backend.URL)), backend
+}
+
+func dialWebSocket(t *testing.T, p *Proxy) *websocket.Conn {
+	conn, resp, err := websocket.DefaultDialer.Dial("ws://"+p.cfg.Addr+"/ws", nil)
+	if err != nil {
+		if resp != nil {
+			t.Fatalf("dial failed with code %d", resp.StatusCode)
+		}
+		t.Fatalf("dial failed with %+v", err)
+	}
+
+	return conn
+}
+
+// expectCloseCode reads the connection until it is closed, and checks the close code
+// and the close text if it is not empty
+func expectCloseCode(t *testing.T, conn *websocket.Conn, code int, text string) {
+	conn.SetReadDeadline(time.Now().Add(time.Second * 3))
+	for {
+		_, _, err := conn.ReadMessage()
+		if err == nil {
+			continue
+		}
+
+		if ce, ok := err.(*websocket.CloseError); !ok || ce.Code != code || (text != "" && ce.Text != text) {
+			t.Errorf("expect close code %d %s, but %+v", code, text, err)
+		}
+		return
+	}
+}
+
+func TestWebSocketEcho(t *testing.T) {
+	p, backend := newWebSocketProxy(t, "ws-echo", &metapb.WebSocketOptions{})
+	defer backend.Close()
+	defer p.GracefulStop()
+
+	// the counters are kept by the other tests
+	var messages []float64
+	for _, direction := range []string{directionUpstream, directionDownstream} {
+		messages = append(messages, metricValue(t, webSocketMessagesTotal, "name", "ws-echo", "direction", direction))
+	}
+
+	conn := dialWebSocket(t, p)
+	data := []byte("hello")
+	conn.WriteMessage(websocket.TextMessage, data)
+	_, value, err := conn.ReadMessage()
+	if err != nil || !bytes.Equal(value, data) {
+		t.Fatalf("expect the echo message, but %q %+v", value, err)
+	}
+
+	if value := metricValue(t, webSocketConnsOpen, "name", "ws-echo"); value != 1 {
+		t.Errorf("expect 1 open connection, but %v", value)
+	}
+	for idx, direction := range []string{directionUpstream, directionDownstream} {
+		if !waitFor(time.Second, func() bool {
+			return metricValue(t, webSocketMessagesTotal, "name", "ws-echo", "direction", direction) == messages[idx]+1
+		}) {
+			t.Errorf("expect 1 %s message", direction)
+		}
+	}
+
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(time.Second))
+	expectCloseCode(t, conn, websocket.CloseNormalClosure, "")
+	if !waitFor(time.Second, func() bool { return metricValue(t, webSocketConnsOpen, "name", "ws-echo") == 0 }) {
+		t.Errorf("expect no open connection")
+	}
+}
+
+func TestWebSocketMaxConnections(t *testing.T) {
+	p, backend := newWebSocketProxy(t, "ws-max", &metapb.WebSocketOptions{MaxConnections: 1})
+	defer backend.Close()
+	defer p.GracefulStop()
+
+	conn := dialWebSocket(t, p)
+	defer conn.Close()
+
+	_, resp, err := websocket.DefaultDialer.Dial("ws://"+p.cfg.Addr+"/ws", nil)
+	if err == nil || resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expect 503 over the max connections, but %+v %+v", resp, err)
+	}
+
+	// the connection is released after closed
+	conn.Close()
+	if !waitFor(time.Second, func() bool {
+		conn, _, err := websocket.DefaultDialer.Dial("ws://"+p.cfg.Addr+"/ws", nil)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}) {
+		t.Errorf("expect the connection is released")
+	}
+}
+
+func TestWebSocketReadLimit(t *testing.T) {
+	p, backend := newWebSocketProxy(t, "ws-limit", &metapb.WebSocketOptions{MaxMessageSize: 16})
+	defer backend.Close()
+	defer p.GracefulStop()
+
+	conn := dialWebSocket(t, p)
+	defer conn.Close()
+
+	conn.WriteMessage(websocket.TextMessage, bytes.Repeat([]byte("a"), 32))
+	expectCloseCode(t, conn, websocket.CloseMessageTooBig, "")
+}
+
+func TestWebSocketIdleTimeout(t *testing.T) {
+	p, backend := newWebSocketProxy(t, "ws-idle", &metapb.WebSocketOptions{IdleTimeout: int64(time.Millisecond * 200)})
+	defer backend.Close()
+	defer p.GracefulStop()
+
+	conn := dialWebSocket(t, p)
+	defer conn.Close()
+
+	startAt := time.Now()
+	expectCloseCode(t, conn, websocket.CloseGoingAway, "idle timeout")
+	if cost := time.Since(startAt); cost < time.Millisecond*200 {
+		t.Errorf("expect closed after the idle timeout, but %s", cost)
+	}
+}
+
+func TestWebSocketCloseOnStop(t *testing.T) {
+	p, backend := newWebSocketProxy(t, "ws-stop", &metapb.WebSocketOptions{})
+	defer backend.Close()
+
+	conn := dialWebSocket(t, p)
+	defer conn.Close()
+
+	conn.WriteMessage(websocket.TextMessage, []byte("hello"))
+	conn.ReadMessage()
+
+	stopped := make(chan struct{})
+	go func() {
+		p.GracefulStop()
+		close(stopped)
+	}()
+	expectCloseCode(t, conn, websocket.CloseGoingAway, "proxy stopped")
+	<-stopped
+}
@@ -1,7 +1,10 @@
 package proxy
 
 import (
+	"bytes"
+
 	"github.com/buger/jsonparser"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
 	"github.com/fagongzi/log"
 	"github.com/valyala/fasthttp"
 )
@@ -12,6 +15,10 @@ var (
 	emptyArray  = []byte("[]")
 )
 
+const (
+	partialErrorsAttr = "_errors"
+)
+
 type render struct {
 	multi        bool
 	multiContext []byte
@@ -70,6 +77,11 @@ func (rd *render) renderSingle(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	if dn.stream != nil {
+		rd.renderStream(ctx, dn)
+		return
+	}
+
 	if !rd.api.hasRenderTemplate() {
 		rd.renderRaw(ctx, dn)
 		return
@@ -87,25 +99,39 @@ func (rd *render) renderMulti(ctx *fasthttp.RequestCtx) {
 	code := fasthttp.StatusInternalServerError
 	hasTemplate := rd.api.hasRenderTemplate()
 
+	var failures [][]byte
+	partialErrors := rd.api.meta.PartialErrors
+
 	for _, dn := range rd.nodes {
-		if hasError {
-			dn.release()
-			continue
-		}
+		if dn.isFailed() {
+			if partialErrors {
+				failures = append(failures, dn.errorObject())
+			} else if !hasError &&
+				dn.node.meta.FailurePolicy == metapb.FailureRequired {
+				hasError = true
+				code = dn.code
+				err = dn.err
+			}
 
-		if dn.hasError() &&
-			!dn.hasDefaultValue() {
-			hasError = true
-			code = dn.code
-			err = dn.err
 			dn.release()
 			continue
 		}
 
-		dn.copyHeaderTo(ctx)
+		if !hasError {
+			dn.copyHeaderTo(ctx)
+		}
 		dn.release()
 	}
 
+	// the content type is removed by copying the headers of the nodes
+	ctx.Response.Header.SetContentType(MultiResultsContentType)
+
+	if len(failures) > 0 {
+		value := append([]byte{'['}, bytes.Join(failures, []byte{','})...)
+		value = append(value, ']')
+		rd.multiContext, _ = jsonparser.Set(rd.multiContext, value, partialErrorsAttr)
+	}
+
 	if hasError {
 		if rd.api.hasDefaultValue() {
 			rd.renderDefault(ctx)
@@ -139,6 +165,23 @@ func (rd *render) renderRaw(ctx *fasthttp.RequestCtx, dn *dispatchNode) {
 		rd.requestTag)
 }
 
+func (rd *render) renderStream(ctx *fasthttp.RequestCtx, dn *dispatchNode) {
+	ctx.Response.Header.SetContentTypeBytes(dn.getResponseContentType())
+	// the stream will be closed by fasthttp after the body is written.
+	// server-sent events always use chunked transfer encoding,
+	// fasthttp flushes every chunk, so every event is sent to client as it arrives
+	if dn.sse {
+		ctx.SetBodyStream(newSSEStream(rd.api.meta.Name, dn.stream), -1)
+	} else {
+		ctx.SetBodyStream(dn.stream, dn.stream.Size())
+	}
+	dn.stream = nil
+	dn.release()
+
+	log.Infof("%s: return with stream body",
+		rd.requestTag)
+}
+
 func (rd *render) renderDefault(ctx *fasthttp.RequestCtx) {
 	header := &ctx.Response.Header
 
@@ -227,6 +270,10 @@ func (rd *render) extract(src []byte) ([]byte, error) {
 }
 
 func (rd *render) extractValue(attr *renderAttr, src []byte) ([]byte, error) {
+	if attr.template != nil {
+		return attr.template.Exec(src), nil
+	}
+
 	if len(attr.extracts) == 1 {
 		return rd.extractAttrValue(src, attr.extracts[0]...)
 	}
@@ -0,0 +1,134 @@
+package proxy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/valyala/fasthttp"
+)
+
+func TestRenderMultiFailures(t *testing.T) {
+	cases := []struct {
+		name          string
+		policy        metapb.FailurePolicy
+		partialErrors bool
+		code          int
+		body          string
+	}{
+		{"required", metapb.FailureRequired, false, fasthttp.StatusServiceUnavailable, ""},
+		{"optional", metapb.FailureOptional, false, fasthttp.StatusOK,
+			`{"user":{"id":1}}`},
+		{"optional with error", metapb.FailureOptionalWithError, false, fasthttp.StatusOK,
+			`{"user":{"id":1},"account":{"attr":"account","code":503,"error":"Service Unavailable"}}`},
+		{"required with partial errors", metapb.FailureRequired, true, fasthttp.StatusOK,
+			`{"user":{"id":1},"_errors":[{"attr":"account","code":503,"error":"Service Unavailable"}]}`},
+		{"optional with partial errors", metapb.FailureOptional, true, fasthttp.StatusOK,
+			`{"user":{"id":1},"_errors":[{"attr":"account","code":503,"error":"Service Unavailable"}]}`},
+		{"optional with error and partial errors", metapb.FailureOptionalWithError, true, fasthttp.StatusOK,
+			`{"user":{"id":1},"account":{"attr":"account","code":503,"error":"Service Unavailable"},"_errors":[{"attr":"account","code":503,"error":"Service Unavailable"}]}`},
+	}
+
+	for _, c := range cases {
+		api := newAPIRuntime(&metapb.API{
+			ID:            1,
+			Name:          "multi",
+			PartialErrors: c.partialErrors,
+			Nodes: []*metapb.DispatchNode{
+				{ClusterID: 1, AttrName: "user"},
+				{ClusterID: 1, AttrName: "account", FailurePolicy: c.policy},
+			},
+		}, nil, 0)
+
+		multiCtx := acquireMultiContext()
+		multiCtx.init()
+		dispatches := newMultiDispatches(api, multiCtx)
+		multiCtx.run(dispatches, func(dn *dispatchNode) {
+			if dn.node.meta.AttrName == "account" {
+				dn.err = ErrNoServer
+				dn.code = fasthttp.StatusServiceUnavailable
+			} else {
+				dn.res = fasthttp.AcquireResponse()
+				dn.res.SetBodyString(`{"id":1}`)
+			}
+			dn.maybeDone()
+		})
+
+		ctx := &fasthttp.RequestCtx{}
+		rd := &render{}
+		rd.init("test", api, dispatches)
+		rd.render(ctx, multiCtx)
+		releaseMultiContext(multiCtx)
+
+		if ctx.Response.StatusCode() != c.code {
+			t.Errorf("%s: expect code %d, but %d", c.name, c.code, ctx.Response.StatusCode())
+		}
+		if string(ctx.Response.Body()) != c.body {
+			t.Errorf("%s: expect body %s, but %s", c.name, c.body, ctx.Response.Body())
+		}
+	}
+}
+
+func TestRenderMultiDefaultValue(t *testing.T) {
+	api := newAPIRuntime(&metapb.API{
+		ID:   1,
+		Name: "multi",
+		Nodes: []*metapb.DispatchNode{
+			{ClusterID: 1, AttrName: "user"},
+			{ClusterID: 1, AttrName: "account", DefaultValue: &metapb.HTTPResult{Body: []byte(`{"id":0}`)}},
+		},
+	}, nil, 0)
+
+	multiCtx := acquireMultiContext()
+	multiCtx.init()
+	defer releaseMultiContext(multiCtx)
+	dispatches := newMultiDispatches(api, multiCtx)
+	multiCtx.run(dispatches, func(dn *dispatchNode) {
+		if dn.node.meta.AttrName == "account" {
+			dn.err = ErrNoServer
+			dn.code = fasthttp.StatusServiceUnavailable
+		} else {
+			dn.res = fasthttp.AcquireResponse()
+			dn.res.SetBodyString(`{"id":1}`)
+		}
+		dn.maybeDone()
+	})
+
+	ctx := &fasthttp.RequestCtx{}
+	rd := &render{}
+	rd.init("test", api, dispatches)
+	rd.render(ctx, multiCtx)
+
+	// the failed node with the default value is not a failure
+	expect := `{"user":{"id":1},"account":{"id":0}}`
+	if ctx.Response.StatusCode() != fasthttp.StatusOK || string(ctx.Response.Body()) != expect {
+		t.Errorf("expect 200 %s, but %d %s", expect, ctx.Response.StatusCode(), ctx.Response.Body())
+	}
+}
+
+func TestErrorObjectHidesError(t *testing.T) {
+	api := newAPIRuntime(&metapb.API{
+		ID:    1,
+		Name:  "multi",
+		Nodes: []*metapb.DispatchNode{{ClusterID: 1, AttrName: "user"}},
+	}, nil, 0)
+
+	cases := []struct {
+		code   int
+		expect string
+	}{
+		{fasthttp.StatusBadGateway, `{"attr":"user","code":502,"error":"Bad Gateway"}`},
+		{0, `{"attr":"user","code":500,"error":"Internal Server Error"}`},
+	}
+
+	for _, c := range cases {
+		dn := &dispatchNode{
+			node: api.nodes[0],
+			code: c.code,
+			err:  errors.New("dial tcp 10.0.0.1:8080: connection refused"),
+		}
+		if value := string(dn.errorObject()); value != c.expect {
+			t.Errorf("expect %s, but %s", c.expect, value)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/gateway/pkg/pb"
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/gateway/pkg/store"
+	"github.com/fagongzi/log"
+)
+
+var (
+	purgeClient = &http.Client{
+		Timeout: time.Second * 10,
+	}
+)
+
+// purgeCache send the purge request to the proxies, the results are in the order of the
+// proxies. The proxies sharing a cache storage are purged once, the request is sent to
+// them one by one until the storage is purged.
+func purgeCache(db store.Store, req *rpcpb.PurgeCacheReq) ([]rpcpb.PurgeCacheResult, error) {
+	err := pb.ValidatePurgeCache(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var proxies []*metapb.Proxy
+	err = db.GetProxies(limit, func(value *metapb.Proxy) error {
+		proxies = append(proxies, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := groupByCacheStorage(proxies)
+	var wg sync.WaitGroup
+	results := make([]rpcpb.PurgeCacheResult, len(groups))
+	for idx, group := range groups {
+		wg.Add(1)
+		go func(idx int, group []*metapb.Proxy) {
+			defer wg.Done()
+			results[idx] = purgeStorageCache(group, body)
+		}(idx, group)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// groupByCacheStorage returns the proxies grouped by the shared cache storage in the order
+// of the proxies, every proxy with the local cache storage is a group
+func groupByCacheStorage(proxies []*metapb.Proxy) [][]*metapb.Proxy {
+	var groups [][]*metapb.Proxy
+	shared := make(map[string]int)
+	for _, value := range proxies {
+		if value.CacheStorage == "" {
+			groups = append(groups, []*metapb.Proxy{value})
+			continue
+		}
+
+		idx, ok := shared[value.CacheStorage]
+		if !ok {
+			idx = len(groups)
+			shared[value.CacheStorage] = idx
+			groups = append(groups, nil)
+		}
+		groups[idx] = append(groups[idx], value)
+	}
+
+	return groups
+}
+
+// purgeStorageCache send the purge request to the proxies sharing a cache storage one
+// by one, returns the result of the first proxy purged the storage
+func purgeStorageCache(proxies []*metapb.Proxy, body []byte) rpcpb.PurgeCacheResult {
+	var result rpcpb.PurgeCacheResult
+	for _, value := range proxies {
+		result = purgeProxyCache(value, body)
+		if result.Error == "" {
+			break
+		}
+
+		log.Errorf("purge cache of proxy %s failed, errors:%s",
+			value.Addr,
+			result.Error)
+	}
+
+	return result
+}
+
+func purgeProxyCache(proxy *metapb.Proxy, body []byte) rpcpb.PurgeCacheResult {
+	result := rpcpb.PurgeCacheResult{
+		Proxy: proxy.Addr,
+	}
+
+	rsp, err := purgeClient.Post(fmt.Sprintf("http://%s%s", proxy.AddrRPC, rpcpb.PurgeCachePath),
+		"application/json",
+		bytes.NewReader(body))
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer rsp.Body.Close()
+
+	err = json.NewDecoder(rsp.Body).Decode(&result)
+	if err != nil {
+		result.Error = err.Error()
+	} else if rsp.StatusCode != http.StatusOK && result.Error == "" {
+		result.Error = rsp.Status
+	}
+
+	result.Proxy = proxy.Addr
+	return result
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/gateway/pkg/store"
+)
+
+type testStore struct {
+	store.Store
+	proxies []*metapb.Proxy
+}
+
+func (s *testStore) GetProxies(limit int64, fn func(*metapb.Proxy) error) error {
+	for _, value := range s.proxies {
+		if err := fn(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type purgeProxy struct {
+	*httptest.Server
+	requests int64
+	body     atomic.Value
+}
+
+// newPurgeProxy returns a rpc server of the proxy, the purge request is failed if the
+// removed is negative
+func newPurgeProxy(removed int64) *purgeProxy {
+	p := &purgeProxy{}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(&p.requests, 1)
+		if req.Method != http.MethodPost || req.URL.Path != rpcpb.PurgeCachePath {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		p.body.Store(string(body))
+
+		result := rpcpb.PurgeCacheResult{Proxy: "backend", Removed: removed}
+		if removed < 0 {
+			rw.WriteHeader(http.StatusInternalServerError)
+			result.Removed = 0
+			result.Error = "storage failed"
+		}
+		json.NewEncoder(rw).Encode(&result)
+	}))
+	return p
+}
+
+func (p *purgeProxy) meta(addr, cacheStorage string) *metapb.Proxy {
+	return &metapb.Proxy{
+		Addr:         addr,
+		AddrRPC:      strings.TrimPrefix(p.URL, "http://"),
+		CacheStorage: cacheStorage,
+	}
+}
+
+func TestPurgeCache(t *testing.T) {
+	local1, local2 := newPurgeProxy(1), newPurgeProxy(2)
+	failed, shared1, shared2 := newPurgeProxy(-1), newPurgeProxy(10), newPurgeProxy(10)
+	for _, p := range []*purgeProxy{local1, local2, failed, shared1, shared2} {
+		defer p.Close()
+	}
+
+	db := &testStore{proxies: []*metapb.Proxy{
+		failed.meta("failed", "redis"),
+		local1.meta("local1", ""),
+		shared1.meta("shared1", "redis"),
+		local2.meta("local2", ""),
+		shared2.meta("shared2", "redis"),
+	}}
+
+	req := &rpcpb.PurgeCacheReq{Tag: "user"}
+	results, err := purgeCache(db, req)
+	if err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+
+	// the shared storage is purged once by the first succeed proxy
+	expect := []rpcpb.PurgeCacheResult{
+		{Proxy: "shared1", Removed: 10},
+		{Proxy: "local1", Removed: 1},
+		{Proxy: "local2", Removed: 2},
+	}
+	if len(results) != len(expect) {
+		t.Fatalf("expect %d results, but %+v", len(expect), results)
+	}
+	for idx, result := range results {
+		if result.Proxy != expect[idx].Proxy || result.Removed != expect[idx].Removed || result.Error != "" {
+			t.Errorf("expect result %+v, but %+v", expect[idx], result)
+		}
+	}
+
+	for _, p := range []*purgeProxy{local1, local2, failed, shared1} {
+		if value := atomic.LoadInt64(&p.requests); value != 1 {
+			t.Errorf("expect 1 purge request of %s, but %d", p.URL, value)
+		}
+	}
+	if value := atomic.LoadInt64(&shared2.requests); value != 0 {
+		t.Errorf("expect the shared storage is not purged again, but %d", value)
+	}
+
+	var sent rpcpb.PurgeCacheReq
+	if err := json.Unmarshal([]byte(local1.body.Load().(string)), &sent); err != nil || sent.Tag != "user" {
+		t.Errorf("expect the purge request is sent, but %+v %+v", sent, err)
+	}
+}
+
+func TestPurgeCacheFailed(t *testing.T) {
+	failed := newPurgeProxy(-1)
+	defer failed.Close()
+
+	db := &testStore{proxies: []*metapb.Proxy{
+		failed.meta("failed", "redis"),
+		{Addr: "unreachable", AddrRPC: "127.0.0.1:0", CacheStorage: "redis"},
+		failed.meta("local", ""),
+	}}
+
+	results, err := purgeCache(db, &rpcpb.PurgeCacheReq{API: 1})
+	if err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expect 2 results, but %+v", results)
+	}
+	if results[0].Proxy != "unreachable" || results[0].Error == "" {
+		t.Errorf("expect the error of the last proxy of the shared storage, but %+v", results[0])
+	}
+	if results[1].Proxy != "local" || results[1].Error != "storage failed" {
+		t.Errorf("expect the error of the proxy, but %+v", results[1])
+	}
+
+	if _, err := purgeCache(db, &rpcpb.PurgeCacheReq{API: 1, Tag: "user"}); err == nil {
+		t.Errorf("expect the invalid purge request")
+	}
+}
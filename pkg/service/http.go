@@ -21,6 +21,8 @@ func InitHTTPRouter(server *echo.Echo, ui, uiPrefix string) {
 	initAPIRouter(versionGroup)
 	initPluginRouter(versionGroup)
 	initSystemRouter(versionGroup)
+	initCacheRouter(versionGroup)
+	initACLRouter(versionGroup)
 	initStatic(server, ui, uiPrefix)
 }
 
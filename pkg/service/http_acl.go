@@ -0,0 +1,39 @@
+package service
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/metapb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initACLRouter(server *echo.Group) {
+	server.PUT("/acl/ip",
+		grpcx.NewJSONBodyHTTPHandle(putIPAccessControlFactory, putIPAccessControlHandler))
+	server.GET("/acl/ip",
+		grpcx.NewGetHTTPHandle(emptyParamFactory, getIPAccessControlHandler))
+}
+
+func getIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	value, err := Store.GetIPAccessControl()
+	if err != nil {
+		log.Errorf("api-acl-ip-get: req %+v, errors:%+v", value, err)
+		return &grpcx.JSONResult{Code: -1, Data: err.Error()}, nil
+	}
+
+	return &grpcx.JSONResult{Data: value}, nil
+}
+
+func putIPAccessControlHandler(value interface{}) (*grpcx.JSONResult, error) {
+	err := Store.PutIPAccessControl(value.(*metapb.IPAccessControl))
+	if err != nil {
+		log.Errorf("api-acl-ip-put: req %+v, errors:%+v", value, err)
+		return &grpcx.JSONResult{Code: -1, Data: err.Error()}, nil
+	}
+
+	return &grpcx.JSONResult{}, nil
+}
+
+func putIPAccessControlFactory() interface{} {
+	return &metapb.IPAccessControl{}
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"github.com/fagongzi/gateway/pkg/pb/rpcpb"
+	"github.com/fagongzi/grpcx"
+	"github.com/fagongzi/log"
+	"github.com/labstack/echo"
+)
+
+func initCacheRouter(server *echo.Group) {
+	server.POST("/cache/purge",
+		grpcx.NewJSONBodyHTTPHandle(purgeCacheFactory, postPurgeCacheHandler))
+}
+
+func postPurgeCacheHandler(value interface{}) (*grpcx.JSONResult, error) {
+	results, err := purgeCache(Store, value.(*rpcpb.PurgeCacheReq))
+	if err != nil {
+		log.Errorf("api-cache-purge: req %+v, errors:%+v", value, err)
+		return &grpcx.JSONResult{Code: -1, Data: err.Error()}, nil
+	}
+
+	return &grpcx.JSONResult{Data: results}, nil
+}
+
+func purgeCacheFactory() interface{} {
+	return &rpcpb.PurgeCacheReq{}
+}
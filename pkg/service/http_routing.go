@@ -10,6 +10,10 @@ import (
 func initRoutingRouter(server *echo.Group) {
 	server.GET("/routings/:id",
 		grpcx.NewGetHTTPHandle(idParamFactory, getRoutingHandler))
+	server.GET("/routings/:id/rollout",
+		grpcx.NewGetHTTPHandle(idParamFactory, getRoutingRolloutHandler))
+	server.GET("/routings/:id/diffs",
+		grpcx.NewGetHTTPHandle(idParamFactory, getRoutingDiffsHandler))
 	server.DELETE("/routings/:id",
 		grpcx.NewGetHTTPHandle(idParamFactory, deleteRoutingHandler))
 	server.PUT("/routings",
@@ -48,6 +52,26 @@ func getRoutingHandler(value interface{}) (*grpcx.JSONResult, error) {
 	return &grpcx.JSONResult{Data: value}, nil
 }
 
+func getRoutingRolloutHandler(value interface{}) (*grpcx.JSONResult, error) {
+	routing, err := Store.GetRouting(value.(uint64))
+	if err != nil {
+		log.Errorf("api-routing-rollout-get: req %+v, errors:%+v", value, err)
+		return &grpcx.JSONResult{Code: -1, Data: err.Error()}, nil
+	}
+
+	return &grpcx.JSONResult{Data: routing.Rollout}, nil
+}
+
+func getRoutingDiffsHandler(value interface{}) (*grpcx.JSONResult, error) {
+	diffs, err := Store.GetShadowDiffs(value.(uint64))
+	if err != nil {
+		log.Errorf("api-routing-diffs-get: req %+v, errors:%+v", value, err)
+		return &grpcx.JSONResult{Code: -1, Data: err.Error()}, nil
+	}
+
+	return &grpcx.JSONResult{Data: diffs}, nil
+}
+
 func putRoutingFactory() interface{} {
 	return &metapb.Routing{}
 }
@@ -449,6 +449,36 @@ func (s *metaService) GetAppliedPlugins(ctx context.Context, req *rpcpb.GetAppli
 	}
 }
 
+func (s *metaService) PutIPAccessControl(ctx context.Context, req *rpcpb.PutIPAccessControlReq) (*rpcpb.PutIPAccessControlRsp, error) {
+	select {
+	case <-ctx.Done():
+		return nil, errRPCCancel
+	default:
+		err := s.db.PutIPAccessControl(&req.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rpcpb.PutIPAccessControlRsp{}, nil
+	}
+}
+
+func (s *metaService) GetIPAccessControl(ctx context.Context, req *rpcpb.GetIPAccessControlReq) (*rpcpb.GetIPAccessControlRsp, error) {
+	select {
+	case <-ctx.Done():
+		return nil, errRPCCancel
+	default:
+		value, err := s.db.GetIPAccessControl()
+		if err != nil {
+			return nil, err
+		}
+
+		return &rpcpb.GetIPAccessControlRsp{
+			Value: value,
+		}, nil
+	}
+}
+
 func (s *metaService) Clean(ctx context.Context, req *rpcpb.CleanReq) (*rpcpb.CleanRsp, error) {
 	select {
 	case <-ctx.Done():
@@ -476,3 +506,19 @@ func (s *metaService) SetID(ctx context.Context, req *rpcpb.SetIDReq) (*rpcpb.Se
 		return &rpcpb.SetIDRsp{}, nil
 	}
 }
+
+func (s *metaService) PurgeCache(ctx context.Context, req *rpcpb.PurgeCacheReq) (*rpcpb.PurgeCacheRsp, error) {
+	select {
+	case <-ctx.Done():
+		return nil, errRPCCancel
+	default:
+		results, err := purgeCache(s.db, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rpcpb.PurgeCacheRsp{
+			Results: results,
+		}, nil
+	}
+}
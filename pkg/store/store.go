@@ -60,6 +60,8 @@ const (
 	EventSrcPlugin = EvtSrc(6)
 	// EventSrcApplyPlugin apply plugin event
 	EventSrcApplyPlugin = EvtSrc(7)
+	// EventSrcIPAccessControl global ip access control event
+	EventSrcIPAccessControl = EvtSrc(8)
 )
 
 // Evt event
@@ -126,9 +128,12 @@ type Store interface {
 	GetAPI(id uint64) (*metapb.API, error)
 
 	PutRouting(routing *metapb.Routing) (uint64, error)
+	UpdateRouting(old, routing *metapb.Routing) error
 	RemoveRouting(id uint64) error
 	GetRoutings(limit int64, fn func(interface{}) error) error
 	GetRouting(id uint64) (*metapb.Routing, error)
+	PutShadowDiff(diff *metapb.ShadowDiff, slot uint64) error
+	GetShadowDiffs(routingID uint64) ([]*metapb.ShadowDiff, error)
 
 	PutPlugin(plugin *metapb.Plugin) (uint64, error)
 	RemovePlugin(id uint64) error
@@ -137,7 +142,11 @@ type Store interface {
 	ApplyPlugins(applied *metapb.AppliedPlugins) error
 	GetAppliedPlugins() (*metapb.AppliedPlugins, error)
 
+	PutIPAccessControl(value *metapb.IPAccessControl) error
+	GetIPAccessControl() (*metapb.IPAccessControl, error)
+
 	RegistryProxy(proxy *metapb.Proxy, ttl int64) error
+	RemoveProxy(addr string) error
 	GetProxies(limit int64, fn func(*metapb.Proxy) error) error
 
 	Watch(evtCh chan *Evt, stopCh chan bool) error
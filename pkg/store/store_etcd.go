@@ -50,8 +50,10 @@ type EtcdStore struct {
 	apisDir          string
 	proxiesDir       string
 	routingsDir      string
+	shadowDiffsDir   string
 	pluginsDir       string
 	appliedPluginDir string
+	ipAccessControl  string
 	idPath           string
 
 	idLock sync.Mutex
@@ -74,8 +76,10 @@ func NewEtcdStore(etcdAddrs []string, prefix string, basicAuth BasicAuth) (Store
 		apisDir:            fmt.Sprintf("%s/apis", prefix),
 		proxiesDir:         fmt.Sprintf("%s/proxies", prefix),
 		routingsDir:        fmt.Sprintf("%s/routings", prefix),
+		shadowDiffsDir:     fmt.Sprintf("%s/diffs", prefix),
 		pluginsDir:         fmt.Sprintf("%s/plugins", prefix),
 		appliedPluginDir:   fmt.Sprintf("%s/applied/plugins", prefix),
+		ipAccessControl:    fmt.Sprintf("%s/acl/ip", prefix),
 		idPath:             fmt.Sprintf("%s/id", prefix),
 		watchMethodMapping: make(map[EvtSrc]func(EvtType, *mvccpb.KeyValue) *Evt),
 		base:               100,
@@ -200,6 +204,32 @@ func (e *EtcdStore) Batch(batch *rpcpb.BatchReq) (*rpcpb.BatchRsp, error) {
 		return nil, err
 	}
 
+	if len(batch.PutAPIs) > 0 {
+		updated := make(map[uint64]bool, len(batch.PutAPIs))
+		values := make([]*metapb.API, 0, len(batch.PutAPIs))
+		for _, req := range batch.PutAPIs {
+			updated[req.API.ID] = true
+			values = append(values, &req.API)
+		}
+
+		fields := make(map[string]uint64)
+		err = e.getValues(e.apisDir, 64, func() pb { return &metapb.API{} }, func(data interface{}) error {
+			v := data.(*metapb.API)
+			if !updated[v.ID] && v.GraphQLField != "" {
+				fields[v.GraphQLField] = v.ID
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkGraphQLFields(fields, values...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ops = make([]clientv3.Op, 0, len(batch.PutAPIs))
 	for _, req := range batch.PutAPIs {
 		value := &req.API
@@ -431,11 +461,15 @@ func (e *EtcdStore) PutAPI(value *metapb.API) (uint64, error) {
 	// load all api every times for validate
 	// TODO: maybe need optimization if there are too much apis
 	apiRoute := route.NewRoute()
+	fields := make(map[string]uint64)
 	e.getValues(e.apisDir, 64, func() pb { return &metapb.API{} }, func(data interface{}) error {
 		v := data.(*metapb.API)
 		if v.ID != value.ID && v.Status == metapb.Up {
 			apiRoute.Add(v)
 		}
+		if v.ID != value.ID && v.GraphQLField != "" {
+			fields[v.GraphQLField] = v.ID
+		}
 		return nil
 	})
 
@@ -446,11 +480,37 @@ func (e *EtcdStore) PutAPI(value *metapb.API) (uint64, error) {
 		}
 	}
 
+	err = checkGraphQLFields(fields, value)
+	if err != nil {
+		return 0, err
+	}
+
 	return e.putPB(e.apisDir, value, func(id uint64) {
 		value.ID = id
 	})
 }
 
+// checkGraphQLFields returns an error if a graphql field is resolved by more than one
+// api, the fields are the graphql fields of the other apis in the store
+func checkGraphQLFields(fields map[string]uint64, values ...*metapb.API) error {
+	added := make(map[string]bool, len(values))
+	for _, value := range values {
+		if value.GraphQLField == "" {
+			continue
+		}
+
+		if id, ok := fields[value.GraphQLField]; ok {
+			return fmt.Errorf("graphql field %s is already resolved by the api %d", value.GraphQLField, id)
+		}
+		if added[value.GraphQLField] {
+			return fmt.Errorf("graphql field %s is resolved by more than one api", value.GraphQLField)
+		}
+		added[value.GraphQLField] = true
+	}
+
+	return nil
+}
+
 // RemoveAPI remove a api from store
 func (e *EtcdStore) RemoveAPI(id uint64) error {
 	e.Lock()
@@ -491,12 +551,50 @@ func (e *EtcdStore) PutRouting(value *metapb.Routing) (uint64, error) {
 	})
 }
 
+// UpdateRouting update the routing if the routing in store is not changed,
+// returns ErrStaleOP if the routing is changed by others.
+func (e *EtcdStore) UpdateRouting(old, value *metapb.Routing) error {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateRouting(value)
+	if err != nil {
+		return err
+	}
+
+	oldData, err := old.Marshal()
+	if err != nil {
+		return err
+	}
+
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+
+	key := getKey(e.routingsDir, value.ID)
+	cmp := clientv3.Compare(clientv3.Value(key), "=", string(oldData))
+	rsp, err := e.txn().If(cmp).Then(clientv3.OpPut(key, string(data))).Commit()
+	if err != nil {
+		return err
+	}
+
+	if !rsp.Succeeded {
+		return ErrStaleOP
+	}
+
+	return nil
+}
+
 // RemoveRouting remove routing
 func (e *EtcdStore) RemoveRouting(id uint64) error {
 	e.Lock()
 	defer e.Unlock()
 
-	return e.delete(getKey(e.routingsDir, id))
+	opRouting := clientv3.OpDelete(getKey(e.routingsDir, id))
+	opDiffs := clientv3.OpDelete(getKey(e.shadowDiffsDir, id), clientv3.WithPrefix())
+	_, err := e.txn().Then(opRouting, opDiffs).Commit()
+	return err
 }
 
 // GetRoutings returns routes in store
@@ -516,6 +614,44 @@ func (e *EtcdStore) GetRouting(id uint64) (*metapb.Routing, error) {
 	return value, e.getPB(e.routingsDir, id, value)
 }
 
+// PutShadowDiff put the diff sample of the copy routing to the slot, the old
+// sample in the slot is replaced
+func (e *EtcdStore) PutShadowDiff(value *metapb.ShadowDiff, slot uint64) error {
+	e.Lock()
+	defer e.Unlock()
+
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.put(getKey(getKey(e.shadowDiffsDir, value.RoutingID), slot), string(data))
+}
+
+// GetShadowDiffs returns the diff samples of the copy routing
+func (e *EtcdStore) GetShadowDiffs(routingID uint64) ([]*metapb.ShadowDiff, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	rsp, err := e.get(getKey(e.shadowDiffsDir, routingID), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	var values []*metapb.ShadowDiff
+	for _, item := range rsp.Kvs {
+		v := &metapb.ShadowDiff{}
+		err := v.Unmarshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
 // PutPlugin add or update the plugin
 func (e *EtcdStore) PutPlugin(value *metapb.Plugin) (uint64, error) {
 	e.Lock()
@@ -594,6 +730,49 @@ func (e *EtcdStore) doGetAppliedPlugins() (*metapb.AppliedPlugins, error) {
 	return value, e.getPBWithKey(e.appliedPluginDir, value, true)
 }
 
+// PutIPAccessControl put the global ip access control
+func (e *EtcdStore) PutIPAccessControl(value *metapb.IPAccessControl) error {
+	e.Lock()
+	defer e.Unlock()
+
+	err := pbutil.ValidateIPAccessControl(value)
+	if err != nil {
+		return err
+	}
+
+	data, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return e.put(e.ipAccessControl, string(data))
+}
+
+// GetIPAccessControl returns the global ip access control
+func (e *EtcdStore) GetIPAccessControl() (*metapb.IPAccessControl, error) {
+	e.RLock()
+	defer e.RUnlock()
+
+	return e.doGetIPAccessControl()
+}
+
+func (e *EtcdStore) doGetIPAccessControl() (*metapb.IPAccessControl, error) {
+	data, err := e.getValue(e.ipAccessControl)
+	if err != nil {
+		return nil, err
+	}
+
+	value := &metapb.IPAccessControl{}
+	if len(data) > 0 {
+		err = value.Unmarshal(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return value, nil
+}
+
 // RegistryProxy registry
 func (e *EtcdStore) RegistryProxy(proxy *metapb.Proxy, ttl int64) error {
 	key := getAddrKey(e.proxiesDir, proxy.Addr)
@@ -620,6 +799,11 @@ func (e *EtcdStore) RegistryProxy(proxy *metapb.Proxy, ttl int64) error {
 	return e.put(key, string(data), clientv3.WithLease(leaseResp.ID))
 }
 
+// RemoveProxy remove the registered proxy
+func (e *EtcdStore) RemoveProxy(addr string) error {
+	return e.delete(getAddrKey(e.proxiesDir, addr))
+}
+
 // GetProxies returns proxies in store
 func (e *EtcdStore) GetProxies(limit int64, fn func(*metapb.Proxy) error) error {
 	start := util.MinAddrFormat
@@ -897,6 +1081,18 @@ func (e *EtcdStore) BackupTo(to string) error {
 		}
 	}
 
+	// backup global ip access control
+	acl, err := e.doGetIPAccessControl()
+	if err != nil {
+		return err
+	}
+	if len(acl.Whitelist) > 0 || len(acl.Blacklist) > 0 {
+		err = targetC.PutIPAccessControl(acl.Whitelist, acl.Blacklist)
+		if err != nil {
+			return err
+		}
+	}
+
 	// backup id
 	currID, err := e.getID()
 	if err != nil {
@@ -68,6 +68,8 @@ func (e *EtcdStore) doWatch() {
 					evtSrc = EventSrcPlugin
 				} else if strings.HasPrefix(key, e.appliedPluginDir) {
 					evtSrc = EventSrcApplyPlugin
+				} else if strings.HasPrefix(key, e.ipAccessControl) {
+					evtSrc = EventSrcIPAccessControl
 				} else {
 					continue
 				}
@@ -201,6 +203,19 @@ func (e *EtcdStore) doWatchWithApplyPlugin(evtType EvtType, kv *mvccpb.KeyValue)
 	}
 }
 
+func (e *EtcdStore) doWatchWithIPAccessControl(evtType EvtType, kv *mvccpb.KeyValue) *Evt {
+	value := &metapb.IPAccessControl{}
+	if len(kv.Value) > 0 {
+		protoc.MustUnmarshal(value, []byte(kv.Value))
+	}
+
+	return &Evt{
+		Src:   EventSrcIPAccessControl,
+		Type:  evtType,
+		Value: value,
+	}
+}
+
 func (e *EtcdStore) init() {
 	e.watchMethodMapping[EventSrcBind] = e.doWatchWithBind
 	e.watchMethodMapping[EventSrcServer] = e.doWatchWithServer
@@ -210,4 +225,5 @@ func (e *EtcdStore) init() {
 	e.watchMethodMapping[EventSrcProxy] = e.doWatchWithProxy
 	e.watchMethodMapping[EventSrcPlugin] = e.doWatchWithPlugin
 	e.watchMethodMapping[EventSrcApplyPlugin] = e.doWatchWithApplyPlugin
+	e.watchMethodMapping[EventSrcIPAccessControl] = e.doWatchWithIPAccessControl
 }
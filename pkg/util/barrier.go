@@ -1,6 +1,8 @@
 package util
 
 import (
+	"encoding/binary"
+	"hash/fnv"
 	"math/rand"
 	"sync"
 	"sync/atomic"
@@ -57,3 +59,16 @@ func NewRateBarrierBase(rate, base int) *RateBarrier {
 func (b *RateBarrier) Allow() bool {
 	return b.source[int(atomic.AddUint64(&b.op, 1))%b.base] < b.rate
 }
+
+// AllowKey returns true if the bucket of the key is allowed. The same key with
+// the same salt always has the same bucket, so a key allowed by a rate is also
+// allowed by any bigger rate.
+func (b *RateBarrier) AllowKey(salt uint64, key string) bool {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], salt)
+
+	h := fnv.New32a()
+	h.Write(buf[:])
+	h.Write([]byte(key))
+	return int(h.Sum32()%uint32(b.base)) < b.rate
+}
@@ -0,0 +1,47 @@
+package util
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAllowKey(t *testing.T) {
+	b := NewRateBarrier(30)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("user-%d", i)
+		expect := b.AllowKey(1, key)
+		for j := 0; j < 10; j++ {
+			if b.AllowKey(1, key) != expect {
+				t.Errorf("expect same result for key %s", key)
+			}
+		}
+	}
+}
+
+func TestAllowKeyWithBiggerRate(t *testing.T) {
+	for rate := 1; rate < 100; rate++ {
+		b := NewRateBarrier(rate)
+		bigger := NewRateBarrier(rate + 1)
+		for i := 0; i < 1000; i++ {
+			key := fmt.Sprintf("user-%d", i)
+			if b.AllowKey(1, key) && !bigger.AllowKey(1, key) {
+				t.Errorf("expect key %s allowed by rate %d", key, rate+1)
+			}
+		}
+	}
+}
+
+func TestAllowKeyRate(t *testing.T) {
+	b := NewRateBarrier(20)
+	n := 10000
+	allowed := 0
+	for i := 0; i < n; i++ {
+		if b.AllowKey(1, fmt.Sprintf("user-%d", i)) {
+			allowed++
+		}
+	}
+
+	if allowed < n*15/100 || allowed > n*25/100 {
+		t.Errorf("expect about 20%% allowed, but %d of %d", allowed, n)
+	}
+}
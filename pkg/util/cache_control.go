@@ -0,0 +1,118 @@
+package util
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CacheControl is the parsed Cache-Control header of RFC 7234, the durations are in seconds,
+// and -1 means the directive is absent.
+type CacheControl struct {
+	NoStore         bool
+	NoCache         bool
+	Private         bool
+	Public          bool
+	MustRevalidate  bool
+	ProxyRevalidate bool
+	OnlyIfCached    bool
+
+	MaxAge               int64
+	SMaxAge              int64
+	MaxStale             int64
+	MinFresh             int64
+	StaleWhileRevalidate int64
+	StaleIfError         int64
+}
+
+// ParseCacheControl parse the Cache-Control header value, the unknown directives and
+// the invalid durations are ignored. The max-stale without value accepts any staleness.
+func ParseCacheControl(value []byte) CacheControl {
+	cc := CacheControl{
+		MaxAge:               -1,
+		SMaxAge:              -1,
+		MaxStale:             -1,
+		MinFresh:             -1,
+		StaleWhileRevalidate: -1,
+		StaleIfError:         -1,
+	}
+
+	for _, directive := range strings.Split(string(value), ",") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" {
+			continue
+		}
+
+		name, arg := directive, ""
+		if idx := strings.IndexByte(directive, '='); idx >= 0 {
+			name, arg = strings.TrimSpace(directive[:idx]), strings.Trim(strings.TrimSpace(directive[idx+1:]), `"`)
+		}
+
+		switch strings.ToLower(name) {
+		case "no-store":
+			cc.NoStore = true
+		case "no-cache":
+			cc.NoCache = true
+		case "private":
+			cc.Private = true
+		case "public":
+			cc.Public = true
+		case "must-revalidate":
+			cc.MustRevalidate = true
+		case "proxy-revalidate":
+			cc.ProxyRevalidate = true
+		case "only-if-cached":
+			cc.OnlyIfCached = true
+		case "max-age":
+			cc.MaxAge = parseDeltaSeconds(arg, cc.MaxAge)
+		case "s-maxage":
+			cc.SMaxAge = parseDeltaSeconds(arg, cc.SMaxAge)
+		case "max-stale":
+			if arg == "" {
+				cc.MaxStale = math.MaxInt32
+			} else {
+				cc.MaxStale = parseDeltaSeconds(arg, cc.MaxStale)
+			}
+		case "min-fresh":
+			cc.MinFresh = parseDeltaSeconds(arg, cc.MinFresh)
+		case "stale-while-revalidate":
+			cc.StaleWhileRevalidate = parseDeltaSeconds(arg, cc.StaleWhileRevalidate)
+		case "stale-if-error":
+			cc.StaleIfError = parseDeltaSeconds(arg, cc.StaleIfError)
+		}
+	}
+
+	return cc
+}
+
+func parseDeltaSeconds(value string, defaultValue int64) int64 {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return defaultValue
+	}
+
+	return n
+}
+
+// MatchETag returns true if the If-None-Match header value matches the etag,
+// the weak comparison is used, and * matches any etag.
+func MatchETag(ifNoneMatch []byte, etag []byte) bool {
+	if len(etag) == 0 {
+		return false
+	}
+
+	etag = bytes.TrimPrefix(etag, []byte("W/"))
+	for _, value := range bytes.Split(ifNoneMatch, []byte{','}) {
+		value = bytes.TrimSpace(value)
+		if len(value) == 1 && value[0] == '*' {
+			return true
+		}
+
+		if bytes.Equal(bytes.TrimPrefix(value, []byte("W/")), etag) {
+			return true
+		}
+	}
+
+	return false
+}
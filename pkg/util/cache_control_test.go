@@ -0,0 +1,58 @@
+package util
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseCacheControl(t *testing.T) {
+	cc := ParseCacheControl([]byte(`public, max-age=60, s-maxage="120", stale-while-revalidate=30, Stale-If-Error=600, must-revalidate`))
+	if !cc.Public || !cc.MustRevalidate || cc.NoStore || cc.NoCache || cc.Private {
+		t.Errorf("expect public and must-revalidate only, but %+v", cc)
+	}
+
+	if cc.MaxAge != 60 || cc.SMaxAge != 120 || cc.StaleWhileRevalidate != 30 || cc.StaleIfError != 600 {
+		t.Errorf("expect durations 60, 120, 30, 600, but %+v", cc)
+	}
+
+	if cc.MaxStale != -1 || cc.MinFresh != -1 {
+		t.Errorf("expect absent max-stale and min-fresh, but %+v", cc)
+	}
+
+	cc = ParseCacheControl([]byte("no-store,no-cache, private, only-if-cached, max-stale, min-fresh=10, max-age=abc"))
+	if !cc.NoStore || !cc.NoCache || !cc.Private || !cc.OnlyIfCached {
+		t.Errorf("expect no-store, no-cache, private and only-if-cached, but %+v", cc)
+	}
+
+	if cc.MaxStale != math.MaxInt32 || cc.MinFresh != 10 || cc.MaxAge != -1 {
+		t.Errorf("expect any max-stale, min-fresh 10 and invalid max-age, but %+v", cc)
+	}
+
+	cc = ParseCacheControl(nil)
+	if cc.MaxAge != -1 || cc.SMaxAge != -1 || cc.NoCache {
+		t.Errorf("expect empty cache control, but %+v", cc)
+	}
+}
+
+func TestMatchETag(t *testing.T) {
+	cases := []struct {
+		ifNoneMatch string
+		etag        string
+		expect      bool
+	}{
+		{`"a"`, `"a"`, true},
+		{`"b", "a"`, `"a"`, true},
+		{`W/"a"`, `"a"`, true},
+		{`"a"`, `W/"a"`, true},
+		{`*`, `"a"`, true},
+		{`"b"`, `"a"`, false},
+		{``, `"a"`, false},
+		{`*`, ``, false},
+	}
+
+	for _, c := range cases {
+		if value := MatchETag([]byte(c.ifNoneMatch), []byte(c.etag)); value != c.expect {
+			t.Errorf("%s with %s: expect %v but %v", c.ifNoneMatch, c.etag, c.expect, value)
+		}
+	}
+}
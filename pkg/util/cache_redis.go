@@ -0,0 +1,193 @@
+package util
+
+import (
+	"strings"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+const (
+	redisScanCount = 100
+	// redisMaxTTL is the max ttl of the values, redis evicts the keys only if the maxmemory
+	// policy is set, so the values without ttl expire after it, and the tag sets expire after
+	// the last added value expires
+	redisMaxTTL = time.Hour * 24
+)
+
+// redisCacheStorage stores the cached values in redis or the redis compatible servers,
+// the keys of a tag are stored in a sorted set scored by the expire time of the values
+type redisCacheStorage struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+func newRedisCacheStorage(addr, prefix string) (CacheStorage, error) {
+	s := &redisCacheStorage{
+		prefix: prefix,
+		pool: &redis.Pool{
+			MaxIdle:     64,
+			IdleTimeout: time.Minute,
+			Dial: func() (redis.Conn, error) {
+				return redis.DialURL(addr,
+					redis.DialConnectTimeout(time.Second*10),
+					redis.DialReadTimeout(time.Second*10),
+					redis.DialWriteTimeout(time.Second*10))
+			},
+		},
+	}
+
+	conn := s.pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("PING")
+	if err != nil {
+		s.pool.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *redisCacheStorage) Get(key string) ([]byte, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	value, err := redis.Bytes(conn.Do("GET", s.valueKey(key)))
+	if err == redis.ErrNil {
+		return nil, nil
+	}
+	return value, err
+}
+
+func (s *redisCacheStorage) Set(key string, value []byte, ttl time.Duration, tags ...string) error {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	if ttl <= 0 || ttl > redisMaxTTL {
+		ttl = redisMaxTTL
+	}
+
+	now := time.Now()
+	conn.Send("SET", s.valueKey(key), value, "PX", int64(ttl/time.Millisecond))
+	for _, tag := range tags {
+		// the expired keys are pruned, and the tag set lives longer than all its keys
+		tagKey := s.tagKey(tag)
+		conn.Send("ZADD", tagKey, unixMilli(now.Add(ttl)), key)
+		conn.Send("ZREMRANGEBYSCORE", tagKey, "-inf", unixMilli(now))
+		conn.Send("PEXPIRE", tagKey, int64(redisMaxTTL/time.Millisecond))
+	}
+
+	_, err := conn.Do("")
+	return err
+}
+
+func (s *redisCacheStorage) Remove(key string) (int, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	return redis.Int(conn.Do("DEL", s.valueKey(key)))
+}
+
+func (s *redisCacheStorage) RemoveByTag(tag string) (int, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	tagKey := s.tagKey(tag)
+	_, err := conn.Do("ZREMRANGEBYSCORE", tagKey, "-inf", unixMilli(time.Now()))
+	if err != nil {
+		return 0, err
+	}
+
+	// the keys are removed in batches, avoid blocking redis by a huge DEL
+	n := 0
+	for {
+		keys, err := redis.Strings(conn.Do("ZRANGE", tagKey, 0, redisScanCount-1))
+		if err != nil {
+			return n, err
+		}
+
+		if len(keys) == 0 {
+			break
+		}
+
+		values := make([]interface{}, 0, len(keys))
+		members := make([]interface{}, 0, len(keys)+1)
+		members = append(members, tagKey)
+		for _, key := range keys {
+			values = append(values, s.valueKey(key))
+			members = append(members, key)
+		}
+
+		removed, err := redis.Int(conn.Do("DEL", values...))
+		if err != nil {
+			return n, err
+		}
+		n += removed
+
+		_, err = conn.Do("ZREM", members...)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	_, err = conn.Do("DEL", tagKey)
+	return n, err
+}
+
+func (s *redisCacheStorage) RemoveByPrefix(prefix string) (int, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	n := 0
+	cursor := "0"
+	pattern := escapeRedisPattern(s.valueKey(prefix)) + "*"
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", pattern, "COUNT", redisScanCount))
+		if err != nil {
+			return n, err
+		}
+
+		var keys []interface{}
+		_, err = redis.Scan(values, &cursor, &keys)
+		if err != nil {
+			return n, err
+		}
+
+		if len(keys) > 0 {
+			removed, err := redis.Int(conn.Do("DEL", keys...))
+			if err != nil {
+				return n, err
+			}
+			n += removed
+		}
+
+		if cursor == "0" {
+			return n, nil
+		}
+	}
+}
+
+func (s *redisCacheStorage) valueKey(key string) string {
+	return s.prefix + "cache:value:" + key
+}
+
+func (s *redisCacheStorage) tagKey(tag string) string {
+	return s.prefix + "cache:tag:" + tag
+}
+
+func unixMilli(value time.Time) int64 {
+	return value.UnixNano() / int64(time.Millisecond)
+}
+
+func escapeRedisPattern(value string) string {
+	var buf strings.Builder
+	for _, c := range value {
+		switch c {
+		case '*', '?', '[', ']', '\\':
+			buf.WriteByte('\\')
+		}
+		buf.WriteRune(c)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,200 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/goetty"
+)
+
+var (
+	supportCacheSchema = map[string]func(string, string) (CacheStorage, error){
+		"redis": newRedisCacheStorage,
+	}
+)
+
+// CacheStorage is the storage of the cached values, the storage shared by the
+// proxies makes the cached values visible to all proxies
+type CacheStorage interface {
+	// Get returns the value of the key, returns nil if the key is not found or expired
+	Get(key string) ([]byte, error)
+	// Set set the value of the key with the tags, the key is kept until it is evicted if the ttl is 0,
+	// the redis storage limits the ttl of the keys to 24 hours
+	Set(key string, value []byte, ttl time.Duration, tags ...string) error
+	// Remove removes the key, returns the count of removed keys
+	Remove(key string) (int, error)
+	// RemoveByTag removes the keys with the tag, returns the count of removed keys
+	RemoveByTag(tag string) (int, error)
+	// RemoveByPrefix removes the keys with the prefix, returns the count of removed keys
+	RemoveByPrefix(prefix string) (int, error)
+}
+
+// CacheStorageID returns the id of the shared cache storage by the addr and the prefix,
+// the proxies with the same id share the cached values. The id is empty if the storage
+// is local, and the password of the addr is not exposed by the id.
+func CacheStorageID(addr, prefix string) string {
+	if addr == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(addr + "\x00" + prefix))
+	return hex.EncodeToString(sum[:8])
+}
+
+// NewCacheStorage returns a cache storage by the addr, the addr is a url, e.g. redis://:pwd@127.0.0.1:6379/0,
+// the local memory storage limited by max bytes is used if the addr is empty. The prefix
+// isolates the keys of the different environments in the shared storage.
+func NewCacheStorage(addr, prefix string, maxBytes uint64, tw *goetty.TimeoutWheel) (CacheStorage, error) {
+	if addr == "" {
+		return NewLocalCacheStorage(maxBytes, tw), nil
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	fn, ok := supportCacheSchema[strings.ToLower(u.Scheme)]
+	if !ok {
+		return nil, fmt.Errorf("not support cache storage: %s", addr)
+	}
+
+	return fn(addr, prefix)
+}
+
+// localCacheStorage stores the cached values in a LRU cache in memory, the value is
+// prefixed with the expire time
+type localCacheStorage struct {
+	sync.Mutex
+
+	tw    *goetty.TimeoutWheel
+	cache *Cache
+	// the keys by the tag, and the tags by the key
+	tags    map[string]map[string]struct{}
+	keyTags map[string][]string
+}
+
+// NewLocalCacheStorage returns a cache storage in memory limited by max bytes
+func NewLocalCacheStorage(maxBytes uint64, tw *goetty.TimeoutWheel) CacheStorage {
+	s := &localCacheStorage{
+		tw:      tw,
+		tags:    make(map[string]map[string]struct{}),
+		keyTags: make(map[string][]string),
+	}
+	s.cache = NewLRUCache(maxBytes, s.onEvicted)
+	return s
+}
+
+func (s *localCacheStorage) Get(key string) ([]byte, error) {
+	buf, ok := s.cache.Get(key)
+	if !ok {
+		return nil, nil
+	}
+
+	data := buf.RawBuf()[buf.GetReaderIndex():buf.GetWriteIndex()]
+	expireAt := goetty.Byte2Int64(data[:8])
+	if expireAt > 0 && time.Now().UnixNano() >= expireAt {
+		s.cache.Remove(key)
+		return nil, nil
+	}
+
+	return data[8:], nil
+}
+
+func (s *localCacheStorage) Set(key string, value []byte, ttl time.Duration, tags ...string) error {
+	var expireAt int64
+	if ttl > 0 {
+		expireAt = time.Now().Add(ttl).UnixNano()
+	}
+
+	buf := goetty.NewByteBuf(len(value) + 8)
+	buf.WriteInt64(expireAt)
+	buf.Write(value)
+	s.cache.Add(key, buf)
+
+	if len(tags) > 0 {
+		s.Lock()
+		s.keyTags[key] = tags
+		for _, tag := range tags {
+			keys, ok := s.tags[tag]
+			if !ok {
+				keys = make(map[string]struct{})
+				s.tags[tag] = keys
+			}
+			keys[key] = struct{}{}
+		}
+		s.Unlock()
+	}
+
+	if ttl > 0 {
+		s.tw.Schedule(ttl, s.removeExpired, key)
+	}
+	return nil
+}
+
+func (s *localCacheStorage) Remove(key string) (int, error) {
+	if _, ok := s.cache.Get(key); !ok {
+		return 0, nil
+	}
+
+	s.cache.Remove(key)
+	return 1, nil
+}
+
+func (s *localCacheStorage) RemoveByTag(tag string) (int, error) {
+	s.Lock()
+	var keys []string
+	for key := range s.tags[tag] {
+		keys = append(keys, key)
+	}
+	s.Unlock()
+
+	n := 0
+	for _, key := range keys {
+		removed, _ := s.Remove(key)
+		n += removed
+	}
+	return n, nil
+}
+
+func (s *localCacheStorage) RemoveByPrefix(prefix string) (int, error) {
+	n := 0
+	for _, key := range s.cache.Keys() {
+		if strings.HasPrefix(key.(string), prefix) {
+			removed, _ := s.Remove(key.(string))
+			n += removed
+		}
+	}
+	return n, nil
+}
+
+// removeExpired removes the key if it is expired, the key may be updated after scheduled
+func (s *localCacheStorage) removeExpired(arg interface{}) {
+	s.Get(arg.(string))
+}
+
+func (s *localCacheStorage) onEvicted(key Key, value *goetty.ByteBuf) {
+	s.Lock()
+	for _, tag := range s.keyTags[key.(string)] {
+		if keys, ok := s.tags[tag]; ok {
+			delete(keys, key.(string))
+			if len(keys) == 0 {
+				delete(s.tags, tag)
+			}
+		}
+	}
+	delete(s.keyTags, key.(string))
+	s.Unlock()
+
+	// the value may be in reading
+	s.tw.Schedule(time.Second*10, s.doReleaseCacheBuf, value)
+}
+
+func (s *localCacheStorage) doReleaseCacheBuf(arg interface{}) {
+	arg.(*goetty.ByteBuf).Release()
+}
@@ -0,0 +1,379 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty"
+)
+
+func testCacheStorage(t *testing.T, s CacheStorage) {
+	s.Set("/users/1", []byte("user1"), 0, "api:1", "users")
+	s.Set("/users/2", []byte("user2"), 0, "api:1")
+	s.Set("/orders/1", []byte("order1"), 0, "api:2", "users")
+	s.Set("/orders/*", []byte("orders"), 0, "api:2")
+
+	value, err := s.Get("/users/1")
+	if err != nil || string(value) != "user1" {
+		t.Fatalf("expect user1, but %s, %+v", value, err)
+	}
+
+	value, err = s.Get("/users/3")
+	if err != nil || value != nil {
+		t.Errorf("expect not found, but %s, %+v", value, err)
+	}
+
+	n, err := s.Remove("/users/2")
+	if err != nil || n != 1 {
+		t.Errorf("expect 1 removed, but %d, %+v", n, err)
+	}
+
+	n, err = s.RemoveByTag("users")
+	if err != nil || n != 2 {
+		t.Errorf("expect 2 removed by tag, but %d, %+v", n, err)
+	}
+
+	if value, _ = s.Get("/orders/1"); value != nil {
+		t.Errorf("expect removed by tag, but %s", value)
+	}
+
+	s.Set("/orders/2", []byte("order2"), 0, "api:2")
+	n, err = s.RemoveByPrefix("/orders/*")
+	if err != nil || n != 1 {
+		t.Errorf("expect 1 removed by prefix with pattern chars, but %d, %+v", n, err)
+	}
+
+	n, err = s.RemoveByPrefix("/orders/")
+	if err != nil || n != 1 {
+		t.Errorf("expect 1 removed by prefix, but %d, %+v", n, err)
+	}
+
+	s.Set("/ttl", []byte("ttl"), time.Millisecond*50)
+	if value, _ = s.Get("/ttl"); string(value) != "ttl" {
+		t.Errorf("expect ttl, but %s", value)
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	if value, _ = s.Get("/ttl"); value != nil {
+		t.Errorf("expect expired, but %s", value)
+	}
+}
+
+func TestLocalCacheStorage(t *testing.T) {
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	defer tw.Stop()
+
+	testCacheStorage(t, NewLocalCacheStorage(0, tw))
+}
+
+func TestLocalCacheStorageEvicted(t *testing.T) {
+	tw := goetty.NewTimeoutWheel(goetty.WithTickInterval(time.Millisecond * 10))
+	defer tw.Stop()
+
+	s := NewLocalCacheStorage(30, tw)
+	s.Set("a", []byte("0123456789"), 0, "tag")
+	s.Set("b", []byte("0123456789"), 0, "tag")
+	if value, _ := s.Get("a"); value != nil {
+		t.Errorf("expect evicted, but %s", value)
+	}
+
+	if n, _ := s.RemoveByTag("tag"); n != 1 {
+		t.Errorf("expect 1 removed, but %d", n)
+	}
+}
+
+func TestRedisCacheStorage(t *testing.T) {
+	server := startRedisStandIn(t)
+	s, err := NewCacheStorage("redis://"+server.addr, "/test/", 0, nil)
+	if err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+
+	testCacheStorage(t, s)
+}
+
+func TestRedisCacheStorageTagExpired(t *testing.T) {
+	server := startRedisStandIn(t)
+	s, err := NewCacheStorage("redis://"+server.addr, "/test/", 0, nil)
+	if err != nil {
+		t.Fatalf("expect no error, but %+v", err)
+	}
+
+	tagKey := "/test/cache:tag:api:1"
+	s.Set("/users/1", []byte("user1"), time.Millisecond*50, "api:1")
+	s.Set("/users/2", []byte("user2"), 0, "api:1")
+	if members, ttl := server.zset(tagKey); members != 2 || ttl != redisMaxTTL {
+		t.Errorf("expect 2 members with ttl %s, but %d with ttl %s", redisMaxTTL, members, ttl)
+	}
+
+	// the value without ttl expires after the max ttl
+	if ttl := server.ttl("/test/cache:value:/users/2"); ttl != redisMaxTTL {
+		t.Errorf("expect value ttl %s, but %s", redisMaxTTL, ttl)
+	}
+
+	// the expired key is pruned by the next set
+	time.Sleep(time.Millisecond * 100)
+	s.Set("/users/3", []byte("user3"), time.Minute, "api:1")
+	if members, _ := server.zset(tagKey); members != 2 {
+		t.Errorf("expect 2 members, but %d", members)
+	}
+
+	for i := 0; i < redisScanCount+10; i++ {
+		s.Set(fmt.Sprintf("/orders/%d", i), []byte("order"), time.Minute, "api:1")
+	}
+
+	n, err := s.RemoveByTag("api:1")
+	if err != nil || n != redisScanCount+12 {
+		t.Errorf("expect %d removed by tag, but %d, %+v", redisScanCount+12, n, err)
+	}
+	if members, _ := server.zset(tagKey); members != 0 {
+		t.Errorf("expect tag removed, but %d members", members)
+	}
+}
+
+func TestNewCacheStorage(t *testing.T) {
+	if _, err := NewCacheStorage("memcached://127.0.0.1:11211", "", 0, nil); err == nil {
+		t.Errorf("expect not support error")
+	}
+}
+
+// redisStandIn is a redis compatible server supports the commands used by the storage
+type redisStandIn struct {
+	sync.Mutex
+
+	addr    string
+	values  map[string][]byte
+	zsets   map[string]map[string]int64
+	expires map[string]time.Time
+}
+
+func startRedisStandIn(t *testing.T) *redisStandIn {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %+v", err)
+	}
+
+	s := &redisStandIn{
+		addr:    ln.Addr().String(),
+		values:  make(map[string][]byte),
+		zsets:   make(map[string]map[string]int64),
+		expires: make(map[string]time.Time),
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			go func(conn net.Conn) {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				w := bufio.NewWriter(conn)
+				for {
+					args, err := readRESPCommand(r)
+					if err != nil {
+						return
+					}
+
+					s.handle(args, w)
+					if r.Buffered() == 0 {
+						w.Flush()
+					}
+				}
+			}(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *redisStandIn) expire(key string) {
+	if at, ok := s.expires[key]; ok && time.Now().After(at) {
+		delete(s.values, key)
+		delete(s.zsets, key)
+		delete(s.expires, key)
+	}
+}
+
+func (s *redisStandIn) get(key string) ([]byte, bool) {
+	s.expire(key)
+	value, ok := s.values[key]
+	return value, ok
+}
+
+func (s *redisStandIn) zset(key string) (int, time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.expire(key)
+	var ttl time.Duration
+	if at, ok := s.expires[key]; ok {
+		ttl = time.Until(at).Round(time.Second)
+	}
+	return len(s.zsets[key]), ttl
+}
+
+func (s *redisStandIn) ttl(key string) time.Duration {
+	s.Lock()
+	defer s.Unlock()
+
+	s.expire(key)
+	return time.Until(s.expires[key]).Round(time.Second)
+}
+
+func (s *redisStandIn) handle(args []string, w *bufio.Writer) {
+	s.Lock()
+	defer s.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		w.WriteString("+PONG\r\n")
+	case "GET":
+		if value, ok := s.get(args[1]); ok {
+			fmt.Fprintf(w, "$%d\r\n%s\r\n", len(value), value)
+		} else {
+			w.WriteString("$-1\r\n")
+		}
+	case "SET":
+		s.values[args[1]] = []byte(args[2])
+		delete(s.expires, args[1])
+		if len(args) == 5 {
+			ms, _ := strconv.Atoi(args[4])
+			s.expires[args[1]] = time.Now().Add(time.Millisecond * time.Duration(ms))
+		}
+		w.WriteString("+OK\r\n")
+	case "PEXPIRE":
+		s.expire(args[1])
+		_, isValue := s.values[args[1]]
+		_, isSet := s.zsets[args[1]]
+		if !isValue && !isSet {
+			w.WriteString(":0\r\n")
+			return
+		}
+
+		ms, _ := strconv.Atoi(args[2])
+		s.expires[args[1]] = time.Now().Add(time.Millisecond * time.Duration(ms))
+		w.WriteString(":1\r\n")
+	case "DEL":
+		n := 0
+		for _, key := range args[1:] {
+			s.expire(key)
+			if _, ok := s.values[key]; ok {
+				delete(s.values, key)
+				n++
+			} else if _, ok := s.zsets[key]; ok {
+				delete(s.zsets, key)
+				n++
+			}
+			delete(s.expires, key)
+		}
+		fmt.Fprintf(w, ":%d\r\n", n)
+	case "ZADD":
+		s.expire(args[1])
+		if _, ok := s.zsets[args[1]]; !ok {
+			s.zsets[args[1]] = make(map[string]int64)
+		}
+		score, _ := strconv.ParseInt(args[2], 10, 64)
+		s.zsets[args[1]][args[3]] = score
+		w.WriteString(":1\r\n")
+	case "ZREMRANGEBYSCORE":
+		// only the -inf min is supported
+		s.expire(args[1])
+		max, _ := strconv.ParseInt(args[3], 10, 64)
+		n := 0
+		for member, score := range s.zsets[args[1]] {
+			if score <= max {
+				delete(s.zsets[args[1]], member)
+				n++
+			}
+		}
+		fmt.Fprintf(w, ":%d\r\n", n)
+	case "ZRANGE":
+		s.expire(args[1])
+		var members []string
+		for member := range s.zsets[args[1]] {
+			members = append(members, member)
+		}
+		sort.Slice(members, func(i, j int) bool {
+			a, b := s.zsets[args[1]][members[i]], s.zsets[args[1]][members[j]]
+			return a < b || (a == b && members[i] < members[j])
+		})
+
+		start, _ := strconv.Atoi(args[2])
+		stop, _ := strconv.Atoi(args[3])
+		if stop >= len(members) {
+			stop = len(members) - 1
+		}
+		if start > stop {
+			members = nil
+		} else {
+			members = members[start : stop+1]
+		}
+
+		fmt.Fprintf(w, "*%d\r\n", len(members))
+		for _, member := range members {
+			fmt.Fprintf(w, "$%d\r\n%s\r\n", len(member), member)
+		}
+	case "ZREM":
+		s.expire(args[1])
+		n := 0
+		for _, member := range args[2:] {
+			if _, ok := s.zsets[args[1]][member]; ok {
+				delete(s.zsets[args[1]], member)
+				n++
+			}
+		}
+		fmt.Fprintf(w, ":%d\r\n", n)
+	case "SCAN":
+		// only the prefix pattern is supported
+		pattern := args[3]
+		prefix := strings.NewReplacer(`\*`, "*", `\?`, "?", `\[`, "[", `\]`, "]", `\\`, `\`).Replace(pattern[:len(pattern)-1])
+		var keys []string
+		for key := range s.values {
+			if _, ok := s.get(key); ok && strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+		}
+		fmt.Fprintf(w, "*2\r\n$1\r\n0\r\n*%d\r\n", len(keys))
+		for _, key := range keys {
+			fmt.Fprintf(w, "$%d\r\n%s\r\n", len(key), key)
+		}
+	default:
+		fmt.Fprintf(w, "-ERR unknown command %s\r\n", args[0])
+	}
+}
+
+func readRESPCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	n, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+	args := make([]string, n)
+	for i := 0; i < n; i++ {
+		line, err = r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		size, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		value := make([]byte, size+2)
+		if _, err = io.ReadFull(r, value); err != nil {
+			return nil, err
+		}
+		args[i] = string(value[:size])
+	}
+
+	return args, nil
+}
@@ -0,0 +1,182 @@
+package util
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+const (
+	// EncodingGzip gzip content encoding
+	EncodingGzip = "gzip"
+	// EncodingDeflate deflate content encoding
+	EncodingDeflate = "deflate"
+	// EncodingBrotli brotli content encoding
+	EncodingBrotli = "br"
+	// EncodingIdentity identity content encoding
+	EncodingIdentity = "identity"
+)
+
+var (
+	// ErrBodyTooLarge the decompressed body exceeds the limit
+	ErrBodyTooLarge = errors.New("decompressed body too large")
+
+	gzipWriterPool   sync.Pool
+	zlibWriterPool   sync.Pool
+	brotliWriterPool sync.Pool
+)
+
+// Decompress decompress the data by the content encoding, the encodings applied
+// in order are decompressed in reverse order. ErrBodyTooLarge is returned if the
+// decompressed size exceeds the limit, and there is no limit if the limit is <= 0.
+func Decompress(encoding string, data []byte, limit int) ([]byte, error) {
+	encodings := strings.Split(encoding, ",")
+	for i := len(encodings) - 1; i >= 0; i-- {
+		var r io.Reader
+		src := bytes.NewReader(data)
+		switch value := strings.ToLower(strings.TrimSpace(encodings[i])); value {
+		case EncodingGzip:
+			gr, err := gzip.NewReader(src)
+			if err != nil {
+				return nil, err
+			}
+			r = gr
+		case EncodingDeflate:
+			// the deflate content encoding is the zlib format, some servers send
+			// the raw deflate data
+			zr, err := zlib.NewReader(src)
+			if err == zlib.ErrHeader {
+				r = flate.NewReader(bytes.NewReader(data))
+			} else if err != nil {
+				return nil, err
+			} else {
+				r = zr
+			}
+		case EncodingBrotli:
+			r = brotli.NewReader(src)
+		case EncodingIdentity, "":
+			continue
+		default:
+			return nil, fmt.Errorf("not support content encoding %s", value)
+		}
+
+		if limit > 0 {
+			r = io.LimitReader(r, int64(limit)+1)
+		}
+
+		value, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if limit > 0 && len(value) > limit {
+			return nil, ErrBodyTooLarge
+		}
+		data = value
+	}
+
+	return data, nil
+}
+
+// Compress compress the data by the gzip, deflate or brotli content encoding
+func Compress(encoding string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case EncodingGzip:
+		gw, ok := gzipWriterPool.Get().(*gzip.Writer)
+		if !ok {
+			gw = gzip.NewWriter(&buf)
+		} else {
+			gw.Reset(&buf)
+		}
+		defer gzipWriterPool.Put(gw)
+		w = gw
+	case EncodingDeflate:
+		zw, ok := zlibWriterPool.Get().(*zlib.Writer)
+		if !ok {
+			zw = zlib.NewWriter(&buf)
+		} else {
+			zw.Reset(&buf)
+		}
+		defer zlibWriterPool.Put(zw)
+		w = zw
+	case EncodingBrotli:
+		bw, ok := brotliWriterPool.Get().(*brotli.Writer)
+		if !ok {
+			bw = brotli.NewWriterLevel(&buf, 4)
+		} else {
+			bw.Reset(&buf)
+		}
+		defer brotliWriterPool.Put(bw)
+		w = bw
+	default:
+		return nil, fmt.Errorf("not support content encoding %s", encoding)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// NegotiateEncoding returns the content encoding accepted by the Accept-Encoding
+// with the highest quality, the candidates are in order of preference if the
+// qualities are equal, empty is returned if none of the candidates is accepted.
+func NegotiateEncoding(acceptEncoding []byte, candidates ...string) string {
+	qualities := make(map[string]float64)
+	wildcard := -1.0
+	for _, value := range strings.Split(string(acceptEncoding), ",") {
+		fields := strings.Split(value, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+
+		if name == "*" {
+			wildcard = q
+		} else {
+			qualities[name] = q
+		}
+	}
+
+	target := ""
+	max := 0.0
+	for _, candidate := range candidates {
+		q, ok := qualities[candidate]
+		if !ok {
+			q = wildcard
+		}
+
+		if q > max {
+			target, max = candidate, q
+		}
+	}
+
+	return target
+}
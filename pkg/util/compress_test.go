@@ -0,0 +1,112 @@
+package util
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"testing"
+)
+
+func TestCompress(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"name":"zhangsan","age":18}`), 100)
+	for _, encoding := range []string{EncodingGzip, EncodingDeflate, EncodingBrotli} {
+		compressed, err := Compress(encoding, data)
+		if err != nil {
+			t.Fatalf("%s: expect no error: %+v", encoding, err)
+		}
+
+		if len(compressed) >= len(data) {
+			t.Errorf("%s: expect compressed, %d >= %d", encoding, len(compressed), len(data))
+		}
+
+		value, err := Decompress(encoding, compressed, 0)
+		if err != nil {
+			t.Fatalf("%s: expect no error: %+v", encoding, err)
+		}
+
+		if !bytes.Equal(value, data) {
+			t.Errorf("%s: expect %s but %s", encoding, data, value)
+		}
+
+		_, err = Decompress(encoding, compressed, len(data)-1)
+		if err != ErrBodyTooLarge {
+			t.Errorf("%s: expect body too large error, but %+v", encoding, err)
+		}
+	}
+
+	_, err := Compress("compress", data)
+	if err == nil {
+		t.Errorf("expect error for not supported encoding")
+	}
+}
+
+func TestDecompressDeflate(t *testing.T) {
+	data := []byte("hello")
+
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	zw.Write(data)
+	zw.Close()
+	value, err := Decompress(EncodingDeflate, buf.Bytes(), 0)
+	if err != nil || !bytes.Equal(value, data) {
+		t.Errorf("expect zlib %s but %s, %+v", data, value, err)
+	}
+
+	// the raw deflate data without the zlib header
+	buf.Reset()
+	fw, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+	fw.Write(data)
+	fw.Close()
+	value, err = Decompress(EncodingDeflate, buf.Bytes(), 0)
+	if err != nil || !bytes.Equal(value, data) {
+		t.Errorf("expect raw deflate %s but %s, %+v", data, value, err)
+	}
+
+	compressed, _ := Compress(EncodingDeflate, data)
+	if _, err := zlib.NewReader(bytes.NewReader(compressed)); err != nil {
+		t.Errorf("expect zlib format, but %+v", err)
+	}
+}
+
+func TestDecompressMultiEncodings(t *testing.T) {
+	data := []byte("hello")
+	gzipped, _ := Compress(EncodingGzip, data)
+	compressed, _ := Compress(EncodingBrotli, gzipped)
+
+	value, err := Decompress("gzip, br", compressed, 0)
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	if !bytes.Equal(value, data) {
+		t.Errorf("expect %s but %s", data, value)
+	}
+
+	_, err = Decompress("gzip", data, 0)
+	if err == nil {
+		t.Errorf("expect error for invalid gzip body")
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	cases := []struct {
+		accept string
+		expect string
+	}{
+		{"", ""},
+		{"gzip", EncodingGzip},
+		{"gzip, deflate, br", EncodingBrotli},
+		{"br;q=0.5, gzip", EncodingGzip},
+		{"br;q=0, gzip;q=0", ""},
+		{"identity", ""},
+		{"*", EncodingBrotli},
+		{"*;q=0.1, gzip;q=0.5", EncodingGzip},
+		{"BR", EncodingBrotli},
+	}
+
+	for _, c := range cases {
+		if value := NegotiateEncoding([]byte(c.accept), EncodingBrotli, EncodingGzip); value != c.expect {
+			t.Errorf("%s: expect %s but %s", c.accept, c.expect, value)
+		}
+	}
+}
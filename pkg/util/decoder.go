@@ -0,0 +1,477 @@
+package util
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// XMLToJSON convert the xml document into a json object, the attributes are
+// prefixed with @, the text of a element with attributes or children is #text,
+// and the repeated elements are converted into an array
+func XMLToJSON(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*xmlNode
+	var root *xmlNode
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: t.Name.Local, attrs: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else if root == nil {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("missing xml root element")
+	}
+
+	return marshalJSON(map[string]interface{}{root.name: root.value()})
+}
+
+type xmlNode struct {
+	name     string
+	attrs    []xml.Attr
+	children []*xmlNode
+	text     bytes.Buffer
+}
+
+func (n *xmlNode) value() interface{} {
+	text := strings.TrimSpace(n.text.String())
+	if len(n.attrs) == 0 && len(n.children) == 0 {
+		return text
+	}
+
+	values := make(map[string]interface{}, len(n.attrs)+len(n.children)+1)
+	for _, attr := range n.attrs {
+		values["@"+attr.Name.Local] = attr.Value
+	}
+
+	for _, child := range n.children {
+		addJSONValue(values, child.name, child.value())
+	}
+
+	if text != "" {
+		values["#text"] = text
+	}
+
+	return values
+}
+
+// FormToJSON convert the form urlencoded body into a json object, the repeated
+// keys are converted into an array
+func FormToJSON(data []byte) ([]byte, error) {
+	form, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(form))
+	for key, value := range form {
+		if len(value) == 1 {
+			values[key] = value[0]
+		} else {
+			values[key] = value
+		}
+	}
+
+	return marshalJSON(values)
+}
+
+// TextToJSON convert the text into a json string
+func TextToJSON(data []byte) ([]byte, error) {
+	return marshalJSON(string(data))
+}
+
+// marshalJSON returns the json without escaping the html characters
+func marshalJSON(value interface{}) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// the encoder appends a newline
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
+
+func addJSONValue(values map[string]interface{}, key string, value interface{}) {
+	prev, ok := values[key]
+	if !ok {
+		values[key] = value
+		return
+	}
+
+	if arr, ok := prev.([]interface{}); ok {
+		values[key] = append(arr, value)
+		return
+	}
+
+	values[key] = []interface{}{prev, value}
+}
+
+// ProtobufDecoder convert the protobuf message into json by the descriptor
+type ProtobufDecoder struct {
+	messages map[string]*protoMessage
+	enums    map[string]map[int32]string
+	root     *protoMessage
+}
+
+type protoMessage struct {
+	fields   map[int32]*descriptor.FieldDescriptorProto
+	mapEntry bool
+}
+
+// NewProtobufDecoder returns a protobuf decoder, the descriptorSet is a
+// serialized FileDescriptorSet, and the messageType is the full name of the
+// message, e.g. pkg.Message
+func NewProtobufDecoder(descriptorSet []byte, messageType string) (*ProtobufDecoder, error) {
+	set := &descriptor.FileDescriptorSet{}
+	err := proto.Unmarshal(descriptorSet, set)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &ProtobufDecoder{
+		messages: make(map[string]*protoMessage),
+		enums:    make(map[string]map[int32]string),
+	}
+	for _, file := range set.File {
+		prefix := ""
+		if file.GetPackage() != "" {
+			prefix = "." + file.GetPackage()
+		}
+
+		d.addEnums(prefix, file.EnumType)
+		d.addMessages(prefix, file.MessageType)
+	}
+
+	root, ok := d.messages["."+strings.TrimPrefix(messageType, ".")]
+	if !ok {
+		return nil, fmt.Errorf("missing message type %s in descriptor", messageType)
+	}
+	d.root = root
+
+	return d, nil
+}
+
+func (d *ProtobufDecoder) addMessages(prefix string, messages []*descriptor.DescriptorProto) {
+	for _, msg := range messages {
+		name := prefix + "." + msg.GetName()
+		m := &protoMessage{
+			fields:   make(map[int32]*descriptor.FieldDescriptorProto, len(msg.Field)),
+			mapEntry: msg.GetOptions().GetMapEntry(),
+		}
+		for _, field := range msg.Field {
+			m.fields[field.GetNumber()] = field
+		}
+
+		d.messages[name] = m
+		d.addEnums(name, msg.EnumType)
+		d.addMessages(name, msg.NestedType)
+	}
+}
+
+func (d *ProtobufDecoder) addEnums(prefix string, enums []*descriptor.EnumDescriptorProto) {
+	for _, enum := range enums {
+		values := make(map[int32]string, len(enum.Value))
+		for _, value := range enum.Value {
+			values[value.GetNumber()] = value.GetName()
+		}
+		d.enums[prefix+"."+enum.GetName()] = values
+	}
+}
+
+// Decode convert the protobuf message into a json object
+func (d *ProtobufDecoder) Decode(data []byte) ([]byte, error) {
+	value, err := d.decodeMessage(d.root, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalJSON(value)
+}
+
+func (d *ProtobufDecoder) decodeMessage(msg *protoMessage, data []byte) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid protobuf field key")
+		}
+		data = data[n:]
+
+		number := int32(key >> 3)
+		wireType := key & 7
+		raw, size, err := readProtoValue(data, wireType)
+		if err != nil {
+			return nil, err
+		}
+		data = data[size:]
+
+		field, ok := msg.fields[number]
+		if !ok {
+			continue
+		}
+
+		var items []interface{}
+		if wireType == 2 && isPackable(field.GetType()) {
+			for len(raw) > 0 {
+				value, size, err := readProtoValue(raw, packedWireType(field.GetType()))
+				if err != nil {
+					return nil, err
+				}
+				raw = raw[size:]
+
+				item, err := d.decodeValue(field, value)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+		} else {
+			item, err := d.decodeValue(field, raw)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+
+		name := jsonName(field)
+		if field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			values[name] = items[len(items)-1]
+			continue
+		}
+
+		if entry, ok := d.messages[field.GetTypeName()]; ok && entry.mapEntry {
+			m, _ := values[name].(map[string]interface{})
+			if m == nil {
+				m = make(map[string]interface{})
+				values[name] = m
+			}
+			for _, item := range items {
+				kv := item.(map[string]interface{})
+				m[mapKey(entry, kv["key"])] = kv["value"]
+			}
+			continue
+		}
+
+		arr, _ := values[name].([]interface{})
+		values[name] = append(arr, items...)
+	}
+
+	return values, nil
+}
+
+// decodeValue decode the value of the field, the 64 bits integers are
+// strings as the protobuf json mapping
+func (d *ProtobufDecoder) decodeValue(field *descriptor.FieldDescriptorProto, raw []byte) (interface{}, error) {
+	switch packedWireType(field.GetType()) {
+	case 1:
+		if len(raw) != 8 {
+			return nil, fmt.Errorf("invalid protobuf value of field %s", field.GetName())
+		}
+	case 5:
+		if len(raw) != 4 {
+			return nil, fmt.Errorf("invalid protobuf value of field %s", field.GetName())
+		}
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return string(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return base64.StdEncoding.EncodeToString(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		msg, ok := d.messages[field.GetTypeName()]
+		if !ok {
+			return nil, fmt.Errorf("missing message type %s in descriptor", field.GetTypeName())
+		}
+		return d.decodeMessage(msg, raw)
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return nil, fmt.Errorf("not support protobuf group")
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return jsonFloat(math.Float64frombits(binary.LittleEndian.Uint64(raw))), nil
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return jsonFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(raw)))), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return binary.LittleEndian.Uint32(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return int32(binary.LittleEndian.Uint32(raw)), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return strconv.FormatUint(binary.LittleEndian.Uint64(raw), 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return strconv.FormatInt(int64(binary.LittleEndian.Uint64(raw)), 10), nil
+	}
+
+	v, n := binary.Uvarint(raw)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid protobuf varint")
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return v != 0, nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		return int32(v), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32:
+		return uint32(v), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		return int32(v>>1) ^ -int32(v&1), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		return strconv.FormatInt(int64(v), 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		return strconv.FormatUint(v, 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return strconv.FormatInt(int64(v>>1)^-int64(v&1), 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if name, ok := d.enums[field.GetTypeName()][int32(v)]; ok {
+			return name, nil
+		}
+		return int32(v), nil
+	}
+
+	return nil, fmt.Errorf("not support protobuf type %s", field.GetType())
+}
+
+// readProtoValue returns the value and the size of the value with the wire type
+func readProtoValue(data []byte, wireType uint64) ([]byte, int, error) {
+	switch wireType {
+	case 0:
+		_, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, 0, fmt.Errorf("invalid protobuf varint")
+		}
+		return data[:n], n, nil
+	case 1:
+		if len(data) < 8 {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		return data[:8], 8, nil
+	case 2:
+		size, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < size {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		return data[n : n+int(size)], n + int(size), nil
+	case 5:
+		if len(data) < 4 {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		return data[:4], 4, nil
+	}
+
+	return nil, 0, fmt.Errorf("not support protobuf wire type %d", wireType)
+}
+
+// mapKey returns the key of the map entry, the default key is omitted in the entry
+func mapKey(entry *protoMessage, key interface{}) string {
+	if key != nil {
+		return fmt.Sprintf("%v", key)
+	}
+
+	switch entry.fields[1].GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return ""
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "false"
+	}
+
+	return "0"
+}
+
+func isPackable(t descriptor.FieldDescriptorProto_Type) bool {
+	switch t {
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return false
+	}
+
+	return true
+}
+
+func packedWireType(t descriptor.FieldDescriptorProto_Type) uint64 {
+	switch t {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return 1
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return 5
+	}
+
+	return 0
+}
+
+func jsonName(field *descriptor.FieldDescriptorProto) string {
+	if field.GetJsonName() != "" {
+		return field.GetJsonName()
+	}
+
+	// lower camel case as protoc
+	var buf bytes.Buffer
+	upper := false
+	for _, c := range field.GetName() {
+		if c == '_' {
+			upper = true
+			continue
+		}
+
+		if upper && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		buf.WriteRune(c)
+	}
+
+	return buf.String()
+}
+
+func jsonFloat(value float64) interface{} {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	}
+
+	return value
+}
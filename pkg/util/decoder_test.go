@@ -0,0 +1,130 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+func TestXMLToJSON(t *testing.T) {
+	value, err := XMLToJSON([]byte(`<?xml version="1.0"?><user id="1"><name>zhangsan</name><tag>a</tag><tag>b</tag></user>`))
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	expect := `{"user":{"@id":"1","name":"zhangsan","tag":["a","b"]}}`
+	if string(value) != expect {
+		t.Errorf("expect %s but %s", expect, value)
+	}
+
+	_, err = XMLToJSON([]byte(`<user>`))
+	if err == nil {
+		t.Errorf("expect error for invalid xml")
+	}
+}
+
+func TestFormToJSON(t *testing.T) {
+	value, err := FormToJSON([]byte("a=1&b=2&b=3"))
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	expect := `{"a":"1","b":["2","3"]}`
+	if string(value) != expect {
+		t.Errorf("expect %s but %s", expect, value)
+	}
+}
+
+func TestTextToJSON(t *testing.T) {
+	value, err := TextToJSON([]byte("<a> \"b\""))
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	expect := `"<a> \"b\""`
+	if string(value) != expect {
+		t.Errorf("expect %s but %s", expect, value)
+	}
+}
+
+func TestProtobufDecoder(t *testing.T) {
+	optional := descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	repeated := descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	set := &descriptor.FileDescriptorSet{
+		File: []*descriptor.FileDescriptorProto{
+			{
+				Name:    proto.String("user.proto"),
+				Package: proto.String("test"),
+				EnumType: []*descriptor.EnumDescriptorProto{
+					{
+						Name: proto.String("Status"),
+						Value: []*descriptor.EnumValueDescriptorProto{
+							{Name: proto.String("Down"), Number: proto.Int32(0)},
+							{Name: proto.String("Up"), Number: proto.Int32(1)},
+						},
+					},
+				},
+				MessageType: []*descriptor.DescriptorProto{
+					{
+						Name: proto.String("User"),
+						Field: []*descriptor.FieldDescriptorProto{
+							{Name: proto.String("user_id"), Number: proto.Int32(1), Label: optional, Type: descriptor.FieldDescriptorProto_TYPE_INT64.Enum()},
+							{Name: proto.String("name"), Number: proto.Int32(2), Label: optional, Type: descriptor.FieldDescriptorProto_TYPE_STRING.Enum()},
+							{Name: proto.String("status"), Number: proto.Int32(3), Label: optional, Type: descriptor.FieldDescriptorProto_TYPE_ENUM.Enum(), TypeName: proto.String(".test.Status")},
+							{Name: proto.String("scores"), Number: proto.Int32(4), Label: repeated, Type: descriptor.FieldDescriptorProto_TYPE_INT32.Enum()},
+							{Name: proto.String("address"), Number: proto.Int32(5), Label: optional, Type: descriptor.FieldDescriptorProto_TYPE_MESSAGE.Enum(), TypeName: proto.String(".test.User.Address")},
+						},
+						NestedType: []*descriptor.DescriptorProto{
+							{
+								Name: proto.String("Address"),
+								Field: []*descriptor.FieldDescriptorProto{
+									{Name: proto.String("city"), Number: proto.Int32(1), Label: optional, Type: descriptor.FieldDescriptorProto_TYPE_STRING.Enum()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	data, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	_, err = NewProtobufDecoder(data, "test.Unknown")
+	if err == nil {
+		t.Errorf("expect error for unknown message type")
+	}
+
+	d, err := NewProtobufDecoder(data, "test.User")
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	msg := []byte{
+		0x08, 0x96, 0x01, // user_id = 150
+		0x12, 0x02, 'z', 's', // name = "zs"
+		0x18, 0x01, // status = Up
+		0x22, 0x02, 0x01, 0x02, // scores = [1, 2] packed
+		0x20, 0x03, // scores = 3 unpacked
+		0x2a, 0x04, 0x0a, 0x02, 'b', 'j', // address.city = "bj"
+		0x30, 0x01, // unknown field
+	}
+	value, err := d.Decode(msg)
+	if err != nil {
+		t.Fatalf("expect no error: %+v", err)
+	}
+
+	expect := `{"address":{"city":"bj"},"name":"zs","scores":[1,2,3],"status":"Up","userId":"150"}`
+	if string(value) != expect {
+		t.Errorf("expect %s but %s", expect, value)
+	}
+
+	_, err = d.Decode([]byte{0x12, 0x05, 'z'})
+	if err == nil {
+		t.Errorf("expect error for truncated message")
+	}
+}
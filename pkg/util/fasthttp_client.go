@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"io"
 	"net"
+	"net/http/httputil"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -223,11 +224,131 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 		panic("BUG: resp cannot be nil")
 	}
 
-	opt := option
-	if opt == nil {
-		opt = c.defaultOption
+	opt := c.option(option)
+	hc := c.getHostClients(addr, opt)
+
+	// Free up resources occupied by response before sending the request,
+	// so the GC may reclaim these resources (e.g. response body).
+	resp.Reset()
+
+	cc, resetConnection, err := c.writeRequest(hc, req, addr, opt)
+	if err != nil {
+		return err
+	}
+
+	if !req.Header.IsGet() && req.Header.IsHead() {
+		resp.SkipBody = true
+	}
+
+	if opt.DisableHeaderNamesNormalizing {
+		resp.Header.DisableNormalizing()
+	}
+
+	br := c.acquireReader(cc.c, opt)
+	if err = resp.ReadLimitBody(br, opt.MaxResponseBodySize); err != nil {
+		c.releaseReader(br)
+		hc.closeConn(cc)
+		if err == io.EOF {
+			return err
+		}
+		return err
+	}
+	c.releaseReader(br)
+
+	if resetConnection || req.ConnectionClose() || resp.ConnectionClose() {
+		hc.closeConn(cc)
+	} else {
+		hc.releaseConn(cc)
+	}
+
+	return err
+}
+
+// DoStream do a http request, but only read the response header. The response body
+// is returned as a BodyStream which must be closed by the caller, the connection is
+// held by the stream until closed.
+func (c *FastHTTPClient) DoStream(req *fasthttp.Request, addr string, option *HTTPOption) (*fasthttp.Response, *BodyStream, error) {
+	if req == nil {
+		panic("BUG: req cannot be nil")
+	}
+
+	opt := c.option(option)
+	hc := c.getHostClients(addr, opt)
+
+	resp := fasthttp.AcquireResponse()
+	cc, resetConnection, err := c.writeRequest(hc, req, addr, opt)
+	if err != nil {
+		return resp, nil, err
+	}
+
+	if opt.DisableHeaderNamesNormalizing {
+		resp.Header.DisableNormalizing()
+	}
+
+	br := c.acquireReader(cc.c, opt)
+	if err = resp.Header.Read(br); err != nil {
+		c.releaseReader(br)
+		hc.closeConn(cc)
+		return resp, nil, err
 	}
 
+	stream := &BodyStream{
+		client:  c,
+		hc:      hc,
+		cc:      cc,
+		br:      br,
+		timeout: opt.ReadTimeout,
+		size:    resp.Header.ContentLength(),
+		reuse:   !resetConnection && !req.ConnectionClose() && !resp.ConnectionClose(),
+	}
+
+	code := resp.StatusCode()
+	if req.Header.IsHead() ||
+		code < fasthttp.StatusOK ||
+		code == fasthttp.StatusNoContent ||
+		code == fasthttp.StatusNotModified {
+		stream.size = 0
+	}
+
+	switch {
+	case stream.size >= 0:
+		stream.r = io.LimitReader(br, int64(stream.size))
+	case stream.size == -1:
+		stream.chunked = true
+		stream.r = httputil.NewChunkedReader(br)
+	default:
+		// identity body, read until the connection is closed
+		stream.size = -1
+		stream.reuse = false
+		stream.r = br
+	}
+
+	return resp, stream, nil
+}
+
+// DoStreamIf do a http request, if the streaming func returns true with the response header,
+// the response body is returned as a BodyStream like DoStream, otherwise the body is read into
+// the response like Do.
+func (c *FastHTTPClient) DoStreamIf(req *fasthttp.Request, addr string, option *HTTPOption, streaming func(*fasthttp.ResponseHeader) bool) (*fasthttp.Response, *BodyStream, error) {
+	resp, stream, err := c.DoStream(req, addr, option)
+	if err != nil || streaming(&resp.Header) {
+		return resp, stream, err
+	}
+
+	err = stream.readTo(resp, c.option(option).MaxResponseBodySize)
+	stream.Close()
+	return resp, nil, err
+}
+
+func (c *FastHTTPClient) option(option *HTTPOption) *HTTPOption {
+	if option == nil {
+		return c.defaultOption
+	}
+
+	return option
+}
+
+func (c *FastHTTPClient) getHostClients(addr string, opt *HTTPOption) *hostClients {
 	var hc *hostClients
 	var ok bool
 	c.Lock()
@@ -238,14 +359,13 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 	c.Unlock()
 
 	atomic.StoreUint32(&hc.lastUseTime, uint32(time.Now().Unix()-startTimeUnix))
+	return hc
+}
 
-	// Free up resources occupied by response before sending the request,
-	// so the GC may reclaim these resources (e.g. response body).
-	resp.Reset()
-
+func (c *FastHTTPClient) writeRequest(hc *hostClients, req *fasthttp.Request, addr string, opt *HTTPOption) (*clientConn, bool, error) {
 	cc, err := hc.acquireConn(addr)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
 	conn := cc.c
 
@@ -257,7 +377,7 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 		currentTime := time.Now()
 		if err = conn.SetWriteDeadline(currentTime.Add(opt.WriteTimeout)); err != nil {
 			hc.closeConn(cc)
-			return err
+			return nil, false, err
 		}
 		cc.lastWriteDeadlineTime = currentTime
 	}
@@ -281,7 +401,7 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 	if err != nil {
 		c.releaseWriter(bw)
 		hc.closeConn(cc)
-		return err
+		return nil, false, err
 	}
 	c.releaseWriter(bw)
 
@@ -293,37 +413,12 @@ func (c *FastHTTPClient) doNonNilReqResp(req *fasthttp.Request, resp *fasthttp.R
 		currentTime := time.Now()
 		if err = conn.SetReadDeadline(currentTime.Add(opt.ReadTimeout)); err != nil {
 			hc.closeConn(cc)
-			return err
+			return nil, false, err
 		}
 		cc.lastReadDeadlineTime = currentTime
 	}
 
-	if !req.Header.IsGet() && req.Header.IsHead() {
-		resp.SkipBody = true
-	}
-
-	if opt.DisableHeaderNamesNormalizing {
-		resp.Header.DisableNormalizing()
-	}
-
-	br := c.acquireReader(conn, opt)
-	if err = resp.ReadLimitBody(br, opt.MaxResponseBodySize); err != nil {
-		c.releaseReader(br)
-		hc.closeConn(cc)
-		if err == io.EOF {
-			return err
-		}
-		return err
-	}
-	c.releaseReader(br)
-
-	if resetConnection || req.ConnectionClose() || resp.ConnectionClose() {
-		hc.closeConn(cc)
-	} else {
-		hc.releaseConn(cc)
-	}
-
-	return err
+	return cc, resetConnection, nil
 }
 
 func dialAddr(addr string) (net.Conn, error) {
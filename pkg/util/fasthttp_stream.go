@@ -0,0 +1,130 @@
+package util
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+var copyBufPool = sync.Pool{
+	New: func() interface{} {
+		return make([]byte, 4096)
+	},
+}
+
+// BodyStream is a response body which is read from the backend connection on demand.
+// It always holds the backend connection, the connection is reused if the body is read
+// completely, otherwise it will be closed.
+type BodyStream struct {
+	client *FastHTTPClient
+	hc     *hostClients
+	cc     *clientConn
+	br     *bufio.Reader
+	r      io.Reader
+
+	timeout time.Duration
+	size    int
+	chunked bool
+	reuse   bool
+	eof     bool
+	closed  bool
+}
+
+// Size returns the size of the body, -1 means the size is unknown,
+// and the body should be written using chunked transfer encoding
+func (s *BodyStream) Size() int {
+	return s.size
+}
+
+// SetTimeout set the idle timeout of the stream, 0 means no timeout
+func (s *BodyStream) SetTimeout(timeout time.Duration) {
+	s.timeout = timeout
+	s.cc.lastReadDeadlineTime = time.Time{}
+	if timeout <= 0 {
+		s.cc.c.SetReadDeadline(time.Time{})
+	}
+}
+
+// Read reads the body from the backend connection, the read deadline is
+// updated before every read, so the read timeout is the idle timeout of the stream
+func (s *BodyStream) Read(p []byte) (int, error) {
+	if s.closed || s.eof {
+		return 0, io.EOF
+	}
+
+	if s.timeout > 0 {
+		currentTime := time.Now()
+		if currentTime.Sub(s.cc.lastReadDeadlineTime) > (s.timeout >> 2) {
+			if err := s.cc.c.SetReadDeadline(currentTime.Add(s.timeout)); err != nil {
+				return 0, err
+			}
+			s.cc.lastReadDeadlineTime = currentTime
+		}
+	}
+
+	n, err := s.r.Read(p)
+	if err == io.EOF {
+		s.eof = true
+		if s.chunked && s.reuse {
+			s.reuse = s.skipTrailer() == nil
+		}
+	}
+
+	return n, err
+}
+
+// Close release the backend connection
+func (s *BodyStream) Close() error {
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+	s.client.releaseReader(s.br)
+	if s.eof && s.reuse {
+		s.hc.releaseConn(s.cc)
+	} else {
+		s.hc.closeConn(s.cc)
+	}
+
+	return nil
+}
+
+func (s *BodyStream) readTo(resp *fasthttp.Response, maxBodySize int) error {
+	var r io.Reader = s
+	if maxBodySize > 0 {
+		r = io.LimitReader(s, int64(maxBodySize)+1)
+	}
+
+	buf := copyBufPool.Get().([]byte)
+	n, err := io.CopyBuffer(resp.BodyWriter(), r, buf)
+	copyBufPool.Put(buf)
+	if err != nil {
+		return err
+	}
+
+	if maxBodySize > 0 && n > int64(maxBodySize) {
+		return fasthttp.ErrBodyTooLarge
+	}
+
+	resp.Header.SetContentLength(int(n))
+	return nil
+}
+
+// skipTrailer skip the trailer of the chunked body, the last chunk is
+// followed by optional trailer fields and an empty line
+func (s *BodyStream) skipTrailer() error {
+	for {
+		line, err := s.br.ReadSlice('\n')
+		if err != nil {
+			return err
+		}
+
+		if len(line) <= 2 {
+			return nil
+		}
+	}
+}
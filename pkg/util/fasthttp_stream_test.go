@@ -0,0 +1,137 @@
+package util
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func startStreamServer(t *testing.T) (string, func()) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed with %+v", err)
+	}
+
+	go fasthttp.Serve(ln, func(ctx *fasthttp.RequestCtx) {
+		switch string(ctx.Path()) {
+		case "/chunked":
+			ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+				for i := 0; i < 3; i++ {
+					w.WriteString("chunk")
+					w.Flush()
+				}
+			})
+		default:
+			ctx.WriteString("hello")
+		}
+	})
+
+	return ln.Addr().String(), func() { ln.Close() }
+}
+
+func TestDoStream(t *testing.T) {
+	addr, stop := startStreamServer(t)
+	defer stop()
+
+	opt := DefaultHTTPOption()
+	opt.ReadTimeout = time.Second
+	c := NewFastHTTPClientOption(opt)
+
+	for i := 0; i < 2; i++ {
+		req := fasthttp.AcquireRequest()
+		req.SetRequestURI("/length")
+		req.SetHost(addr)
+
+		resp, stream, err := c.DoStream(req, addr, nil)
+		assert.NoError(t, err, "do stream failed")
+		assert.Equal(t, fasthttp.StatusOK, resp.StatusCode(), "check status code failed")
+		assert.Equal(t, 5, stream.Size(), "check size failed")
+
+		data, err := ioutil.ReadAll(stream)
+		assert.NoError(t, err, "read stream failed")
+		assert.Equal(t, "hello", string(data), "check body failed")
+		assert.NoError(t, stream.Close(), "close stream failed")
+
+		fasthttp.ReleaseResponse(resp)
+		fasthttp.ReleaseRequest(req)
+	}
+
+	assert.Equal(t, 1, c.hostClients[addr].connsCount, "check connection reused failed")
+}
+
+func TestDoStreamWithChunked(t *testing.T) {
+	addr, stop := startStreamServer(t)
+	defer stop()
+
+	c := NewFastHTTPClientOption(DefaultHTTPOption())
+
+	for i := 0; i < 2; i++ {
+		req := fasthttp.AcquireRequest()
+		req.SetRequestURI("/chunked")
+		req.SetHost(addr)
+
+		resp, stream, err := c.DoStream(req, addr, nil)
+		assert.NoError(t, err, "do stream failed")
+		assert.Equal(t, -1, stream.Size(), "check size failed")
+
+		data, err := ioutil.ReadAll(stream)
+		assert.NoError(t, err, "read stream failed")
+		assert.Equal(t, "chunkchunkchunk", string(data), "check body failed")
+		assert.NoError(t, stream.Close(), "close stream failed")
+
+		fasthttp.ReleaseResponse(resp)
+		fasthttp.ReleaseRequest(req)
+	}
+
+	assert.Equal(t, 1, c.hostClients[addr].connsCount, "check connection reused failed")
+}
+
+func TestCloseStreamBeforeEOF(t *testing.T) {
+	addr, stop := startStreamServer(t)
+	defer stop()
+
+	c := NewFastHTTPClientOption(DefaultHTTPOption())
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.SetRequestURI("/chunked")
+	req.SetHost(addr)
+
+	resp, stream, err := c.DoStream(req, addr, nil)
+	assert.NoError(t, err, "do stream failed")
+	defer fasthttp.ReleaseResponse(resp)
+
+	assert.NoError(t, stream.Close(), "close stream failed")
+	assert.Equal(t, 0, c.hostClients[addr].connsCount, "check connection closed failed")
+}
+
+func TestDoStreamIf(t *testing.T) {
+	addr, stop := startStreamServer(t)
+	defer stop()
+
+	c := NewFastHTTPClientOption(DefaultHTTPOption())
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	req.SetRequestURI("/chunked")
+	req.SetHost(addr)
+
+	resp, stream, err := c.DoStreamIf(req, addr, nil, func(*fasthttp.ResponseHeader) bool { return false })
+	assert.NoError(t, err, "do stream failed")
+	assert.Nil(t, stream, "check stream failed")
+	assert.Equal(t, "chunkchunkchunk", string(resp.Body()), "check body failed")
+	assert.Equal(t, 15, resp.Header.ContentLength(), "check content length failed")
+	fasthttp.ReleaseResponse(resp)
+
+	opt := DefaultHTTPOption()
+	opt.MaxResponseBodySize = 4
+	resp, stream, err = c.DoStreamIf(req, addr, opt, func(*fasthttp.ResponseHeader) bool { return false })
+	assert.Equal(t, fasthttp.ErrBodyTooLarge, err, "check body too large failed")
+	assert.Nil(t, stream, "check stream failed")
+	fasthttp.ReleaseResponse(resp)
+}
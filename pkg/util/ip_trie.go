@@ -0,0 +1,266 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+)
+
+// ipv4 addresses are stored as the ipv4-mapped ipv6 addresses, so the
+// ipv4 and ipv6 addresses share the same trie
+const ipv4MappedBits = 96
+
+type ipTrieNode struct {
+	children [2]*ipTrieNode
+	// terminal is true if the path from the root to the node is a added prefix
+	terminal bool
+}
+
+// IPTrie is a binary radix trie of the ip prefixes, it is used to check
+// whether a ip is in the added ipv4 or ipv6 CIDRs. The networks with the
+// non-contiguous masks, e.g. 10.*.1.*, are not prefixes, they are checked
+// one by one with the mask.
+type IPTrie struct {
+	root   *ipTrieNode
+	masked []*net.IPNet
+	size   int
+}
+
+// NewIPTrie returns a empty ip trie
+func NewIPTrie() *IPTrie {
+	return &IPTrie{
+		root: &ipTrieNode{},
+	}
+}
+
+// NewIPTrieFromValues returns a ip trie with the ip values,
+// see ParseIPValue for the formats of the value
+func NewIPTrieFromValues(values []string) (*IPTrie, error) {
+	t := NewIPTrie()
+	for _, value := range values {
+		nets, err := ParseIPValue(value)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range nets {
+			t.Add(n)
+		}
+	}
+
+	return t, nil
+}
+
+// Len returns the number of the prefixes in the trie
+func (t *IPTrie) Len() int {
+	return t.size
+}
+
+// Add adds the CIDR or the network with a non-contiguous mask to the trie
+func (t *IPTrie) Add(n *net.IPNet) {
+	ones, bits := n.Mask.Size()
+	ip := n.IP.To16()
+	if ip == nil {
+		return
+	}
+
+	if bits == 0 {
+		if len(n.Mask) == net.IPv4len || len(n.Mask) == net.IPv6len {
+			t.masked = append(t.masked, n)
+			t.size++
+		}
+		return
+	}
+
+	if bits == 8*net.IPv4len {
+		ones += ipv4MappedBits
+	}
+
+	node := t.root
+	for i := 0; i < ones; i++ {
+		// the longer prefixes are covered by the shorter prefix
+		if node.terminal {
+			return
+		}
+
+		bit := ipBit(ip, i)
+		if node.children[bit] == nil {
+			node.children[bit] = &ipTrieNode{}
+		}
+		node = node.children[bit]
+	}
+
+	if !node.terminal {
+		// the longer prefixes covered by the prefix are removed
+		t.size -= node.prefixes()
+		node.terminal = true
+		node.children = [2]*ipTrieNode{}
+		t.size++
+	}
+}
+
+// Contains returns true if the ip is in any prefix of the trie
+func (t *IPTrie) Contains(value net.IP) bool {
+	if t == nil || value == nil {
+		return false
+	}
+
+	ip := value.To16()
+	if ip == nil {
+		return false
+	}
+
+	for _, n := range t.masked {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	node := t.root
+	for i := 0; i < 8*net.IPv6len; i++ {
+		if node.terminal {
+			return true
+		}
+
+		node = node.children[ipBit(ip, i)]
+		if node == nil {
+			return false
+		}
+	}
+
+	return node.terminal
+}
+
+// ParseIPValue parse the ip value to CIDRs, the value can be:
+// a ipv4 or ipv6 address, e.g. 192.168.1.1 or 2001:db8::1
+// a CIDR, e.g. 192.168.0.0/16 or 2001:db8::/32
+// a range of the addresses, e.g. 192.168.1.10-192.168.1.20
+// a ipv4 address with wildcards or the leading octets, e.g. 192.168.*.*, 192.168.* or 192.168,
+// the wildcards in the middle make a non-contiguous mask, e.g. 10.*.1.* is 10.0.1.0/255.0.255.0
+func ParseIPValue(value string) ([]*net.IPNet, error) {
+	value = strings.TrimSpace(value)
+
+	if strings.Contains(value, "/") {
+		_, n, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		return []*net.IPNet{n}, nil
+	}
+
+	if idx := strings.IndexByte(value, '-'); idx >= 0 {
+		return parseIPRange(strings.TrimSpace(value[:idx]), strings.TrimSpace(value[idx+1:]))
+	}
+
+	ip := net.ParseIP(value)
+	if ip == nil {
+		if strings.Contains(value, ":") {
+			return nil, fmt.Errorf("error ip: %s", value)
+		}
+
+		return parseIPWildcard(value)
+	}
+
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		bits = 8 * net.IPv4len
+	}
+	return []*net.IPNet{{IP: ip, Mask: net.CIDRMask(bits, bits)}}, nil
+}
+
+// parseIPWildcard parse the ipv4 address with the wildcards to a network, the missing
+// octets are wildcards. The mask of every wildcard octet is 0, so the network has a
+// non-contiguous mask if any wildcard is in the middle.
+func parseIPWildcard(value string) ([]*net.IPNet, error) {
+	segments := strings.Split(value, ".")
+	if len(segments) > net.IPv4len {
+		return nil, fmt.Errorf("error ip: %s", value)
+	}
+	for len(segments) < net.IPv4len {
+		segments = append(segments, "*")
+	}
+
+	mask := net.CIDRMask(8*net.IPv4len, 8*net.IPv4len)
+	for idx, segment := range segments {
+		if segment == "*" {
+			segments[idx] = "0"
+			mask[idx] = 0
+		}
+	}
+
+	ip := net.ParseIP(strings.Join(segments, ".")).To4()
+	if ip == nil {
+		return nil, fmt.Errorf("error ip: %s", value)
+	}
+
+	return []*net.IPNet{{IP: ip, Mask: mask}}, nil
+}
+
+// parseIPRange parse the range of the addresses to the minimal CIDRs
+func parseIPRange(from, to string) ([]*net.IPNet, error) {
+	start := net.ParseIP(from)
+	end := net.ParseIP(to)
+	if start == nil || end == nil {
+		return nil, fmt.Errorf("error ip range: %s-%s", from, to)
+	}
+
+	size := net.IPv6len
+	if start4, end4 := start.To4(), end.To4(); start4 != nil || end4 != nil {
+		if start4 == nil || end4 == nil {
+			return nil, fmt.Errorf("error ip range: %s-%s, mixed ipv4 and ipv6", from, to)
+		}
+		start, end, size = start4, end4, net.IPv4len
+	} else {
+		start, end = start.To16(), end.To16()
+	}
+
+	if bytes.Compare(start, end) > 0 {
+		return nil, fmt.Errorf("error ip range: %s-%s, start is greater than end", from, to)
+	}
+
+	bits := uint(8 * size)
+	one := big.NewInt(1)
+	low := new(big.Int).SetBytes(start)
+	high := new(big.Int).SetBytes(end)
+
+	var values []*net.IPNet
+	for low.Cmp(high) <= 0 {
+		// the largest block aligned with low and not exceed high
+		host := uint(0)
+		for host < bits && low.Bit(int(host)) == 0 {
+			last := new(big.Int).Lsh(one, host+1)
+			last.Add(last, low).Sub(last, one)
+			if last.Cmp(high) > 0 {
+				break
+			}
+			host++
+		}
+
+		ip := make(net.IP, size)
+		value := low.Bytes()
+		copy(ip[size-len(value):], value)
+		values = append(values, &net.IPNet{IP: ip, Mask: net.CIDRMask(int(bits-host), int(bits))})
+		low.Add(low, new(big.Int).Lsh(one, host))
+	}
+
+	return values, nil
+}
+
+func (n *ipTrieNode) prefixes() int {
+	if n == nil {
+		return 0
+	}
+
+	if n.terminal {
+		return 1
+	}
+
+	return n.children[0].prefixes() + n.children[1].prefixes()
+}
+
+func ipBit(ip net.IP, i int) int {
+	return int(ip[i/8]>>(7-uint(i%8))) & 1
+}
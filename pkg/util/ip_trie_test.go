@@ -0,0 +1,145 @@
+package util
+
+import (
+	"net"
+	"testing"
+)
+
+func TestParseIPValue(t *testing.T) {
+	cases := []struct {
+		value  string
+		expect []string
+	}{
+		{"192.168.1.1", []string{"192.168.1.1/32"}},
+		{"2001:db8::1", []string{"2001:db8::1/128"}},
+		{"192.168.0.0/16", []string{"192.168.0.0/16"}},
+		{"2001:db8::/32", []string{"2001:db8::/32"}},
+		{"192.168.*.*", []string{"192.168.0.0/16"}},
+		{"*.*.*.*", []string{"0.0.0.0/0"}},
+		{"*", []string{"0.0.0.0/0"}},
+		{"127.*", []string{"127.0.0.0/8"}},
+		{"192.168", []string{"192.168.0.0/16"}},
+		{"10.*.1.*", []string{"10.0.1.0/ff00ff00"}},
+		{"*.*.*.1", []string{"0.0.0.1/000000ff"}},
+		{"192.168.1.0-192.168.1.255", []string{"192.168.1.0/24"}},
+		{"192.168.1.10-192.168.1.20", []string{"192.168.1.10/31", "192.168.1.12/30", "192.168.1.16/30", "192.168.1.20/32"}},
+		{"2001:db8::-2001:db8::ff", []string{"2001:db8::/120"}},
+	}
+
+	for _, c := range cases {
+		values, err := ParseIPValue(c.value)
+		if err != nil {
+			t.Errorf("parse %s failed with %+v", c.value, err)
+			continue
+		}
+
+		if len(values) != len(c.expect) {
+			t.Errorf("parse %s expect %+v, but %+v", c.value, c.expect, values)
+			continue
+		}
+
+		for idx, value := range values {
+			if value.String() != c.expect[idx] {
+				t.Errorf("parse %s expect %+v, but %+v", c.value, c.expect, values)
+				break
+			}
+		}
+	}
+
+	for _, value := range []string{"", "192.168.1.1.1", "a.b", "*.1.1.300", "192.168.1.300", "1.1.1.1/33",
+		"192.168.1.20-192.168.1.10", "192.168.1.1-2001:db8::1", "2001:db8::*"} {
+		if _, err := ParseIPValue(value); err == nil {
+			t.Errorf("expect invalid value %s", value)
+		}
+	}
+}
+
+func TestParseIPMiddleWildcards(t *testing.T) {
+	trie, _ := NewIPTrieFromValues([]string{"*.*.1.1"})
+	if trie.Len() != 1 || !trie.Contains(net.ParseIP("200.3.1.1")) || trie.Contains(net.ParseIP("200.3.1.2")) {
+		t.Errorf("expect the wildcards in the middle are one network, but %d", trie.Len())
+	}
+
+	trie, _ = NewIPTrieFromValues([]string{"10.*.1.*", "*.2"})
+	cases := []struct {
+		ip     string
+		expect bool
+	}{
+		{"10.0.1.1", true},
+		{"10.200.1.255", true},
+		{"10.200.2.1", false},
+		{"11.0.1.1", false},
+		{"1.2.3.4", true},
+		{"2.1.1.1", false},
+		{"::ffff:10.3.1.1", true},
+		{"2001:db8::1", false},
+	}
+
+	for _, c := range cases {
+		if trie.Contains(net.ParseIP(c.ip)) != c.expect {
+			t.Errorf("%s expect %v", c.ip, c.expect)
+		}
+	}
+}
+
+func TestIPTrie(t *testing.T) {
+	trie, err := NewIPTrieFromValues([]string{"10.0.0.0/8", "192.168.1.*", "172.16.0.1", "2001:db8::/32", "::1",
+		"192.168.2.10-192.168.2.20"})
+	if err != nil {
+		t.Fatalf("create trie failed with %+v", err)
+	}
+
+	cases := []struct {
+		ip     string
+		expect bool
+	}{
+		{"10.1.2.3", true},
+		{"11.0.0.1", false},
+		{"192.168.1.100", true},
+		{"192.168.3.1", false},
+		{"172.16.0.1", true},
+		{"172.16.0.2", false},
+		{"192.168.2.9", false},
+		{"192.168.2.10", true},
+		{"192.168.2.20", true},
+		{"192.168.2.21", false},
+		{"2001:db8:1::1", true},
+		{"2001:db9::1", false},
+		{"::1", true},
+		{"::ffff:10.0.0.1", true},
+		{"::a00:1", false},
+	}
+
+	for _, c := range cases {
+		if trie.Contains(net.ParseIP(c.ip)) != c.expect {
+			t.Errorf("%s expect %v", c.ip, c.expect)
+		}
+	}
+
+	if trie.Contains(nil) {
+		t.Errorf("nil ip expect false")
+	}
+
+	var empty *IPTrie
+	if empty.Contains(net.ParseIP("10.0.0.1")) {
+		t.Errorf("empty trie expect false")
+	}
+}
+
+func TestIPTrieCoveredPrefix(t *testing.T) {
+	trie := NewIPTrie()
+	values, _ := ParseIPValue("10.1.0.0/16")
+	trie.Add(values[0])
+	values, _ = ParseIPValue("10.0.0.0/8")
+	trie.Add(values[0])
+	values, _ = ParseIPValue("10.2.0.1")
+	trie.Add(values[0])
+
+	if trie.Len() != 1 {
+		t.Errorf("expect 1 prefix, but %d", trie.Len())
+	}
+
+	if !trie.Contains(net.ParseIP("10.1.1.1")) || !trie.Contains(net.ParseIP("10.3.1.1")) {
+		t.Errorf("expect contains by the covered prefix")
+	}
+}
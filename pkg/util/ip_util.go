@@ -1,24 +1,162 @@
 package util
 
 import (
+	"bytes"
+	"fmt"
+	"net"
 	"strings"
 
 	"github.com/valyala/fasthttp"
 )
 
-// ClientIP returns the real client IP
+const (
+	clientIPKey = "__client_ip__"
+)
+
+var (
+	xForwardedForHeader = []byte("X-Forwarded-For")
+	forwardedHeader     = []byte("Forwarded")
+	xRealIPHeader       = []byte("X-Real-Ip")
+)
+
+// ClientIP returns the real client IP resolved by SetClientIP, it is the remote ip
+// of the connection if the request is not resolved
 func ClientIP(ctx *fasthttp.RequestCtx) string {
-	clientIP := string(ctx.Request.Header.Peek("X-Forwarded-For"))
-	if index := strings.IndexByte(clientIP, ','); index >= 0 {
-		clientIP = clientIP[0:index]
+	if value, ok := ctx.UserValue(clientIPKey).(string); ok {
+		return value
+	}
+
+	return ctx.RemoteIP().String()
+}
+
+// SetClientIP resolve the real client IP of the request by the trusted proxies,
+// the result is returned by ClientIP
+func SetClientIP(ctx *fasthttp.RequestCtx, trusted *IPTrie) string {
+	value := RealClientIP(ctx, trusted)
+	ctx.SetUserValue(clientIPKey, value)
+	return value
+}
+
+// RealClientIP returns the real client IP, which is the right-most untrusted hop of the
+// Forwarded or X-Forwarded-For header, the headers are ignored if the remote ip of the
+// connection is not a trusted proxy.
+func RealClientIP(ctx *fasthttp.RequestCtx, trusted *IPTrie) string {
+	remote := ctx.RemoteIP()
+	if !trusted.Contains(remote) {
+		return remote.String()
 	}
-	clientIP = strings.TrimSpace(clientIP)
-	if len(clientIP) > 0 {
-		return clientIP
+
+	hops := forwardedHops(&ctx.Request.Header)
+	if len(hops) == 0 {
+		if value := strings.TrimSpace(string(ctx.Request.Header.PeekBytes(xRealIPHeader))); value != "" {
+			if ip := net.ParseIP(value); ip != nil {
+				return ip.String()
+			}
+		}
+
+		return remote.String()
 	}
-	clientIP = strings.TrimSpace(string(ctx.Request.Header.Peek("X-Real-Ip")))
-	if len(clientIP) > 0 {
-		return clientIP
+
+	client := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(hops[i])
+		// the hops before the invalid hop can not be trusted
+		if ip == nil {
+			break
+		}
+
+		client = ip
+		if !trusted.Contains(ip) {
+			break
+		}
 	}
-	return ctx.RemoteIP().String()
+
+	return client.String()
+}
+
+// forwardedHops returns the hops of the Forwarded header, or the X-Forwarded-For
+// header if no Forwarded header, the multiple headers are combined in order
+func forwardedHops(header *fasthttp.RequestHeader) []string {
+	var forwarded, xForwardedFor []string
+	header.VisitAll(func(key, value []byte) {
+		if bytes.EqualFold(key, forwardedHeader) {
+			forwarded = append(forwarded, parseForwarded(string(value))...)
+		} else if bytes.EqualFold(key, xForwardedForHeader) {
+			for _, hop := range strings.Split(string(value), ",") {
+				xForwardedFor = append(xForwardedFor, strings.TrimSpace(hop))
+			}
+		}
+	})
+
+	if len(forwarded) > 0 {
+		return forwarded
+	}
+
+	return xForwardedFor
+}
+
+// parseForwarded returns the for parameters of the Forwarded header defined by RFC 7239,
+// e.g. for=192.0.2.60;proto=http, for="[2001:db8:cafe::17]:4711"
+func parseForwarded(value string) []string {
+	var hops []string
+	for _, element := range strings.Split(value, ",") {
+		hop := ""
+		for _, pair := range strings.Split(element, ";") {
+			pair = strings.TrimSpace(pair)
+			if idx := strings.IndexByte(pair, '='); idx > 0 && strings.EqualFold(pair[:idx], "for") {
+				hop = forwardedNode(strings.Trim(pair[idx+1:], `"`))
+			}
+		}
+
+		// the element without for parameter is a unknown hop
+		hops = append(hops, hop)
+	}
+
+	return hops
+}
+
+// forwardedNode returns the ip of the node, the port is removed
+func forwardedNode(node string) string {
+	if strings.HasPrefix(node, "[") {
+		if idx := strings.IndexByte(node, ']'); idx > 0 {
+			return node[1:idx]
+		}
+		return node
+	}
+
+	if idx := strings.IndexByte(node, ':'); idx > 0 && strings.Count(node, ":") == 1 {
+		return node[:idx]
+	}
+
+	return node
+}
+
+// ParseCIDRs parse the comma separated CIDRs, a ip without mask is a single ip
+func ParseCIDRs(value string) ([]*net.IPNet, error) {
+	var values []*net.IPNet
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("error ip: %s", s)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+				bits = 8 * net.IPv4len
+			}
+			values = append(values, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, ipNet)
+	}
+
+	return values, nil
 }
@@ -0,0 +1,68 @@
+package util
+
+import (
+	"net"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func newClientIPCtx(remote string, headers ...string) *fasthttp.RequestCtx {
+	req := &fasthttp.Request{}
+	for i := 0; i < len(headers); i += 2 {
+		req.Header.Add(headers[i], headers[i+1])
+	}
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Init(req, &net.TCPAddr{IP: net.ParseIP(remote), Port: 1234}, nil)
+	return ctx
+}
+
+func TestRealClientIP(t *testing.T) {
+	trusted, _ := NewIPTrieFromValues([]string{"10.0.0.0/8", "2001:db8::/32"})
+
+	cases := []struct {
+		remote  string
+		headers []string
+		expect  string
+	}{
+		{"1.1.1.1", nil, "1.1.1.1"},
+		{"1.1.1.1", []string{"X-Forwarded-For", "2.2.2.2"}, "1.1.1.1"},
+		{"10.0.0.1", nil, "10.0.0.1"},
+		{"10.0.0.1", []string{"X-Real-Ip", "2.2.2.2"}, "2.2.2.2"},
+		{"10.0.0.1", []string{"X-Forwarded-For", "2.2.2.2"}, "2.2.2.2"},
+		{"10.0.0.1", []string{"X-Forwarded-For", "3.3.3.3, 2.2.2.2, 10.0.0.2"}, "2.2.2.2"},
+		{"10.0.0.1", []string{"X-Forwarded-For", "3.3.3.3", "X-Forwarded-For", "2.2.2.2"}, "2.2.2.2"},
+		{"10.0.0.1", []string{"X-Forwarded-For", "10.0.0.3, 10.0.0.2"}, "10.0.0.3"},
+		{"10.0.0.1", []string{"X-Forwarded-For", "2.2.2.2, unknown, 10.0.0.2"}, "10.0.0.2"},
+		{"10.0.0.1", []string{"Forwarded", `for=2.2.2.2;proto=http, for="[2001:db8::1]:4711"`, "X-Forwarded-For", "3.3.3.3"}, "2.2.2.2"},
+		{"10.0.0.1", []string{"Forwarded", "for=2.2.2.2:80"}, "2.2.2.2"},
+		{"2001:db8::2", []string{"Forwarded", `for="[2001:db9::1]"`}, "2001:db9::1"},
+		{"10.0.0.1", []string{"Forwarded", "for=_hidden"}, "10.0.0.1"},
+	}
+
+	for _, c := range cases {
+		ctx := newClientIPCtx(c.remote, c.headers...)
+		if value := RealClientIP(ctx, trusted); value != c.expect {
+			t.Errorf("%s %+v expect %s, but %s", c.remote, c.headers, c.expect, value)
+		}
+	}
+
+	ctx := newClientIPCtx("1.1.1.1", "X-Forwarded-For", "2.2.2.2")
+	if value := RealClientIP(ctx, nil); value != "1.1.1.1" {
+		t.Errorf("no trusted proxies expect remote ip, but %s", value)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	trusted, _ := NewIPTrieFromValues([]string{"10.0.0.0/8"})
+	ctx := newClientIPCtx("10.0.0.1", "X-Forwarded-For", "2.2.2.2")
+	if value := ClientIP(ctx); value != "10.0.0.1" {
+		t.Errorf("expect remote ip before resolved, but %s", value)
+	}
+
+	SetClientIP(ctx, trusted)
+	if value := ClientIP(ctx); value != "2.2.2.2" {
+		t.Errorf("expect resolved ip, but %s", value)
+	}
+}
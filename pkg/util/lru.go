@@ -7,7 +7,7 @@ import (
 	"github.com/fagongzi/goetty"
 )
 
-// Cache is an LRU cache. It is not safe for concurrent access.
+// Cache is an LRU cache. It is safe for concurrent access.
 type Cache struct {
 	sync.RWMutex
 
@@ -56,9 +56,16 @@ func (c *Cache) Add(key Key, value *goetty.ByteBuf) {
 		c.ll.MoveToFront(ee)
 
 		entry := ee.Value.(*entry)
-		c.current -= uint64(value.Readable())
+		old := entry.value
+		c.current -= uint64(old.Readable())
 		c.current += uint64(value.Readable())
 		entry.value = value
+		if c.OnEvicted != nil && old != value {
+			c.OnEvicted(key, old)
+		}
+		if c.MaxBytes != 0 && c.current > c.MaxBytes {
+			c.removeOldest()
+		}
 		c.Unlock()
 		return
 	}
@@ -74,20 +81,21 @@ func (c *Cache) Add(key Key, value *goetty.ByteBuf) {
 
 // Get looks up a key's value from the cache.
 func (c *Cache) Get(key Key) (value *goetty.ByteBuf, ok bool) {
-	c.RLock()
+	// moving the element to front modifies the list
+	c.Lock()
 
 	if c.cache == nil {
-		c.RUnlock()
+		c.Unlock()
 		return
 	}
 
 	if ele, hit := c.cache[key]; hit {
 		c.ll.MoveToFront(ele)
-		c.RUnlock()
+		c.Unlock()
 		return ele.Value.(*entry).value, true
 	}
 
-	c.RUnlock()
+	c.Unlock()
 	return
 }
 
@@ -138,6 +146,22 @@ func (c *Cache) Len() int {
 	return value
 }
 
+// Keys returns the keys of the items in the cache, from the most recently used.
+func (c *Cache) Keys() []Key {
+	c.RLock()
+	if c.cache == nil {
+		c.RUnlock()
+		return nil
+	}
+
+	keys := make([]Key, 0, c.ll.Len())
+	for e := c.ll.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.(*entry).key)
+	}
+	c.RUnlock()
+	return keys
+}
+
 // Clear purges all stored items from the cache.
 func (c *Cache) Clear() {
 	c.Lock()
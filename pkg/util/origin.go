@@ -0,0 +1,96 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateOriginPattern returns error if the origin pattern is invalid, the pattern is *,
+// an exact origin, or an origin with a wildcard as the leading labels of the host or as
+// the port, e.g. https://*.example.com, http://127.0.0.1:*
+func ValidateOriginPattern(pattern string) error {
+	if pattern == "*" {
+		return nil
+	}
+
+	if strings.Count(pattern, "*") > 1 {
+		return fmt.Errorf("origin pattern %s has more than one wildcard", pattern)
+	}
+
+	idx := strings.Index(pattern, "://")
+	if idx <= 0 || idx+3 == len(pattern) {
+		return fmt.Errorf("origin pattern %s missing scheme or host", pattern)
+	}
+
+	if strings.Contains(pattern[:idx], "*") || strings.Contains(pattern[idx+3:], "/") {
+		return fmt.Errorf("origin pattern %s only support wildcard host or port", pattern)
+	}
+
+	if strings.Contains(pattern, "*") && wildcardOf(pattern) == noWildcard {
+		return fmt.Errorf("origin pattern %s wildcard must be the leading labels of the host or the port", pattern)
+	}
+
+	return nil
+}
+
+const (
+	noWildcard = iota
+	hostWildcard
+	portWildcard
+)
+
+// wildcardOf returns the wildcard kind of the pattern, the wildcard must be followed by a dot
+// at the beginning of the host, or be the whole port
+func wildcardOf(pattern string) int {
+	idx := strings.IndexByte(pattern, '*')
+	switch {
+	case idx < 3:
+		return noWildcard
+	case strings.HasPrefix(pattern[idx-3:], "://*.") && len(pattern) > idx+2:
+		return hostWildcard
+	case idx == len(pattern)-1 && pattern[idx-1] == ':' && !strings.HasSuffix(pattern[:idx], "://:"):
+		return portWildcard
+	}
+
+	return noWildcard
+}
+
+// MatchOrigin returns true if the origin matches the pattern, the scheme and host are
+// case insensitive, the host wildcard matches one or more leading labels of the host,
+// the port wildcard matches any port
+func MatchOrigin(pattern, origin string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	idx := strings.IndexByte(pattern, '*')
+	if idx < 0 {
+		return strings.EqualFold(pattern, origin)
+	}
+
+	prefix, suffix := pattern[:idx], pattern[idx+1:]
+	if len(origin) <= len(prefix)+len(suffix) {
+		return false
+	}
+
+	if !strings.EqualFold(origin[:len(prefix)], prefix) ||
+		!strings.EqualFold(origin[len(origin)-len(suffix):], suffix) {
+		return false
+	}
+
+	value := origin[len(prefix) : len(origin)-len(suffix)]
+	switch wildcardOf(pattern) {
+	case hostWildcard:
+		// the wildcard part can not across the port or the path
+		return !strings.ContainsAny(value, ":/")
+	case portWildcard:
+		for _, c := range value {
+			if c < '0' || c > '9' {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestValidateOriginPattern(t *testing.T) {
+	for _, pattern := range []string{"*", "https://example.com", "https://*.example.com", "http://*.example.com:8080", "http://127.0.0.1:*"} {
+		if err := ValidateOriginPattern(pattern); err != nil {
+			t.Errorf("expect valid pattern %s, but %+v", pattern, err)
+		}
+	}
+
+	for _, pattern := range []string{"", "example.com", "https://", "*://example.com", "https://*.*.example.com", "https://example.com/a",
+		"https://*example.com", "https://a*.example.com", "https://a.*.example.com", "https://*", "https://*.", "http://127.0.0.1:8*", "http://*:8080", "http://:*"} {
+		if err := ValidateOriginPattern(pattern); err == nil {
+			t.Errorf("expect invalid pattern %s", pattern)
+		}
+	}
+}
+
+func TestMatchOrigin(t *testing.T) {
+	cases := []struct {
+		pattern string
+		origin  string
+		expect  bool
+	}{
+		{"*", "https://a.com", true},
+		{"https://a.com", "https://a.com", true},
+		{"https://a.com", "HTTPS://A.COM", true},
+		{"https://a.com", "http://a.com", false},
+		{"https://a.com", "https://a.com:8443", false},
+		{"https://*.a.com", "https://b.a.com", true},
+		{"https://*.a.com", "https://c.b.a.com", true},
+		{"https://*.a.com", "https://a.com", false},
+		{"https://*.a.com", "https://.a.com", false},
+		{"https://*.a.com", "https://evil.com/.a.com", false},
+		{"https://*.a.com", "https://b.a.com.evil.com", false},
+		{"https://*.a.com", "https://x:1.a.com", false},
+		{"http://127.0.0.1:*", "http://127.0.0.1:8080", true},
+		{"http://127.0.0.1:*", "http://127.0.0.1", false},
+		{"http://127.0.0.1:*", "http://127.0.0.1:80a", false},
+		{"http://127.0.0.1:*", "http://127.0.0.1:8080/a", false},
+		{"https://*example.com", "https://evilexample.com", false},
+		{"https://*example.com", "https://a.example.com", false},
+		{"https://a*.example.com", "https://ab.example.com", false},
+	}
+
+	for _, c := range cases {
+		if MatchOrigin(c.pattern, c.origin) != c.expect {
+			t.Errorf("expect %s matches %s %+v, but not", c.pattern, c.origin, c.expect)
+		}
+	}
+}
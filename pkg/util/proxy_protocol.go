@@ -0,0 +1,221 @@
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// the max length of the v1 header, including the CRLF
+	proxyProtocolV1MaxLen = 107
+	// the length of the v2 header before the addresses
+	proxyProtocolV2HeaderLen = 16
+)
+
+var (
+	proxyProtocolV1Sig = []byte("PROXY ")
+	proxyProtocolV2Sig = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+	// ErrInvalidProxyProtocol invalid proxy protocol header
+	ErrInvalidProxyProtocol = errors.New("invalid proxy protocol header")
+)
+
+// NewProxyProtocolListener returns a listener which parse the PROXY protocol v1 and v2
+// header of the accepted connections, the RemoteAddr of the connection is the source
+// address of the header. The header is only accepted from the trusted proxies, so it
+// is never accepted if the trusted proxies are empty, the connection without the header
+// and the connection from the untrusted clients are served as is.
+func NewProxyProtocolListener(l net.Listener, timeout time.Duration, trusted *IPTrie) net.Listener {
+	return &proxyProtocolListener{
+		Listener: l,
+		timeout:  timeout,
+		trusted:  trusted,
+	}
+}
+
+type proxyProtocolListener struct {
+	net.Listener
+
+	timeout time.Duration
+	trusted *IPTrie
+}
+
+func (l *proxyProtocolListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	// the header is read by the first Read or RemoteAddr, so that Accept is
+	// not blocked by the slow connections
+	return &proxyProtocolConn{
+		Conn:     conn,
+		reader:   bufio.NewReaderSize(conn, proxyProtocolV1MaxLen),
+		listener: l,
+	}, nil
+}
+
+type proxyProtocolConn struct {
+	net.Conn
+
+	reader   *bufio.Reader
+	listener *proxyProtocolListener
+	once     sync.Once
+	remote   net.Addr
+	err      error
+}
+
+func (c *proxyProtocolConn) Read(b []byte) (int, error) {
+	c.once.Do(c.readHeader)
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	return c.reader.Read(b)
+}
+
+func (c *proxyProtocolConn) RemoteAddr() net.Addr {
+	c.once.Do(c.readHeader)
+	if c.remote != nil {
+		return c.remote
+	}
+
+	return c.Conn.RemoteAddr()
+}
+
+func (c *proxyProtocolConn) readHeader() {
+	// the untrusted clients can fake the source address by the header
+	addr, ok := c.Conn.RemoteAddr().(*net.TCPAddr)
+	if !ok || !c.listener.trusted.Contains(addr.IP) {
+		return
+	}
+
+	if c.listener.timeout > 0 {
+		c.Conn.SetReadDeadline(time.Now().Add(c.listener.timeout))
+		defer c.Conn.SetReadDeadline(time.Time{})
+	}
+
+	c.remote, c.err = readProxyProtocolHeader(c.reader)
+	if c.err != nil {
+		c.Conn.Close()
+	}
+}
+
+// readProxyProtocolHeader returns the source address of the header, returns nil
+// if there is no header or the header is LOCAL or UNKNOWN
+func readProxyProtocolHeader(r *bufio.Reader) (net.Addr, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	switch first[0] {
+	case proxyProtocolV1Sig[0]:
+		sig, err := r.Peek(len(proxyProtocolV1Sig))
+		if err != nil || !bytes.Equal(sig, proxyProtocolV1Sig) {
+			return nil, nil
+		}
+		return readProxyProtocolV1(r)
+	case proxyProtocolV2Sig[0]:
+		sig, err := r.Peek(len(proxyProtocolV2Sig))
+		if err != nil || !bytes.Equal(sig, proxyProtocolV2Sig) {
+			return nil, nil
+		}
+		return readProxyProtocolV2(r)
+	}
+
+	return nil, nil
+}
+
+// readProxyProtocolV1 parse the header like: PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\r\n
+func readProxyProtocolV1(r *bufio.Reader) (net.Addr, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	if len(line) > proxyProtocolV1MaxLen || !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	fields := strings.Split(string(line[:len(line)-2]), " ")
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	ip := net.ParseIP(fields[2])
+	if ip == nil || (fields[1] == "TCP4") != (ip.To4() != nil) {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readProxyProtocolV2 parse the binary header, the TLVs are ignored
+func readProxyProtocolV2(r *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, proxyProtocolV2HeaderLen)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	version, command := header[12]>>4, header[12]&0x0f
+	if version != 2 || command > 1 {
+		return nil, fmt.Errorf("%s: version %d, command %d",
+			ErrInvalidProxyProtocol,
+			version,
+			command)
+	}
+
+	payload := make([]byte, binary.BigEndian.Uint16(header[14:]))
+	_, err = io.ReadFull(r, payload)
+	if err != nil {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	// LOCAL command, the connection is from the proxy itself
+	if command == 0 {
+		return nil, nil
+	}
+
+	var size int
+	switch header[13] >> 4 {
+	case 1:
+		size = net.IPv4len
+	case 2:
+		size = net.IPv6len
+	default:
+		// AF_UNSPEC or AF_UNIX
+		return nil, nil
+	}
+
+	if len(payload) < 2*size+4 {
+		return nil, ErrInvalidProxyProtocol
+	}
+
+	ip := make(net.IP, size)
+	copy(ip, payload[:size])
+	port := binary.BigEndian.Uint16(payload[2*size:])
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
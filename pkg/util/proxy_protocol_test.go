@@ -0,0 +1,136 @@
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+func proxyProtocolV2(command, family byte, src, dst net.IP, srcPort, dstPort uint16, tlv []byte) []byte {
+	var addrs bytes.Buffer
+	addrs.Write(src)
+	addrs.Write(dst)
+	binary.Write(&addrs, binary.BigEndian, srcPort)
+	binary.Write(&addrs, binary.BigEndian, dstPort)
+	addrs.Write(tlv)
+
+	var buf bytes.Buffer
+	buf.Write(proxyProtocolV2Sig)
+	buf.WriteByte(0x20 | command)
+	buf.WriteByte(family)
+	binary.Write(&buf, binary.BigEndian, uint16(addrs.Len()))
+	buf.Write(addrs.Bytes())
+	return buf.Bytes()
+}
+
+func serveProxyProtocol(t *testing.T, trusted *IPTrie, data []byte) (string, string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed with %+v", err)
+	}
+	defer l.Close()
+
+	go func() {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			return
+		}
+		conn.Write(data)
+		conn.Close()
+	}()
+
+	conn, err := NewProxyProtocolListener(l, time.Second, trusted).Accept()
+	if err != nil {
+		t.Fatalf("accept failed with %+v", err)
+	}
+	defer conn.Close()
+
+	remote := conn.RemoteAddr().String()
+	body, err := ioutil.ReadAll(conn)
+	return remote, string(body), err
+}
+
+func localTrustedProxies() *IPTrie {
+	trusted, _ := NewIPTrieFromValues([]string{"127.0.0.1"})
+	return trusted
+}
+
+func TestProxyProtocol(t *testing.T) {
+	body := "GET / HTTP/1.1\r\n\r\n"
+	cases := []struct {
+		data   []byte
+		remote string
+	}{
+		{[]byte("PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\r\n" + body), "192.168.0.1:56324"},
+		{[]byte("PROXY TCP6 2001:db8::1 2001:db8::2 56324 443\r\n" + body), "[2001:db8::1]:56324"},
+		{append(proxyProtocolV2(1, 0x11, net.ParseIP("192.168.0.1").To4(), net.ParseIP("192.168.0.11").To4(), 56324, 443, nil), body...), "192.168.0.1:56324"},
+		{append(proxyProtocolV2(1, 0x21, net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8::2"), 56324, 443, []byte{1, 0, 1, 'h'}), body...), "[2001:db8::1]:56324"},
+	}
+
+	for _, c := range cases {
+		remote, value, err := serveProxyProtocol(t, localTrustedProxies(), c.data)
+		if err != nil {
+			t.Errorf("%q read failed with %+v", c.data, err)
+		}
+		if remote != c.remote {
+			t.Errorf("%q expect remote %s, but %s", c.data, c.remote, remote)
+		}
+		if value != body {
+			t.Errorf("%q expect body %q, but %q", c.data, body, value)
+		}
+	}
+}
+
+func TestProxyProtocolWithoutHeader(t *testing.T) {
+	for _, data := range [][]byte{
+		[]byte("POST / HTTP/1.1\r\n\r\n"),
+		[]byte("PROXY UNKNOWN\r\nGET / HTTP/1.1\r\n\r\n"),
+		append(proxyProtocolV2(0, 0x11, net.ParseIP("192.168.0.1").To4(), net.ParseIP("192.168.0.11").To4(), 56324, 443, nil), "GET / HTTP/1.1\r\n\r\n"...),
+	} {
+		remote, value, err := serveProxyProtocol(t, localTrustedProxies(), data)
+		if err != nil {
+			t.Errorf("%q read failed with %+v", data, err)
+		}
+		if host, _, _ := net.SplitHostPort(remote); host != "127.0.0.1" {
+			t.Errorf("%q expect remote of the connection, but %s", data, remote)
+		}
+		if !bytes.HasSuffix(data, []byte(value)) || value == "" {
+			t.Errorf("%q unexpected body %q", data, value)
+		}
+	}
+}
+
+func TestProxyProtocolInvalid(t *testing.T) {
+	for _, data := range [][]byte{
+		[]byte("PROXY TCP4 192.168.0.1\r\n"),
+		[]byte("PROXY TCP4 2001:db8::1 192.168.0.11 56324 443\r\n"),
+		[]byte("PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\n"),
+		proxyProtocolV2(1, 0x21, net.ParseIP("192.168.0.1").To4(), net.ParseIP("192.168.0.11").To4(), 56324, 443, nil),
+	} {
+		if _, _, err := serveProxyProtocol(t, localTrustedProxies(), data); err == nil {
+			t.Errorf("%q expect error", data)
+		}
+	}
+}
+
+func TestProxyProtocolUntrusted(t *testing.T) {
+	trusted, _ := NewIPTrieFromValues([]string{"10.0.0.0/8"})
+	data := "PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\r\n"
+
+	// no trusted proxies, the header is not accepted from any client
+	for _, trusted := range []*IPTrie{trusted, NewIPTrie(), nil} {
+		remote, value, err := serveProxyProtocol(t, trusted, []byte(data))
+		if err != nil {
+			t.Errorf("read failed with %+v", err)
+		}
+		if host, _, _ := net.SplitHostPort(remote); host != "127.0.0.1" {
+			t.Errorf("expect remote of the connection, but %s", remote)
+		}
+		if value != data {
+			t.Errorf("expect the header is not parsed, but %q", value)
+		}
+	}
+}
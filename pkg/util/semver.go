@@ -0,0 +1,98 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SemVer is a semantic version, the missing minor and patch are zero
+type SemVer struct {
+	numbers    [3]uint64
+	prerelease []string
+}
+
+// ParseSemVer parse a semantic version like v1.2.3-beta.1+build, the leading v and
+// the build metadata are ignored
+func ParseSemVer(value string) (SemVer, error) {
+	v := SemVer{}
+	s := strings.TrimPrefix(strings.TrimSpace(value), "v")
+	if idx := strings.IndexByte(s, '+'); idx >= 0 {
+		s = s[:idx]
+	}
+	if idx := strings.IndexByte(s, '-'); idx >= 0 {
+		v.prerelease = strings.Split(s[idx+1:], ".")
+		s = s[:idx]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return v, fmt.Errorf("error semantic version: %s", value)
+	}
+
+	for idx, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return v, fmt.Errorf("error semantic version: %s", value)
+		}
+		v.numbers[idx] = n
+	}
+
+	for _, id := range v.prerelease {
+		if id == "" {
+			return v, fmt.Errorf("error semantic version: %s", value)
+		}
+	}
+
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 if the version is less than, equal to or greater
+// than the other version
+func (v SemVer) Compare(other SemVer) int {
+	for idx := range v.numbers {
+		if v.numbers[idx] != other.numbers[idx] {
+			return compareUint64(v.numbers[idx], other.numbers[idx])
+		}
+	}
+
+	// a version without prerelease has a higher precedence
+	if len(v.prerelease) == 0 || len(other.prerelease) == 0 {
+		return compareUint64(uint64(len(other.prerelease)), uint64(len(v.prerelease)))
+	}
+
+	for idx := 0; idx < len(v.prerelease) && idx < len(other.prerelease); idx++ {
+		if value := comparePrerelease(v.prerelease[idx], other.prerelease[idx]); value != 0 {
+			return value
+		}
+	}
+
+	return compareUint64(uint64(len(v.prerelease)), uint64(len(other.prerelease)))
+}
+
+// comparePrerelease numeric identifiers are compared numerically and have a
+// lower precedence than alphanumeric identifiers
+func comparePrerelease(a, b string) int {
+	na, errA := strconv.ParseUint(a, 10, 64)
+	nb, errB := strconv.ParseUint(b, 10, 64)
+	switch {
+	case errA == nil && errB == nil:
+		return compareUint64(na, nb)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareUint64(a, b uint64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+
+	return 0
+}
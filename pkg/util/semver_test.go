@@ -0,0 +1,45 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestParseSemVer(t *testing.T) {
+	for _, value := range []string{"1", "1.2", "1.2.3", "v1.2.3", "1.2.3-beta.1", "1.2.3+build.1"} {
+		if _, err := ParseSemVer(value); err != nil {
+			t.Errorf("parse %s failed with %+v", value, err)
+		}
+	}
+
+	for _, value := range []string{"", "a.b", "1.2.3.4", "1.2.3-", "1..2", "1.2.3-a..b"} {
+		if _, err := ParseSemVer(value); err == nil {
+			t.Errorf("expect parse %s failed", value)
+		}
+	}
+}
+
+func TestSemVerCompare(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		expect int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2", "1.2.0", 0},
+		{"v1.2.3", "1.2.3+build", 0},
+		{"1.2.3", "1.10.0", -1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-rc.1", "1.0.0-beta.11", 1},
+	}
+
+	for _, c := range cases {
+		a, _ := ParseSemVer(c.a)
+		b, _ := ParseSemVer(c.b)
+		if value := a.Compare(b); value != c.expect {
+			t.Errorf("compare %s with %s, expect %d but %d", c.a, c.b, c.expect, value)
+		}
+	}
+}